@@ -418,8 +418,9 @@ func (av *AnimationViewer) Draw(screen *ebiten.Image) {
 	}
 
 	// Получаем текущий кадр анимации
-	frameImg := av.animSystem.GetFrameImage(av.animComponent)
-	if frameImg != nil {
+	// Спрайты вьювера - *ebiten.Image, поэтому и кадры *ebiten.Image
+	frameImg, ok := av.animSystem.GetFrameImage(av.animComponent).(*ebiten.Image)
+	if ok && frameImg != nil {
 		// Рисуем анимацию в центре экрана
 		screenWidth, screenHeight := screen.Bounds().Dx(), screen.Bounds().Dy()
 
@@ -4,10 +4,12 @@ import (
 	"fmt"
 
 	"github.com/aiseeq/savanna/config"
-	"github.com/aiseeq/savanna/internal/adapters"
 	"github.com/aiseeq/savanna/internal/core"
 	"github.com/aiseeq/savanna/internal/generator"
+	"github.com/aiseeq/savanna/internal/lifestats"
+	"github.com/aiseeq/savanna/internal/pipeline"
 	"github.com/aiseeq/savanna/internal/simulation"
+	"github.com/aiseeq/savanna/internal/snapshot"
 )
 
 // Константы популяции животных для начального размещения
@@ -21,34 +23,56 @@ type WorldStats struct {
 	Rabbits      int `json:"rabbits"`      // Количество зайцев
 	Wolves       int `json:"wolves"`       // Количество волков
 	TotalAnimals int `json:"totalAnimals"` // Общее количество животных
+
+	Life []lifestats.SpeciesStats `json:"life"` // Причины смертей и истории жизней по видам
 }
 
 // GameWorld управляет симуляцией мира и его системами
 // Соблюдает SRP - единственная ответственность: симуляция экосистемы
 type GameWorld struct {
-	world            *core.World
-	systemManager    *core.SystemManager
-	animationManager *AnimationManager
-	terrain          *generator.Terrain
+	world    *core.World
+	pipeline *pipeline.Pipeline // Системы и анимации (общие с headless прогонами)
+	terrain  *generator.Terrain
+	life     *lifestats.Tracker // Истории жизней и причины смертей
+
+	balance *simulation.BalanceProfile // Профиль баланса (nil - значения по умолчанию)
 }
 
 // NewGameWorld создаёт новый игровой мир
 func NewGameWorld(worldWidth, worldHeight int, seed int64, terrain *generator.Terrain) *GameWorld {
 	world := core.NewWorld(float32(worldWidth), float32(worldHeight), seed)
-	systemManager := core.NewSystemManager()
-	animationManager := NewAnimationManager()
-
-	gw := &GameWorld{
-		world:            world,
-		systemManager:    systemManager,
-		animationManager: animationManager,
-		terrain:          terrain,
+	return newGameWorld(world, terrain)
+}
+
+// NewGameWorldFromSnapshot восстанавливает игровой мир из снапшота
+// Системы создаются заново поверх восстановленного ландшафта с профилем баланса снапшота,
+// затем получают сохранённое состояние
+func NewGameWorldFromSnapshot(snap *snapshot.Snapshot) (*GameWorld, error) {
+	world, terrain, err := snap.Restore()
+	if err != nil {
+		return nil, err
 	}
 
-	// Инициализируем системы симуляции
-	gw.initializeSystems(worldWidth, worldHeight)
+	gw := newGameWorld(world, terrain)
+	gw.SetBalanceProfile(snap.Balance)
+	if err := snap.RestoreSystems(gw.pipeline.SystemManager()); err != nil {
+		return nil, fmt.Errorf("failed to restore systems: %w", err)
+	}
+
+	return gw, nil
+}
+
+// newGameWorld собирает GameWorld вокруг готового мира и ландшафта
+func newGameWorld(world *core.World, terrain *generator.Terrain) *GameWorld {
+	// Инициализируем системы симуляции для реальных размеров мира
+	worldWidth, worldHeight := world.GetWorldDimensions()
 
-	return gw
+	return &GameWorld{
+		world:    world,
+		pipeline: pipeline.New(terrain, worldWidth, worldHeight),
+		terrain:  terrain,
+		life:     lifestats.NewTracker(world),
+	}
 }
 
 // GetWorld возвращает мир для доступа к данным
@@ -61,90 +85,45 @@ func (gw *GameWorld) GetTerrain() *generator.Terrain {
 	return gw.terrain
 }
 
-// REMOVED: Старые методы отрисовки больше не используются
-// Новая изометрическая система отрисовки используется напрямую в main.go
-
-// Update обновляет симуляцию мира
-func (gw *GameWorld) Update(deltaTime float32) {
-	gw.world.Update(deltaTime)
+// GetSystemStats возвращает профили систем симуляции в порядке выполнения
+func (gw *GameWorld) GetSystemStats() []core.SystemStats {
+	return gw.pipeline.SystemManager().Stats()
+}
 
-	// КРИТИЧЕСКИЙ ИСПРАВЛЕНИЕ: Анимации должны обновляться ПЕРЕД системами
-	// чтобы GrassEatingSystem видел актуальные значения анимационных таймеров
-	gw.animationManager.UpdateAnimalAnimations(gw.world, deltaTime)
+// CreateSnapshot снимает полный снапшот симуляции (мир + ландшафт + состояние систем + профиль баланса)
+func (gw *GameWorld) CreateSnapshot() (*snapshot.Snapshot, error) {
+	return snapshot.Capture(gw.world, gw.terrain, gw.pipeline.SystemManager(), gw.balance)
+}
 
-	gw.systemManager.Update(gw.world, deltaTime)
+// SetBalanceProfile устанавливает профиль баланса для систем и новых животных
+// Вызывается до PopulateWorld - параметры видов применяются к начальной популяции
+func (gw *GameWorld) SetBalanceProfile(profile *simulation.BalanceProfile) {
+	gw.balance = profile
+	gw.pipeline.SetBalanceProfile(profile)
 }
 
-// initializeSystems инициализирует все системы симуляции
-func (gw *GameWorld) initializeSystems(worldWidth, worldHeight int) {
-	// Создаём системы (SRP рефакторинг: разделённые специализированные системы)
-	vegetationSystem := simulation.NewVegetationSystem(gw.terrain)
-
-	// НОВЫЕ СИСТЕМЫ (следуют принципу SRP):
-	satiationSystem := simulation.NewSatiationSystem() // 1. Только управление сытостью
-	// 2. Только поиск травы и создание EatingState (DIP: использует интерфейс)
-	grassSearchSystem := simulation.NewGrassSearchSystem(vegetationSystem)
-	satiationSpeedModifier := simulation.NewSatiationSpeedModifierSystem() // 3. Только влияние сытости на скорость
-	starvationDamage := simulation.NewStarvationDamageSystem()             // 4. Только урон от истощения
-
-	grassEatingSystem := simulation.NewGrassEatingSystem(vegetationSystem) // DIP: использует интерфейс VegetationProvider
-	animalBehaviorSystem := simulation.NewAnimalBehaviorSystem(vegetationSystem)
-	// Используем реальные размеры мира
-	movementSystem := simulation.NewMovementSystem(float32(worldWidth), float32(worldHeight))
-	// Уже включает DamageSystem внутри
-	combatSystem := simulation.NewCombatSystem()
-
-	// Добавляем системы в правильном порядке (КРИТИЧЕСКИ ВАЖЕН ДЛЯ ПИТАНИЯ!)
-	gw.systemManager.AddSystem(vegetationSystem)                 // 1. Рост травы
-	gw.systemManager.AddSystem(&adapters.SatiationSystemAdapter{ // 2. Управление сытостью
-		System: satiationSystem,
-	})
-	gw.systemManager.AddSystem(&adapters.GrassSearchSystemAdapter{ // 3. Создание EatingState
-		System: grassSearchSystem,
-	})
-	gw.systemManager.AddSystem(grassEatingSystem)               // 4. Дискретное поедание травы
-	gw.systemManager.AddSystem(&adapters.BehaviorSystemAdapter{ // 5. Поведение (проверяет EatingState)
-		System: animalBehaviorSystem,
-	})
-	gw.systemManager.AddSystem(&adapters.SatiationSpeedModifierSystemAdapter{ // 6. Влияние сытости на скорость
-		System: satiationSpeedModifier,
-	})
-	gw.systemManager.AddSystem(&adapters.MovementSystemAdapter{ // 7. Движение (сбрасывает скорость едящих)
-		System: movementSystem,
-	})
-	gw.systemManager.AddSystem(combatSystem)                            // 8. Система боя
-	gw.systemManager.AddSystem(&adapters.StarvationDamageSystemAdapter{ // 9. Урон от истощения
-		System: starvationDamage,
-	})
+// REMOVED: Старые методы отрисовки больше не используются
+// Новая изометрическая система отрисовки используется напрямую в main.go
 
-	// Загружаем анимации для всех типов животных
-	if err := gw.animationManager.LoadAnimationsFromConfig(); err != nil {
-		// В GameWorld мы не можем вернуть ошибку, поэтому просто логируем
-		// В реальном приложении здесь может быть более сложная обработка
-		return
-	}
+// Update обновляет симуляцию мира
+func (gw *GameWorld) Update(deltaTime float32) {
+	gw.pipeline.Update(gw.world, deltaTime)
+	gw.life.Observe()
 }
 
 // PopulateWorld заполняет мир животными используя PopulationGenerator
-func (gw *GameWorld) PopulateWorld(cfg *config.Config) {
-	// ИСПРАВЛЕНИЕ: Используем PopulationGenerator вместо случайного размещения
-	popGen := generator.NewPopulationGenerator(cfg, gw.terrain)
-	placements := popGen.Generate()
+func (gw *GameWorld) PopulateWorld(cfg *config.Config) error {
+	popGen, placements, err := pipeline.Populate(gw.world, gw.terrain, cfg, gw.balance)
+	if err != nil {
+		return err
+	}
 
+	// Проверяем границы размещения (в пикселях)
 	worldWidth, worldHeight := gw.world.GetWorldDimensions()
-
 	for _, placement := range placements {
-		// PopulationGenerator уже возвращает координаты в пикселях
-		// CreateAnimal также ожидает координаты в пикселях
-		x := placement.X
-		y := placement.Y
-
-		// Проверяем границы размещения (в пикселях)
-		if x < 0 || x > worldWidth*32 || y < 0 || y > worldHeight*32 {
+		if placement.X < 0 || placement.X > worldWidth*32 || placement.Y < 0 || placement.Y > worldHeight*32 {
 			fmt.Printf("WARNING: Animal placed outside world bounds!\n")
 		}
-
-		simulation.CreateAnimal(gw.world, placement.Type, x, y)
 	}
 
 	errors := popGen.ValidatePlacement(placements)
@@ -156,6 +135,7 @@ func (gw *GameWorld) PopulateWorld(cfg *config.Config) {
 	popStats := popGen.GetStats(placements)
 	fmt.Printf("Размещено животных: %d зайцев, %d волков\n",
 		popStats["rabbits"], popStats["wolves"])
+	return nil
 }
 
 // GetStats возвращает типизированную статистику мира
@@ -169,6 +149,8 @@ func (gw *GameWorld) GetStats() WorldStats {
 			return
 		}
 
+		// Виды из файлов описаний учитываются только в общем количестве
+		stats.TotalAnimals++
 		switch animalType {
 		case core.TypeRabbit:
 			stats.Rabbits++
@@ -177,6 +159,6 @@ func (gw *GameWorld) GetStats() WorldStats {
 		}
 	})
 
-	stats.TotalAnimals = stats.Rabbits + stats.Wolves
+	stats.Life = lifestats.Summarize(gw.life.Lives(), nil)
 	return stats
 }
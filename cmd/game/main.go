@@ -25,8 +25,12 @@ import (
 	"github.com/aiseeq/savanna/internal/generator"
 	"github.com/aiseeq/savanna/internal/rendering"
 	"github.com/aiseeq/savanna/internal/simulation"
+	"github.com/aiseeq/savanna/internal/snapshot"
 )
 
+// QuickSaveFile файл быстрого сохранения (F5 - сохранить, F9 - загрузить)
+const QuickSaveFile = "savanna_quicksave.snap"
+
 // Game структура для GUI версии симулятора экосистемы саванны
 // Рефакторинг: разбита на специализированные менеджеры (соблюдение SRP)
 type Game struct {
@@ -99,6 +103,14 @@ func (g *Game) Update() error {
 		g.takeDebugScreenshot()
 	}
 
+	// Быстрое сохранение (F5) и загрузка (F9) снапшота
+	if inpututil.IsKeyJustPressed(ebiten.KeyF5) {
+		g.quickSave()
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyF9) {
+		g.quickLoad()
+	}
+
 	// Обновляем симуляцию с учётом времени
 	deltaTime := g.timeManager.GetDeltaTime()
 	g.gameWorld.Update(deltaTime)
@@ -127,6 +139,41 @@ func (g *Game) Draw(screen *ebiten.Image) {
 	g.drawFPS(screen)
 }
 
+// quickSave сохраняет снапшот симуляции в QuickSaveFile
+func (g *Game) quickSave() {
+	snap, err := g.gameWorld.CreateSnapshot()
+	if err == nil {
+		err = snapshot.SaveToFile(QuickSaveFile, snap)
+	}
+	if err != nil {
+		log.Printf("Ошибка сохранения снапшота: %v", err)
+		return
+	}
+	log.Printf("💾 Снапшот сохранён: %s", QuickSaveFile)
+}
+
+// quickLoad загружает снапшот из QuickSaveFile и подменяет текущий мир
+func (g *Game) quickLoad() {
+	gameWorld, err := loadGameWorld(QuickSaveFile)
+	if err != nil {
+		log.Printf("Ошибка загрузки снапшота: %v", err)
+		return
+	}
+
+	g.gameWorld = gameWorld
+	g.terrain = gameWorld.GetTerrain()
+	log.Printf("📂 Снапшот загружен: %s", QuickSaveFile)
+}
+
+// loadGameWorld загружает игровой мир из файла снапшота
+func loadGameWorld(filename string) (*GameWorld, error) {
+	snap, err := snapshot.LoadFromFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return NewGameWorldFromSnapshot(snap)
+}
+
 // Layout устанавливает размеры экрана
 func (g *Game) Layout(outsideWidth, outsideHeight int) (screenWidth, screenHeight int) {
 	return outsideWidth, outsideHeight
@@ -152,6 +199,16 @@ func (g *Game) drawUI(screen *ebiten.Image) {
 	g.drawText(screen, fmt.Sprintf("Wolves: %d", stats.Wolves), 10, y, font)
 	y += lineHeight
 
+	// Причины смертей по видам
+	for _, species := range stats.Life {
+		deaths := species.Deaths
+		g.drawText(screen, fmt.Sprintf("%s deaths: killed %d, starvation %d, thirst %d, old age %d (avg age %.0fs)",
+			species.Species, deaths[core.DeathKilled.String()], deaths[core.DeathStarvation.String()],
+			deaths[core.DeathDehydration.String()], deaths[core.DeathOldAge.String()],
+			species.MeanAgeAtDeath), 10, y, font)
+		y += lineHeight
+	}
+
 	// Масштаб и скорость
 	g.drawText(screen, fmt.Sprintf("Zoom: %.1fx", g.camera.GetZoom()), 10, y, font)
 	y += lineHeight
@@ -338,6 +395,9 @@ func (g *Game) drawDebugInfo(screen *ebiten.Image, world *core.World) {
 
 	// Отрисовываем камеру информацию
 	g.drawCameraInfo(screen)
+
+	// Отрисовываем время выполнения систем
+	g.drawSystemProfile(screen)
 }
 
 // drawTileGrid отрисовывает сетку тайлов
@@ -496,6 +556,30 @@ func (g *Game) drawCameraInfo(screen *ebiten.Image) {
 	}
 }
 
+// drawSystemProfile отрисовывает время выполнения систем (последний тик и среднее, мс)
+func (g *Game) drawSystemProfile(screen *ebiten.Image) {
+	font := g.fontManager.GetDebugFont()
+	y := 170.0 // Под информацией камеры
+
+	for _, stats := range g.gameWorld.GetSystemStats() {
+		line := fmt.Sprintf("%-8s %-16s %6.3f / %6.3f ms", stats.Phase, stats.Name,
+			float64(stats.Last.Microseconds())/1000, float64(stats.Average().Microseconds())/1000)
+		if !stats.Enabled {
+			line += " (off)"
+		}
+
+		if font != nil {
+			op := &text.DrawOptions{}
+			op.GeoM.Translate(10, y)
+			op.ColorScale.ScaleWithColor(color.RGBA{R: 200, G: 255, B: 200, A: 255})
+			text.Draw(screen, line, font, op)
+		} else {
+			ebitenutil.DebugPrintAt(screen, line, 10, int(y))
+		}
+		y += 16
+	}
+}
+
 // drawFPS отрисовывает FPS счетчик
 func (g *Game) drawFPS(screen *ebiten.Image) {
 	font := g.fontManager.GetDebugFont()
@@ -530,6 +614,8 @@ type CommandLineArgs struct {
 	Interval    int
 	Headless    bool
 	Speed       float64
+	Load        string // Путь к снапшоту для загрузки вместо генерации мира
+	Balance     string // Путь к профилю баланса (YAML)
 }
 
 func main() {
@@ -560,6 +646,8 @@ func parseCommandLineArgs() CommandLineArgs {
 	var intervalFlag = flag.Int("interval", 60, "Интервал между скриншотами в тиках")
 	var headlessFlag = flag.Bool("headless", false, "Запустить в headless режиме")
 	var speedFlag = flag.Float64("speed", 1.0, "Множитель скорости симуляции")
+	var loadFlag = flag.String("load", "", "Загрузить снапшот симуляции из файла (.snap или .json)")
+	var balanceFlag = flag.String("balance", "", "Профиль баланса (YAML), по умолчанию - константы game_balance.go")
 
 	flag.Parse()
 
@@ -575,6 +663,8 @@ func parseCommandLineArgs() CommandLineArgs {
 		Interval:    *intervalFlag,
 		Headless:    *headlessFlag,
 		Speed:       *speedFlag,
+		Load:        *loadFlag,
+		Balance:     *balanceFlag,
 	}
 }
 
@@ -594,9 +684,8 @@ func determineSeed(seedFlag int64) int64 {
 func createGameInstance(args CommandLineArgs) *Game {
 	fmt.Println("Запуск GUI версии симулятора экосистемы саванны...")
 
-	terrain := createGameWorld(args.Seed)
-	gameWorld := NewGameWorld(terrain.Width, terrain.Height, args.Seed, terrain)
-	gameWorld.PopulateWorld(config.LoadDefaultConfig())
+	gameWorld := createOrLoadGameWorld(args)
+	terrain := gameWorld.GetTerrain()
 
 	camera := setupCamera(terrain)
 	screenshotDir := setupVisualTest(args)
@@ -626,6 +715,63 @@ func createGameInstance(args CommandLineArgs) *Game {
 	}
 }
 
+// createOrLoadGameWorld загружает мир из снапшота (--load) или генерирует новый
+func createOrLoadGameWorld(args CommandLineArgs) *GameWorld {
+	// ВАЖНО: виды загружаются до создания мира - типы животных снапшота и анимации должны быть известны
+	loadSpecies(config.LoadDefaultConfig())
+	balance := loadBalanceProfile(args.Balance)
+
+	if args.Load != "" {
+		gameWorld, err := loadGameWorld(args.Load)
+		if err != nil {
+			log.Fatalf("❌ Не удалось загрузить снапшот %s: %v", args.Load, err)
+		}
+		// Профиль снапшота сохраняется, если --balance не задан явно
+		if balance != nil {
+			gameWorld.SetBalanceProfile(balance)
+		}
+		fmt.Printf("Мир загружен из снапшота: %s\n", args.Load)
+		return gameWorld
+	}
+
+	terrain := createGameWorld(args.Seed)
+	gameWorld := NewGameWorld(terrain.Width, terrain.Height, args.Seed, terrain)
+	gameWorld.SetBalanceProfile(balance)
+	if err := gameWorld.PopulateWorld(config.LoadDefaultConfig()); err != nil {
+		log.Fatalf("❌ Не удалось разместить популяцию: %v", err)
+	}
+	return gameWorld
+}
+
+// loadSpecies загружает описания видов из каталога конфигурации
+// Без каталога (запуск не из корня репозитория) остаются только встроенные заяц и волк
+func loadSpecies(cfg *config.Config) {
+	if cfg.Species.Dir == "" {
+		return
+	}
+
+	types, err := simulation.LoadSpeciesDir(cfg.Species.Dir)
+	if err != nil {
+		log.Printf("Предупреждение: не удалось загрузить виды из %s: %v", cfg.Species.Dir, err)
+		return
+	}
+	fmt.Printf("Загружено видов из %s: %d\n", cfg.Species.Dir, len(types))
+}
+
+// loadBalanceProfile загружает профиль баланса (--balance), без флага - значения по умолчанию
+func loadBalanceProfile(path string) *simulation.BalanceProfile {
+	if path == "" {
+		return nil
+	}
+
+	profile, err := simulation.LoadBalanceProfile(path)
+	if err != nil {
+		log.Fatalf("❌ Не удалось загрузить профиль баланса %s: %v", path, err)
+	}
+	fmt.Printf("Профиль баланса: %s (%s)\n", profile.Name, path)
+	return profile
+}
+
 // createGameWorld создает игровой мир и ландшафт
 func createGameWorld(seed int64) *generator.Terrain {
 	cfg := config.LoadDefaultConfig()
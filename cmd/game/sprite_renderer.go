@@ -13,6 +13,8 @@ import (
 	"github.com/aiseeq/savanna/internal/animation"
 	"github.com/aiseeq/savanna/internal/constants"
 	"github.com/aiseeq/savanna/internal/core"
+	"github.com/aiseeq/savanna/internal/pipeline"
+	"github.com/aiseeq/savanna/internal/simulation"
 )
 
 // SpriteRenderer отвечает за загрузку и отрисовку спрайтов животных
@@ -41,9 +43,30 @@ func NewSpriteRenderer() *SpriteRenderer {
 	sr.loadAnimalSprites(core.TypeRabbit, "hare")
 	sr.loadAnimalSprites(core.TypeWolf, "wolf")
 
+	// Виды из файлов описаний загружают спрайты по своему префиксу
+	for _, animalType := range simulation.RegisteredSpecies() {
+		species, _ := simulation.GetSpecies(animalType)
+		sr.loadSpeciesSprites(animalType, species)
+	}
+
 	return sr
 }
 
+// loadSpeciesSprites загружает спрайты вида из файла описания
+func (sr *SpriteRenderer) loadSpeciesSprites(animalType core.AnimalType, species simulation.SpeciesDefinition) {
+	sprites := AnimalSprites{
+		animations: make(map[animation.AnimationType][]*ebiten.Image),
+	}
+
+	for _, anim := range pipeline.SpeciesAnimationDefaults {
+		frames, _ := pipeline.SpeciesAnimationFrames(species, anim)
+		// Без своих спрайтов вид рисуется заглушкой по типу питания
+		sprites.animations[anim.AnimType] = sr.loadAnimationFrames(species.SpritePrefix(), anim.Sprite, frames)
+	}
+
+	sr.animalSprites[animalType] = sprites
+}
+
 // loadAnimalSprites загружает все спрайты для указанного типа животного
 func (sr *SpriteRenderer) loadAnimalSprites(animalType core.AnimalType, prefix string) {
 
@@ -63,6 +86,8 @@ func (sr *SpriteRenderer) loadAnimalSprites(animalType core.AnimalType, prefix s
 		{animation.AnimAttack, "attack", 2},
 		{animation.AnimEat, "eat", 2},
 		{animation.AnimDeathDying, "dead", 2},
+		{animation.AnimBreed, "idle", 2}, // ВРЕМЕННО: отдельных спрайтов размножения пока нет
+		{animation.AnimDrink, "eat", 2},  // ВРЕМЕННО: пьют с той же позой что и едят
 	}
 
 	// Загружаем каждую анимацию
@@ -168,11 +193,17 @@ func (sr *SpriteRenderer) DrawAnimal(
 
 	// Масштабирование (разное для разных животных)
 	var spriteScale float64
-	if animalType == core.TypeRabbit {
+	if species, isSpecies := simulation.GetSpecies(animalType); isSpecies && species.Sprite.Scale > 0 {
+		spriteScale = float64(params.Zoom) * species.Sprite.Scale // Масштаб из файла вида
+	} else if animalType == core.TypeRabbit {
 		spriteScale = float64(params.Zoom) * constants.RabbitSpriteScale // Масштаб спрайта зайца
 	} else {
 		spriteScale = float64(params.Zoom) * constants.WolfSpriteScale // Масштаб спрайта волка
 	}
+	// Размер тела зависит от стадии жизни (детёныши меньше взрослых)
+	if age, hasAge := world.GetAge(entity); hasAge {
+		spriteScale *= float64(age.SizeMultiplier)
+	}
 	op.GeoM.Scale(spriteScale, spriteScale)
 
 	// Отражение по горизонтали если животное смотрит влево
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"runtime"
+	"time"
+
+	"github.com/aiseeq/savanna/config"
+	"github.com/aiseeq/savanna/internal/batch"
+	"github.com/aiseeq/savanna/internal/lifestats"
+	"github.com/aiseeq/savanna/internal/simulation"
+)
+
+// Headless пакетная симуляция для Monte-Carlo исследований баланса (без ebiten и ожидания кадров)
+//
+//	go run ./cmd/savanna-sim -seeds 1-100 -ticks 36000 -interval 600 -format csv -out stats.csv
+//	go run ./cmd/savanna-sim -config my_world.yaml -balance my_balance.yaml -seeds 1-20 -format jsonl
+//	go run ./cmd/savanna-sim -seeds 1-20 -life-out deaths.csv
+
+func main() {
+	configPath := flag.String("config", "", "Конфигурация мира (YAML), пусто - значения по умолчанию")
+	balancePath := flag.String("balance", "", "Профиль баланса (YAML), пусто - значения по умолчанию")
+	seedsFlag := flag.String("seeds", "1", "Seed: список и диапазоны, например 1-100,200")
+	ticks := flag.Int("ticks", batch.DefaultTicks, "Количество тиков каждого прогона")
+	interval := flag.Int("interval", batch.DefaultInterval, "Период записи статистики (тики)")
+	timeStep := flag.Float64("dt", batch.DefaultTimeStep, "Фиксированный шаг симуляции (секунды)")
+	parallel := flag.Int("parallel", runtime.NumCPU(), "Количество параллельных прогонов")
+	workers := flag.Int("workers", 1, "Потоки секторов карты внутри прогона (результат не меняется)")
+	format := flag.String("format", batch.FormatCSV, "Формат вывода: csv или jsonl")
+	outPath := flag.String("out", "", "Файл результатов, пусто - stdout")
+	lifeOut := flag.String("life-out", "", "Файл статистики жизней и причин смертей, пусто - не собирать")
+	lifeFormat := flag.String("life-format", lifestats.FormatCSV, "Формат статистики жизней: csv (по видам) или json (с историями)")
+	flag.Parse()
+
+	if *format != batch.FormatCSV && *format != batch.FormatJSONL {
+		log.Fatalf("❌ Неизвестный формат %q (csv или jsonl)", *format)
+	}
+	if *lifeFormat != lifestats.FormatCSV && *lifeFormat != lifestats.FormatJSON {
+		log.Fatalf("❌ Неизвестный формат статистики жизней %q (csv или json)", *lifeFormat)
+	}
+
+	cfg, err := loadConfig(*configPath)
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+
+	balance, err := loadBalance(*balancePath)
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+
+	seeds, err := batch.ParseSeeds(*seedsFlag)
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+
+	// Виды загружаются до прогонов: реестр видов только читается параллельными симуляциями
+	if cfg.Species.Dir != "" {
+		if _, err := simulation.LoadSpeciesDir(cfg.Species.Dir); err != nil {
+			log.Printf("Предупреждение: не удалось загрузить виды из %s: %v", cfg.Species.Dir, err)
+		}
+	}
+
+	runConfig := batch.RunConfig{
+		Config:   cfg,
+		Balance:  balance,
+		Ticks:    *ticks,
+		Interval: *interval,
+		TimeStep: float32(*timeStep),
+		Workers:  *workers,
+
+		LifeStats: *lifeOut != "",
+	}
+
+	start := time.Now()
+	results, err := batch.RunSeeds(seeds, runConfig, *parallel)
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+
+	if err := writeResults(*outPath, *format, results); err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+	if *lifeOut != "" {
+		if err := writeLifeStats(*lifeOut, *lifeFormat, results); err != nil {
+			log.Fatalf("❌ %v", err)
+		}
+	}
+
+	// Сводка в stderr чтобы не смешиваться с данными в stdout
+	fmt.Fprintf(os.Stderr, "Прогонов: %d, тиков в каждом: %d, время: %v\n",
+		len(results), *ticks, time.Since(start).Round(time.Millisecond))
+}
+
+// loadConfig загружает конфигурацию мира (пустой путь - конфигурация по умолчанию)
+func loadConfig(path string) (*config.Config, error) {
+	if path == "" {
+		return config.LoadDefaultConfig(), nil
+	}
+
+	cfg, err := config.LoadConfig(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// loadBalance загружает профиль баланса (пустой путь - значения по умолчанию)
+func loadBalance(path string) (*simulation.BalanceProfile, error) {
+	if path == "" {
+		return nil, nil
+	}
+	return simulation.LoadBalanceProfile(path)
+}
+
+// writeResults записывает статистику в файл или stdout
+func writeResults(path, format string, results []batch.RunResult) error {
+	out := os.Stdout
+	if path != "" {
+		file, err := os.Create(path)
+		if err != nil {
+			return fmt.Errorf("failed to create %s: %w", path, err)
+		}
+		defer file.Close()
+		out = file
+	}
+
+	species := make([]string, 0)
+	for _, animalType := range batch.ReportSpecies() {
+		species = append(species, batch.SpeciesName(animalType))
+	}
+
+	return batch.WriteResults(out, format, species, results)
+}
+
+// writeLifeStats записывает статистику жизней всех прогонов в файл
+func writeLifeStats(path, format string, results []batch.RunResult) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", path, err)
+	}
+	defer file.Close()
+
+	reports := make([]lifestats.Report, 0, len(results))
+	for _, result := range results {
+		reports = append(reports, *result.Life)
+	}
+	return lifestats.WriteReports(file, format, reports)
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"runtime"
+	"time"
+
+	"github.com/aiseeq/savanna/config"
+	"github.com/aiseeq/savanna/internal/batch"
+	"github.com/aiseeq/savanna/internal/simulation"
+	"github.com/aiseeq/savanna/internal/sweep"
+)
+
+// Перебор параметров популяций и баланса: какие комбинации сохраняют и зайцев, и волков
+//
+//	go run ./cmd/savanna-sweep -spec config/sweep.yaml -out sweep_report.json
+//	go run ./cmd/savanna-sweep -spec my_sweep.yaml -balance my_balance.yaml -seeds 1-50 -duration 1200
+
+func main() {
+	specPath := flag.String("spec", "config/sweep.yaml", "Описание перебора параметров (YAML)")
+	configPath := flag.String("config", "", "Базовая конфигурация мира (YAML), пусто - значения по умолчанию")
+	balancePath := flag.String("balance", "", "Базовый профиль баланса (YAML), пусто - значения по умолчанию")
+	seedsFlag := flag.String("seeds", "", "Seed каждой комбинации (переопределяет seeds из описания)")
+	duration := flag.Float64("duration", 0, "Целевая длительность в секундах (0 - из описания)")
+	timeStep := flag.Float64("dt", batch.DefaultTimeStep, "Фиксированный шаг симуляции (секунды)")
+	parallel := flag.Int("parallel", runtime.NumCPU(), "Количество параллельных прогонов")
+	outPath := flag.String("out", "", "Файл JSON отчёта, пусто - stdout")
+	flag.Parse()
+
+	spec, err := sweep.LoadSpec(*specPath)
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+	if *seedsFlag != "" {
+		spec.Seeds = *seedsFlag
+	}
+	if *duration > 0 {
+		spec.Duration = *duration
+	}
+
+	seeds, err := batch.ParseSeeds(spec.Seeds)
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+
+	cfg, err := loadConfig(*configPath)
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+
+	var balance *simulation.BalanceProfile
+	if *balancePath != "" {
+		if balance, err = simulation.LoadBalanceProfile(*balancePath); err != nil {
+			log.Fatalf("❌ %v", err)
+		}
+	}
+
+	// Виды загружаются до прогонов: реестр видов только читается параллельными симуляциями
+	if cfg.Species.Dir != "" {
+		if _, err := simulation.LoadSpeciesDir(cfg.Species.Dir); err != nil {
+			log.Printf("Предупреждение: не удалось загрузить виды из %s: %v", cfg.Species.Dir, err)
+		}
+	}
+
+	start := time.Now()
+	report, err := sweep.Run(spec, seeds, sweep.Options{
+		Config:   cfg,
+		Balance:  balance,
+		TimeStep: float32(*timeStep),
+		Parallel: *parallel,
+	})
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+
+	if err := writeReport(*outPath, report); err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+
+	// Сводка в stderr чтобы не смешиваться с JSON в stdout
+	fmt.Fprintf(os.Stderr, "Комбинаций: %d, seed в каждой: %d, длительность: %.0fс, время: %v\n\n",
+		len(report.Combinations), len(seeds), spec.Duration, time.Since(start).Round(time.Millisecond))
+	if err := sweep.WriteSummary(os.Stderr, report); err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+}
+
+// loadConfig загружает конфигурацию мира (пустой путь - конфигурация по умолчанию)
+func loadConfig(path string) (*config.Config, error) {
+	if path == "" {
+		return config.LoadDefaultConfig(), nil
+	}
+
+	cfg, err := config.LoadConfig(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// writeReport записывает JSON отчёт в файл или stdout
+func writeReport(path string, report *sweep.Report) error {
+	out := os.Stdout
+	if path != "" {
+		file, err := os.Create(path)
+		if err != nil {
+			return fmt.Errorf("failed to create %s: %w", path, err)
+		}
+		defer file.Close()
+		out = file
+	}
+
+	return sweep.WriteJSON(out, report)
+}
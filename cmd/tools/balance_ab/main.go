@@ -0,0 +1,186 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/aiseeq/savanna/internal/core"
+	"github.com/aiseeq/savanna/internal/gamestate"
+	"github.com/aiseeq/savanna/internal/simulation"
+)
+
+// A/B сравнение профилей баланса: одинаковые seed прогоняются headless с каждым профилем
+//
+//	go run ./cmd/tools/balance_ab -a config/balance.yaml -b my_balance.yaml -seeds 1,2,3 -duration 300
+
+const (
+	defaultWorldSize = 1600.0 // Размер мира в пикселях (50x50 тайлов)
+	fixedTimeStep    = 1.0 / 60.0
+)
+
+// populationResult итоги одного прогона
+type populationResult struct {
+	rabbits, wolves int
+	minRabbits      int
+	minWolves       int
+}
+
+// profileSummary сводка по всем seed для одного профиля
+type profileSummary struct {
+	name                  string
+	results               []populationResult
+	rabbitsExtinct        int
+	wolvesExtinct         int
+	avgRabbits, avgWolves float64
+}
+
+func main() {
+	profileA := flag.String("a", "", "Профиль баланса A (YAML), пусто - значения по умолчанию")
+	profileB := flag.String("b", "", "Профиль баланса B (YAML), пусто - значения по умолчанию")
+	seedsFlag := flag.String("seeds", "1,2,3,4,5", "Seed через запятую")
+	duration := flag.Float64("duration", 300, "Длительность прогона (секунды симуляции)")
+	writeDefault := flag.String("write-default", "", "Записать профиль по умолчанию в файл и выйти")
+	flag.Parse()
+
+	if *writeDefault != "" {
+		if err := simulation.SaveBalanceProfile(simulation.DefaultBalanceProfile(), *writeDefault); err != nil {
+			log.Fatalf("❌ %v", err)
+		}
+		fmt.Printf("Профиль по умолчанию записан в %s\n", *writeDefault)
+		return
+	}
+
+	seeds, err := parseSeeds(*seedsFlag)
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+
+	summaries := make([]profileSummary, 0, 2)
+	for _, path := range []string{*profileA, *profileB} {
+		profile, err := loadProfile(path)
+		if err != nil {
+			log.Fatalf("❌ %v", err)
+		}
+		summaries = append(summaries, runProfile(profile, seeds, *duration))
+	}
+
+	printReport(summaries, seeds, *duration)
+}
+
+// parseSeeds разбирает список seed через запятую
+func parseSeeds(value string) ([]int64, error) {
+	var seeds []int64
+	for _, part := range strings.Split(value, ",") {
+		seed, err := strconv.ParseInt(strings.TrimSpace(part), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid seed %q: %w", part, err)
+		}
+		seeds = append(seeds, seed)
+	}
+	return seeds, nil
+}
+
+// loadProfile загружает профиль баланса (пустой путь - профиль по умолчанию)
+func loadProfile(path string) (*simulation.BalanceProfile, error) {
+	if path == "" {
+		return simulation.DefaultBalanceProfile(), nil
+	}
+	return simulation.LoadBalanceProfile(path)
+}
+
+// runProfile прогоняет все seed с одним профилем
+func runProfile(profile *simulation.BalanceProfile, seeds []int64, duration float64) profileSummary {
+	summary := profileSummary{name: profile.Name}
+
+	for _, seed := range seeds {
+		result := runSimulation(profile, seed, duration)
+		summary.results = append(summary.results, result)
+
+		summary.avgRabbits += float64(result.rabbits)
+		summary.avgWolves += float64(result.wolves)
+		if result.rabbits == 0 {
+			summary.rabbitsExtinct++
+		}
+		if result.wolves == 0 {
+			summary.wolvesExtinct++
+		}
+	}
+
+	summary.avgRabbits /= float64(len(seeds))
+	summary.avgWolves /= float64(len(seeds))
+	return summary
+}
+
+// runSimulation выполняет один headless прогон и собирает численность популяций
+func runSimulation(profile *simulation.BalanceProfile, seed int64, duration float64) populationResult {
+	gs := gamestate.NewGameState(&gamestate.GameConfig{
+		WorldWidth:    defaultWorldSize,
+		WorldHeight:   defaultWorldSize,
+		FixedTimeStep: fixedTimeStep,
+		RandomSeed:    seed,
+		Balance:       profile,
+	})
+
+	rabbits, wolves := countAnimals(gs.GetWorld())
+	result := populationResult{minRabbits: rabbits, minWolves: wolves}
+
+	ticks := int(duration / fixedTimeStep)
+	for tick := 0; tick < ticks; tick++ {
+		gs.Update()
+
+		rabbits, wolves = countAnimals(gs.GetWorld())
+		result.minRabbits = min(result.minRabbits, rabbits)
+		result.minWolves = min(result.minWolves, wolves)
+	}
+
+	result.rabbits, result.wolves = rabbits, wolves
+	return result
+}
+
+// countAnimals считает живых зайцев и волков (трупы не учитываются)
+func countAnimals(world *core.World) (rabbits, wolves int) {
+	world.ForEachWith(core.MaskAnimalType, func(entity core.EntityID) {
+		if world.HasComponent(entity, core.MaskCorpse) {
+			return
+		}
+
+		animalType, _ := world.GetAnimalType(entity)
+		switch animalType {
+		case core.TypeRabbit:
+			rabbits++
+		case core.TypeWolf:
+			wolves++
+		}
+	})
+	return rabbits, wolves
+}
+
+// printReport выводит результаты профилей рядом для сравнения
+func printReport(summaries []profileSummary, seeds []int64, duration float64) {
+	fmt.Printf("A/B сравнение баланса: %d seed, %.0f секунд симуляции\n\n", len(seeds), duration)
+
+	fmt.Printf("%-10s", "seed")
+	for i, summary := range summaries {
+		fmt.Printf(" | %c: %-24s", 'A'+i, summary.name)
+	}
+	fmt.Println()
+
+	for i, seed := range seeds {
+		fmt.Printf("%-10d", seed)
+		for _, summary := range summaries {
+			result := summary.results[i]
+			fmt.Printf(" | зайцы %3d (мин %3d) волки %2d", result.rabbits, result.minRabbits, result.wolves)
+		}
+		fmt.Println()
+	}
+
+	fmt.Println()
+	for i, summary := range summaries {
+		fmt.Printf("%c (%s): в среднем зайцев %.1f, волков %.1f; вымирание зайцев %d/%d, волков %d/%d\n",
+			'A'+i, summary.name, summary.avgRabbits, summary.avgWolves,
+			summary.rabbitsExtinct, len(seeds), summary.wolvesExtinct, len(seeds))
+	}
+}
@@ -15,6 +15,8 @@ const (
 	AnimDeathDecay
 	AnimEat
 	AnimAttack
+	AnimBreed
+	AnimDrink
 )
 
 func main() {
@@ -29,6 +31,8 @@ func main() {
 		AnimAttack:     "attack",
 		AnimEat:        "eat",
 		AnimDeathDying: "dead",
+		AnimBreed:      "idle",
+		AnimDrink:      "eat",
 	}
 
 	fmt.Println("\n📋 Соответствие enum -> спрайты:")
@@ -42,7 +46,9 @@ func main() {
 
 	// Проверяем все значения enum
 	fmt.Println("\n📊 Полный список AnimationType:")
-	allAnims := []AnimationType{AnimIdle, AnimWalk, AnimRun, AnimDeathDying, AnimDeathDecay, AnimEat, AnimAttack}
+	allAnims := []AnimationType{
+		AnimIdle, AnimWalk, AnimRun, AnimDeathDying, AnimDeathDecay, AnimEat, AnimAttack, AnimBreed, AnimDrink,
+	}
 	for _, anim := range allAnims {
 		spriteName, loaded := loadedAnimations[anim]
 		status := "❌ НЕ ЗАГРУЖЕН"
@@ -80,6 +86,10 @@ func getAnimName(anim AnimationType) string {
 		return "AnimEat"
 	case AnimAttack:
 		return "AnimAttack"
+	case AnimBreed:
+		return "AnimBreed"
+	case AnimDrink:
+		return "AnimDrink"
 	default:
 		return "Unknown"
 	}
@@ -12,6 +12,12 @@ type Config struct {
 	World      WorldConfig      `yaml:"world"`
 	Terrain    TerrainConfig    `yaml:"terrain"`
 	Population PopulationConfig `yaml:"population"`
+	Species    SpeciesConfig    `yaml:"species"`
+}
+
+// SpeciesConfig набор видов животных
+type SpeciesConfig struct {
+	Dir string `yaml:"dir"` // Каталог YAML файлов описаний видов (пусто - только встроенные заяц и волк)
 }
 
 // WorldConfig настройки мира
@@ -35,6 +41,17 @@ type PopulationConfig struct {
 	Wolves          int `yaml:"wolves"`            // Количество волков
 	RabbitGroupSize int `yaml:"rabbit_group_size"` // Размер группы зайцев
 	MinWolfDistance int `yaml:"min_wolf_distance"` // Минимальная дистанция между волками
+
+	// Популяции видов из файлов описаний (размещаются после зайцев и волков)
+	Species []SpeciesPopulation `yaml:"species"`
+}
+
+// SpeciesPopulation начальная популяция одного вида
+type SpeciesPopulation struct {
+	Name        string `yaml:"name"`         // Имя вида из файла описания
+	Count       int    `yaml:"count"`        // Количество особей
+	GroupSize   int    `yaml:"group_size"`   // Размер группы (0 или 1 - поодиночке)
+	MinDistance int    `yaml:"min_distance"` // Минимальная дистанция между одиночками (тайлы)
 }
 
 // LoadConfig загружает конфигурацию из файла
@@ -71,6 +88,9 @@ const (
 	DefaultRabbitGroupSize = 3  // Размер группы зайцев
 	DefaultMinWolfDistance = 15 // Минимальное расстояние между волками (тайлы) - ИСПРАВЛЕНО: уменьшено для плотности
 
+	// Каталог описаний видов животных
+	DefaultSpeciesDir = "config/species"
+
 	// Права доступа к файлам
 	ConfigFilePermissions = 0600 // Права доступа к файлу конфигурации (только владелец)
 )
@@ -95,6 +115,9 @@ func LoadDefaultConfig() *Config {
 			RabbitGroupSize: DefaultRabbitGroupSize,
 			MinWolfDistance: DefaultMinWolfDistance,
 		},
+		Species: SpeciesConfig{
+			Dir: DefaultSpeciesDir,
+		},
 	}
 }
 
@@ -140,5 +163,14 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("rabbit group size must be at least 1, got %d", c.Population.RabbitGroupSize)
 	}
 
+	for _, species := range c.Population.Species {
+		if species.Name == "" {
+			return fmt.Errorf("species population must have a name")
+		}
+		if species.Count < 0 || species.GroupSize < 0 || species.MinDistance < 0 {
+			return fmt.Errorf("species %s population values cannot be negative", species.Name)
+		}
+	}
+
 	return nil
 }
@@ -95,6 +95,18 @@ func (a *StarvationDamageSystemAdapter) Update(world *core.World, deltaTime floa
 	a.System.Update(world, deltaTime)
 }
 
+// ThirstSystemAdapter адаптирует ThirstSystem к старому интерфейсу System
+type ThirstSystemAdapter struct {
+	System *simulation.ThirstSystem
+}
+
+func (a *ThirstSystemAdapter) Update(world *core.World, deltaTime float32) {
+	if a.System == nil {
+		return
+	}
+	a.System.Update(world, deltaTime)
+}
+
 // BehaviorSystemAdapter адаптирует AnimalBehaviorSystem к старому интерфейсу System
 type BehaviorSystemAdapter struct {
 	System *simulation.AnimalBehaviorSystem
@@ -124,3 +136,36 @@ type GrassEatingSystemAdapter struct {
 func (a *GrassEatingSystemAdapter) Update(world *core.World, deltaTime float32) {
 	a.System.Update(world, deltaTime)
 }
+
+// Проброс состояния для снапшотов (core.StatefulSystem)
+// Адаптеры регистрируются в SystemManager вместо самих систем, поэтому должны делегировать
+
+// SaveState делегирует сохранение состояния StarvationDamageSystem
+func (a *StarvationDamageSystemAdapter) SaveState() core.SystemState {
+	return a.System.SaveState()
+}
+
+// LoadState делегирует загрузку состояния StarvationDamageSystem
+func (a *StarvationDamageSystemAdapter) LoadState(state core.SystemState) {
+	a.System.LoadState(state)
+}
+
+// SaveState делегирует сохранение состояния GrassEatingSystem
+func (a *GrassEatingSystemAdapter) SaveState() core.SystemState {
+	return a.System.SaveState()
+}
+
+// LoadState делегирует загрузку состояния GrassEatingSystem
+func (a *GrassEatingSystemAdapter) LoadState(state core.SystemState) {
+	a.System.LoadState(state)
+}
+
+// SaveState делегирует сохранение состояния ThirstSystem
+func (a *ThirstSystemAdapter) SaveState() core.SystemState {
+	return a.System.SaveState()
+}
+
+// LoadState делегирует загрузку состояния ThirstSystem
+func (a *ThirstSystemAdapter) LoadState(state core.SystemState) {
+	a.System.LoadState(state)
+}
@@ -1,7 +1,5 @@
 package animation
 
-import "github.com/hajimehoshi/ebiten/v2"
-
 // AnimationConfig конфигурация анимации (устраняет дублирование параметров)
 type AnimationConfig struct {
 	Frames   int
@@ -19,6 +17,8 @@ const (
 	AttackFrameCount = 2
 	EatFrameCount    = 2
 	DeathFrameCount  = 1
+	BreedFrameCount  = 2
+	DrinkFrameCount  = 2
 
 	// Скорости анимаций (FPS)
 	IdleFPS   = 1.0  // Медленная анимация для покоя
@@ -27,6 +27,8 @@ const (
 	AttackFPS = 6.0  // Скорость атаки
 	EatFPS    = 2.0  // Скорость поедания
 	DeathFPS  = 1.0  // Скорость смерти (статичная)
+	BreedFPS  = 4.0  // Скорость анимации размножения
+	DrinkFPS  = 2.0  // Скорость питья (как поедание)
 )
 
 // StandardAnimationConfigs стандартные конфигурации анимаций (устраняет дублирование)
@@ -67,6 +69,18 @@ var StandardAnimationConfigs = map[AnimationType]AnimationConfig{
 		Loop:     false,
 		AnimType: AnimDeathDying,
 	},
+	AnimBreed: {
+		Frames:   BreedFrameCount,
+		FPS:      BreedFPS,
+		Loop:     true,
+		AnimType: AnimBreed,
+	},
+	AnimDrink: {
+		Frames:   DrinkFrameCount,
+		FPS:      DrinkFPS,
+		Loop:     true,
+		AnimType: AnimDrink,
+	},
 }
 
 // AnimationLoader загрузчик анимаций
@@ -78,7 +92,7 @@ func NewAnimationLoader() *AnimationLoader {
 }
 
 // LoadWolfAnimations загружает стандартные анимации волка (устраняет дублирование)
-func (al *AnimationLoader) LoadWolfAnimations(animSystem *AnimationSystem, spriteImage *ebiten.Image) {
+func (al *AnimationLoader) LoadWolfAnimations(animSystem *AnimationSystem, spriteImage SpriteSheet) {
 	// Определяем какие анимации нужны волку
 	wolfAnimations := []AnimationType{
 		AnimIdle,
@@ -86,6 +100,8 @@ func (al *AnimationLoader) LoadWolfAnimations(animSystem *AnimationSystem, sprit
 		AnimRun,
 		AnimAttack,
 		AnimEat,
+		AnimBreed,
+		AnimDrink,
 	}
 
 	// Загружаем каждую анимацию
@@ -96,7 +112,7 @@ func (al *AnimationLoader) LoadWolfAnimations(animSystem *AnimationSystem, sprit
 }
 
 // LoadRabbitAnimations загружает стандартные анимации зайца (устраняет дублирование)
-func (al *AnimationLoader) LoadRabbitAnimations(animSystem *AnimationSystem, spriteImage *ebiten.Image) {
+func (al *AnimationLoader) LoadRabbitAnimations(animSystem *AnimationSystem, spriteImage SpriteSheet) {
 	// Определяем какие анимации нужны зайцу
 	rabbitAnimations := []AnimationType{
 		AnimIdle,
@@ -104,6 +120,8 @@ func (al *AnimationLoader) LoadRabbitAnimations(animSystem *AnimationSystem, spr
 		AnimRun,
 		AnimEat, // ИСПРАВЛЕНИЕ: зайцы тоже едят траву!
 		AnimDeathDying,
+		AnimBreed,
+		AnimDrink,
 	}
 
 	// Загружаем каждую анимацию
@@ -116,7 +134,7 @@ func (al *AnimationLoader) LoadRabbitAnimations(animSystem *AnimationSystem, spr
 // LoadAnimations загружает анимации с реальными спрайтами
 func (al *AnimationLoader) LoadAnimations(
 	wolfSystem, rabbitSystem *AnimationSystem,
-	wolfSprite, rabbitSprite *ebiten.Image,
+	wolfSprite, rabbitSprite SpriteSheet,
 ) {
 	al.LoadWolfAnimations(wolfSystem, wolfSprite)
 	al.LoadRabbitAnimations(rabbitSystem, rabbitSprite)
@@ -25,6 +25,24 @@ func (ar *AnimationResolver) ResolveAnimalAnimationType(
 		return ar.resolveWolfAnimationType(world, entity)
 	case core.TypeRabbit:
 		return ar.resolveRabbitAnimationType(world, entity)
+	default:
+		return ar.resolveSpeciesAnimationType(world, entity)
+	}
+}
+
+// resolveSpeciesAnimationType определяет анимацию вида из файла описания по типу питания
+// Хищники и падальщики анимируются как волк, травоядные и всеядные как заяц
+func (ar *AnimationResolver) resolveSpeciesAnimationType(world *core.World, entity core.EntityID) AnimationType {
+	config, hasConfig := world.GetAnimalConfig(entity)
+	if !hasConfig {
+		return AnimIdle
+	}
+
+	switch config.Diet {
+	case core.BehaviorPredator, core.BehaviorScavenger:
+		return ar.resolveWolfAnimationType(world, entity)
+	case core.BehaviorHerbivore, core.BehaviorOmnivore:
+		return ar.resolveRabbitAnimationType(world, entity)
 	default:
 		return AnimIdle
 	}
@@ -42,7 +60,17 @@ func (ar *AnimationResolver) resolveWolfAnimationType(world *core.World, entity
 		return AnimAttack
 	}
 
-	// ПРИОРИТЕТ 3: Движение
+	// ПРИОРИТЕТ 3: Питьё
+	if world.HasComponent(entity, core.MaskDrinkingState) {
+		return AnimDrink
+	}
+
+	// ПРИОРИТЕТ 4: Спаривание
+	if ar.isBreeding(world, entity) {
+		return AnimBreed
+	}
+
+	// ПРИОРИТЕТ 5: Движение
 	velocity, hasVel := world.GetVelocity(entity)
 	if !hasVel {
 		return AnimIdle
@@ -72,7 +100,17 @@ func (ar *AnimationResolver) resolveRabbitAnimationType(world *core.World, entit
 		return AnimEat
 	}
 
-	// ПРИОРИТЕТ 3: Движение
+	// ПРИОРИТЕТ 3: Питьё
+	if world.HasComponent(entity, core.MaskDrinkingState) {
+		return AnimDrink
+	}
+
+	// ПРИОРИТЕТ 4: Спаривание
+	if ar.isBreeding(world, entity) {
+		return AnimBreed
+	}
+
+	// ПРИОРИТЕТ 5: Движение
 	velocity, hasVel := world.GetVelocity(entity)
 	if !hasVel {
 		return AnimIdle
@@ -90,6 +128,12 @@ func (ar *AnimationResolver) resolveRabbitAnimationType(world *core.World, entit
 	}
 }
 
+// isBreeding проверяет идёт ли анимация спаривания (таймер выставляет ReproductionSystem)
+func (ar *AnimationResolver) isBreeding(world *core.World, entity core.EntityID) bool {
+	cooldown, hasCooldown := world.GetReproductionCooldown(entity)
+	return hasCooldown && cooldown.BreedTimer > 0
+}
+
 // isWolfAttacking проверяет атакует ли волк
 func (ar *AnimationResolver) isWolfAttacking(world *core.World, wolf core.EntityID) bool {
 	satiation, hasSatiation := world.GetSatiation(wolf)
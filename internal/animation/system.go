@@ -5,7 +5,6 @@ import (
 	"math"
 
 	"github.com/aiseeq/savanna/internal/constants"
-	"github.com/hajimehoshi/ebiten/v2"
 )
 
 // AnimationType тип анимации - используем из constants
@@ -20,6 +19,8 @@ const (
 	AnimDeathDecay = constants.AnimDeathDecay
 	AnimEat        = constants.AnimEat
 	AnimAttack     = constants.AnimAttack
+	AnimBreed      = constants.AnimBreed
+	AnimDrink      = constants.AnimDrink
 )
 
 // Константы анимационной системы
@@ -44,13 +45,21 @@ const (
 
 // String method is now available from constants.AnimationType
 
+// SpriteSheet спрайт-лист анимации (реализуется *ebiten.Image)
+// ИСПРАВЛЕНИЕ: интерфейс вместо *ebiten.Image - пакет не зависит от ebiten,
+// поэтому headless прогоны (savanna-sim) работают без дисплея
+type SpriteSheet interface {
+	Bounds() image.Rectangle
+	SubImage(r image.Rectangle) image.Image
+}
+
 // AnimationData описывает параметры анимации
 type AnimationData struct {
 	Type        AnimationType
-	Frames      int           // Количество кадров
-	FPS         float32       // Кадров в секунду
-	Loop        bool          // Зациклена ли анимация
-	SpriteSheet *ebiten.Image // Спрайт-лист (может быть nil если не загружен)
+	Frames      int         // Количество кадров
+	FPS         float32     // Кадров в секунду
+	Loop        bool        // Зациклена ли анимация
+	SpriteSheet SpriteSheet // Спрайт-лист (может быть nil если не загружен)
 }
 
 // AnimationComponent компонент анимации для ECS
@@ -76,7 +85,7 @@ func NewAnimationSystem() *AnimationSystem {
 
 // RegisterAnimation регистрирует анимацию в системе
 func (as *AnimationSystem) RegisterAnimation(
-	animType AnimationType, frames int, fps float32, loop bool, spriteSheet *ebiten.Image,
+	animType AnimationType, frames int, fps float32, loop bool, spriteSheet SpriteSheet,
 ) {
 	as.animations[animType] = &AnimationData{
 		Type:        animType,
@@ -129,8 +138,8 @@ func (as *AnimationSystem) Update(anim *AnimationComponent, deltaTime float32) {
 	}
 }
 
-// GetFrameImage возвращает изображение текущего кадра
-func (as *AnimationSystem) GetFrameImage(anim *AnimationComponent) *ebiten.Image {
+// GetFrameImage возвращает изображение текущего кадра (для *ebiten.Image спрайтов - *ebiten.Image)
+func (as *AnimationSystem) GetFrameImage(anim *AnimationComponent) image.Image {
 	animData := as.GetAnimation(anim.CurrentAnim)
 	if animData == nil || animData.SpriteSheet == nil {
 		return nil
@@ -160,9 +169,7 @@ func (as *AnimationSystem) GetFrameImage(anim *AnimationComponent) *ebiten.Image
 	y := row * frameHeight
 
 	// Создаем изображение кадра
-	frameImg := animData.SpriteSheet.SubImage(image.Rect(x, y, x+frameWidth, y+frameHeight)).(*ebiten.Image)
-
-	return frameImg
+	return animData.SpriteSheet.SubImage(image.Rect(x, y, x+frameWidth, y+frameHeight))
 }
 
 // getFramesPerRow возвращает оптимальное количество кадров в ряду для сетки
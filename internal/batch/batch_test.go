@@ -0,0 +1,247 @@
+package batch
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aiseeq/savanna/config"
+	"github.com/aiseeq/savanna/internal/core"
+	"github.com/aiseeq/savanna/internal/generator"
+	"github.com/aiseeq/savanna/internal/simulation"
+)
+
+// testRunConfig короткий прогон на маленькой карте (без файлов видов - только заяц и волк)
+func testRunConfig() RunConfig {
+	cfg := config.LoadDefaultConfig()
+	cfg.World.Size = 24
+	cfg.Population.Rabbits = 12
+	cfg.Population.Wolves = 2
+	cfg.Species.Dir = ""
+
+	return RunConfig{Config: cfg, Ticks: 600, Interval: 120, TimeStep: DefaultTimeStep}
+}
+
+func TestRun_Deterministic(t *testing.T) {
+	rc := testRunConfig()
+
+	first, err := Run(5, rc)
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	second, err := Run(5, rc)
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+
+	if !reflect.DeepEqual(first, second) {
+		t.Error("Runs with the same seed should produce identical statistics")
+	}
+
+	// Начальная строка + строка на каждый интервал
+	if len(first.Intervals) != rc.Ticks/rc.Interval+1 {
+		t.Errorf("Expected %d intervals, got %d", rc.Ticks/rc.Interval+1, len(first.Intervals))
+	}
+	if initial := first.Intervals[0]; initial.Population["rabbit"] != 12 || initial.Population["wolf"] != 2 {
+		t.Errorf("Initial population should match config, got %v", initial.Population)
+	}
+}
+
+func TestRun_UnknownSpeciesFails(t *testing.T) {
+	rc := testRunConfig()
+	cfg := *rc.Config
+	cfg.Population.Species = []config.SpeciesPopulation{{Name: "unicorn", Count: 3}}
+	rc.Config = &cfg
+
+	if _, err := Run(5, rc); !errors.Is(err, generator.ErrUnknownSpecies) {
+		t.Errorf("Run with an unregistered species should fail, got %v", err)
+	}
+}
+
+func TestRunSeeds_ParallelMatchesSerial(t *testing.T) {
+	rc := testRunConfig()
+	seeds := []int64{1, 2, 3, 4}
+
+	serial, err := RunSeeds(seeds, rc, 1)
+	if err != nil {
+		t.Fatalf("Serial runs failed: %v", err)
+	}
+	parallel, err := RunSeeds(seeds, rc, 4)
+	if err != nil {
+		t.Fatalf("Parallel runs failed: %v", err)
+	}
+
+	if !reflect.DeepEqual(serial, parallel) {
+		t.Error("Parallel runs should produce the same results as serial runs")
+	}
+	for i, result := range parallel {
+		if result.Seed != seeds[i] {
+			t.Errorf("Results should keep seed order: got seed %d at %d", result.Seed, i)
+		}
+	}
+	if rc.Config.World.Seed != config.DefaultWorldSeed {
+		t.Error("Runs should not modify the shared config")
+	}
+}
+
+func TestRun_SectorWorkersMatchSerial(t *testing.T) {
+	rc := testRunConfig()
+	parallelConfig := rc
+	parallelConfig.Workers = 4
+
+	for seed := int64(1); seed <= 8; seed++ {
+		serial, err := Run(seed, rc)
+		if err != nil {
+			t.Fatalf("Serial run failed: %v", err)
+		}
+		parallel, err := Run(seed, parallelConfig)
+		if err != nil {
+			t.Fatalf("Parallel run failed: %v", err)
+		}
+
+		if !reflect.DeepEqual(serial, parallel) {
+			t.Errorf("Seed %d: sector workers should not change results", seed)
+		}
+	}
+}
+
+func TestCollector_CountsBirthsDeathsAndKills(t *testing.T) {
+	world := core.NewWorld(20, 20, 1)
+	terrain := &generator.Terrain{Width: 1, Height: 1, Grass: [][]float32{{40}}}
+
+	eaten := simulation.CreateAnimal(world, core.TypeRabbit, 100, 100)
+	starved := simulation.CreateAnimal(world, core.TypeRabbit, 200, 200)
+	simulation.CreateAnimal(world, core.TypeWolf, 300, 300)
+
+	collector := NewCollector(world, []core.AnimalType{core.TypeRabbit, core.TypeWolf})
+
+	simulation.CreateCorpseAndGetID(world, eaten)
+	world.DestroyEntity(starved)
+	// Новорождённый занимает слот умершего в том же тике, но с новым поколением ID
+	if newborn := simulation.CreateAnimal(world, core.TypeRabbit, 400, 400); newborn == starved {
+		t.Fatal("Reused entity slot should get a new generation")
+	}
+	collector.Observe(world)
+
+	stats := collector.Flush(1, 10, world, terrain)
+	if stats.Births != 1 || stats.Deaths != 2 || stats.Kills != 1 {
+		t.Errorf("Expected 1 birth, 2 deaths, 1 kill, got %+v", stats)
+	}
+	if stats.Population["rabbit"] != 1 || stats.Population["wolf"] != 1 || stats.GrassBiomass != 40 {
+		t.Errorf("Unexpected population or grass: %+v", stats)
+	}
+
+	if next := collector.Flush(1, 20, world, terrain); next.Births != 0 || next.Deaths != 0 || next.Kills != 0 {
+		t.Errorf("Flush should reset interval counters, got %+v", next)
+	}
+}
+
+func TestParseSeeds(t *testing.T) {
+	seeds, err := ParseSeeds("5, 1-3,2,10-11")
+	if err != nil {
+		t.Fatalf("ParseSeeds failed: %v", err)
+	}
+	if expected := []int64{1, 2, 3, 5, 10, 11}; !reflect.DeepEqual(seeds, expected) {
+		t.Errorf("Expected %v, got %v", expected, seeds)
+	}
+
+	if seeds, err := ParseSeeds(fmt.Sprintf("1-%d,5", MaxSeeds)); err != nil || len(seeds) != MaxSeeds {
+		t.Errorf("Range of MaxSeeds seeds should parse, got %d seeds (err %v)", len(seeds), err)
+	}
+
+	tooMany := fmt.Sprintf("1-%d,%d", MaxSeeds, MaxSeeds+1)
+	invalidSeeds := []string{
+		"", "abc", "5-1", "1-x",
+		"1-1000000000", // Диапазон больше MaxSeeds
+		"9223372036854775806-9223372036854775807", // Конец диапазона MaxInt64 - цикл не завершился бы
+		tooMany,
+	}
+	for _, invalid := range invalidSeeds {
+		if _, err := ParseSeeds(invalid); err == nil {
+			t.Errorf("Expected error for %q", invalid)
+		}
+	}
+}
+
+func TestWriteResults_Formats(t *testing.T) {
+	results := []RunResult{{Seed: 3, Intervals: []IntervalStats{
+		{Seed: 3, Tick: 60, Time: 1, Population: map[string]int{"rabbit": 7, "wolf": 2}, Births: 1, GrassBiomass: 12.5},
+	}}}
+
+	var csvOut bytes.Buffer
+	if err := WriteResults(&csvOut, FormatCSV, []string{"rabbit", "wolf"}, results); err != nil {
+		t.Fatalf("CSV export failed: %v", err)
+	}
+	rows, err := csv.NewReader(&csvOut).ReadAll()
+	if err != nil {
+		t.Fatalf("CSV output should be valid: %v", err)
+	}
+	expected := [][]string{
+		{"seed", "tick", "time", "grass_biomass", "births", "deaths", "kills", "rabbit", "wolf"},
+		{"3", "60", "1.000", "12.5", "1", "0", "0", "7", "2"},
+	}
+	if !reflect.DeepEqual(rows, expected) {
+		t.Errorf("Unexpected CSV rows: %v", rows)
+	}
+
+	var jsonlOut bytes.Buffer
+	if err := WriteResults(&jsonlOut, FormatJSONL, nil, results); err != nil {
+		t.Fatalf("JSONL export failed: %v", err)
+	}
+	scanner := bufio.NewScanner(&jsonlOut)
+	if !scanner.Scan() {
+		t.Fatal("JSONL output should contain a line")
+	}
+	var decoded IntervalStats
+	err = json.Unmarshal(scanner.Bytes(), &decoded)
+	if err != nil || !reflect.DeepEqual(decoded, results[0].Intervals[0]) {
+		t.Errorf("JSONL line should round-trip, got %+v (err %v)", decoded, err)
+	}
+
+	if err := WriteResults(&jsonlOut, "xml", nil, results); err == nil {
+		t.Error("Unknown format should return an error")
+	}
+}
+
+func TestRun_LifeStatsMatchPopulationCounts(t *testing.T) {
+	rc := testRunConfig()
+	rc.Ticks = 3600
+	rc.LifeStats = true
+
+	result, err := Run(3, rc)
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if result.Life == nil {
+		t.Fatal("Life stats should be collected when requested")
+	}
+
+	var births, deaths int
+	for _, stats := range result.Intervals {
+		births += stats.Births
+		deaths += stats.Deaths
+	}
+
+	var lifeBirths, lifeDeaths, kills, killed int
+	for _, species := range result.Life.Species {
+		lifeBirths += species.Births
+		kills += species.Kills
+		killed += species.Deaths[core.DeathKilled.String()]
+		for _, count := range species.Deaths {
+			lifeDeaths += count
+		}
+	}
+
+	// Каждая смерть и рождение приходят событием, их число совпадает с подсчётом численности
+	if lifeBirths != births || lifeDeaths != deaths {
+		t.Errorf("Life stats births/deaths %d/%d should match population counts %d/%d", lifeBirths, lifeDeaths, births, deaths)
+	}
+	if deaths == 0 || kills != killed {
+		t.Errorf("Expected deaths with consistent kills, got deaths %d, kills %d, killed %d", deaths, kills, killed)
+	}
+}
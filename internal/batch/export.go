@@ -0,0 +1,73 @@
+package batch
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// Форматы экспорта статистики
+const (
+	FormatCSV   = "csv"
+	FormatJSONL = "jsonl"
+)
+
+// WriteResults записывает результаты прогонов в выбранном формате
+func WriteResults(w io.Writer, format string, species []string, results []RunResult) error {
+	switch format {
+	case FormatCSV:
+		return WriteCSV(w, species, results)
+	case FormatJSONL:
+		return WriteJSONL(w, results)
+	default:
+		return fmt.Errorf("unknown format %q (expected %s or %s)", format, FormatCSV, FormatJSONL)
+	}
+}
+
+// WriteCSV записывает статистику таблицей: по строке на интервал, по колонке на вид
+func WriteCSV(w io.Writer, species []string, results []RunResult) error {
+	writer := csv.NewWriter(w)
+
+	header := append([]string{"seed", "tick", "time", "grass_biomass", "births", "deaths", "kills"}, species...)
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	for _, result := range results {
+		for _, stats := range result.Intervals {
+			row := []string{
+				strconv.FormatInt(stats.Seed, 10),
+				strconv.Itoa(stats.Tick),
+				strconv.FormatFloat(float64(stats.Time), 'f', 3, 32),
+				strconv.FormatFloat(stats.GrassBiomass, 'f', 1, 64),
+				strconv.Itoa(stats.Births),
+				strconv.Itoa(stats.Deaths),
+				strconv.Itoa(stats.Kills),
+			}
+			for _, name := range species {
+				row = append(row, strconv.Itoa(stats.Population[name]))
+			}
+			if err := writer.Write(row); err != nil {
+				return err
+			}
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteJSONL записывает статистику в формате JSON Lines (объект на интервал)
+func WriteJSONL(w io.Writer, results []RunResult) error {
+	encoder := json.NewEncoder(w)
+	for _, result := range results {
+		for _, stats := range result.Intervals {
+			if err := encoder.Encode(stats); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,227 @@
+package batch
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/aiseeq/savanna/config"
+	"github.com/aiseeq/savanna/internal/core"
+	"github.com/aiseeq/savanna/internal/generator"
+	"github.com/aiseeq/savanna/internal/lifestats"
+	"github.com/aiseeq/savanna/internal/pipeline"
+	"github.com/aiseeq/savanna/internal/simulation"
+)
+
+// Параметры прогона по умолчанию
+const (
+	DefaultTimeStep = 1.0 / 60.0 // Фиксированный шаг симуляции (как в GUI при 60 TPS)
+	DefaultTicks    = 18000      // 5 минут симуляции
+	DefaultInterval = 600        // Строка статистики каждые 10 секунд симуляции
+
+	MaxSeeds = 10000 // Максимум seed в одном запуске (защита от опечатки в диапазоне)
+)
+
+// RunConfig параметры headless прогона
+type RunConfig struct {
+	Config   *config.Config             // Конфигурация мира и популяций (seed подменяется)
+	Balance  *simulation.BalanceProfile // Профиль баланса (nil - значения по умолчанию)
+	Ticks    int                        // Количество тиков прогона
+	Interval int                        // Период записи статистики (тики)
+	TimeStep float32                    // Фиксированный шаг (секунды)
+	Workers  int                        // Потоки секторов карты внутри прогона (0 или 1 - последовательно)
+
+	LifeStats bool // Вести истории жизней животных (RunResult.Life)
+}
+
+// RunResult результат прогона одного seed
+type RunResult struct {
+	Seed      int64
+	Intervals []IntervalStats
+	Life      *lifestats.Report // Истории жизней и причины смертей (только при RunConfig.LifeStats)
+}
+
+// Validate проверяет параметры прогона
+func (rc RunConfig) Validate() error {
+	if rc.Config == nil {
+		return fmt.Errorf("config is required")
+	}
+	if rc.Ticks <= 0 {
+		return fmt.Errorf("ticks must be positive, got %d", rc.Ticks)
+	}
+	if rc.Interval <= 0 {
+		return fmt.Errorf("interval must be positive, got %d", rc.Interval)
+	}
+	if rc.TimeStep <= 0 {
+		return fmt.Errorf("time step must be positive, got %f", rc.TimeStep)
+	}
+	return nil
+}
+
+// Run выполняет один headless прогон с фиксированным шагом без ожидания кадров
+// Конвейер систем тот же, что у GUI, поэтому результаты совпадают с игрой при том же seed
+func Run(seed int64, rc RunConfig) (RunResult, error) {
+	if err := rc.Validate(); err != nil {
+		return RunResult{Seed: seed}, err
+	}
+
+	// Копия конфигурации: параллельные прогоны не должны менять общий seed
+	cfg := *rc.Config
+	cfg.World.Seed = seed
+
+	terrain := generator.NewTerrainGenerator(&cfg).Generate()
+	world := core.NewWorld(float32(terrain.Width), float32(terrain.Height), seed)
+	world.SetParallelism(rc.Workers) // Результат не зависит от числа потоков
+
+	worldWidth, worldHeight := world.GetWorldDimensions()
+	simPipeline := pipeline.New(terrain, worldWidth, worldHeight)
+	simPipeline.SetBalanceProfile(rc.Balance)
+	if _, _, err := pipeline.Populate(world, terrain, &cfg, rc.Balance); err != nil {
+		return RunResult{Seed: seed}, err
+	}
+
+	collector := NewCollector(world, ReportSpecies())
+	result := RunResult{Seed: seed, Intervals: make([]IntervalStats, 0, rc.Ticks/rc.Interval+1)}
+	result.Intervals = append(result.Intervals, collector.Flush(seed, 0, world, terrain))
+
+	var life *lifestats.Tracker
+	if rc.LifeStats {
+		life = lifestats.NewTracker(world)
+	}
+
+	for tick := 1; tick <= rc.Ticks; tick++ {
+		simPipeline.Update(world, rc.TimeStep)
+		collector.Observe(world)
+		if life != nil {
+			life.Observe()
+		}
+
+		if tick%rc.Interval == 0 || tick == rc.Ticks {
+			result.Intervals = append(result.Intervals, collector.Flush(seed, tick, world, terrain))
+		}
+	}
+
+	if life != nil {
+		report := life.Report(seed, ReportSpecies())
+		result.Life = &report
+	}
+	return result, nil
+}
+
+// RunSeeds выполняет прогоны для всех seed в parallel горутинах
+// Результаты возвращаются в порядке seed независимо от порядка завершения
+func RunSeeds(seeds []int64, rc RunConfig, parallel int) ([]RunResult, error) {
+	if err := rc.Validate(); err != nil {
+		return nil, err
+	}
+	if parallel < 1 {
+		parallel = 1
+	}
+
+	results := make([]RunResult, len(seeds))
+	errs := make([]error, len(seeds))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	for worker := 0; worker < min(parallel, len(seeds)); worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i], errs[i] = Run(seeds[i], rc)
+			}
+		}()
+	}
+
+	for i := range seeds {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			return nil, fmt.Errorf("seed %d: %w", seeds[i], err)
+		}
+	}
+	return results, nil
+}
+
+// ReportSpecies виды в отчёте: встроенные заяц и волк, затем виды из файлов описаний
+func ReportSpecies() []core.AnimalType {
+	species := []core.AnimalType{core.TypeRabbit, core.TypeWolf}
+	for _, animalType := range simulation.RegisteredSpecies() {
+		if animalType != core.TypeRabbit && animalType != core.TypeWolf {
+			species = append(species, animalType)
+		}
+	}
+	return species
+}
+
+// ParseSeeds разбирает список seed: "1,2,3", диапазоны "1-100" и их сочетания "1-10,42"
+// Повторы удаляются, результат отсортирован; больше MaxSeeds seed - ошибка
+func ParseSeeds(value string) ([]int64, error) {
+	unique := make(map[int64]bool)
+
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		from, to, err := parseSeedRange(part)
+		if err != nil {
+			return nil, err
+		}
+		// Размер диапазона проверяется до заполнения: огромный диапазон не должен занимать память
+		if to-from >= MaxSeeds {
+			return nil, fmt.Errorf("seed range %q is too large: at most %d seeds per run", part, MaxSeeds)
+		}
+		for seed := from; seed <= to; seed++ {
+			unique[seed] = true
+		}
+		if len(unique) > MaxSeeds {
+			return nil, fmt.Errorf("too many seeds in %q: at most %d per run", value, MaxSeeds)
+		}
+	}
+
+	if len(unique) == 0 {
+		return nil, fmt.Errorf("no seeds in %q", value)
+	}
+
+	seeds := make([]int64, 0, len(unique))
+	for seed := range unique {
+		seeds = append(seeds, seed)
+	}
+	sort.Slice(seeds, func(i, j int) bool { return seeds[i] < seeds[j] })
+	return seeds, nil
+}
+
+// parseSeedRange разбирает один seed или диапазон "from-to" (отрицательные seed не поддерживаются в диапазонах)
+func parseSeedRange(part string) (from, to int64, err error) {
+	bounds := strings.SplitN(part, "-", 2)
+	if len(bounds) == 1 || bounds[0] == "" {
+		seed, err := strconv.ParseInt(part, 10, 64)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid seed %q: %w", part, err)
+		}
+		return seed, seed, nil
+	}
+
+	if from, err = strconv.ParseInt(strings.TrimSpace(bounds[0]), 10, 64); err != nil {
+		return 0, 0, fmt.Errorf("invalid seed range %q: %w", part, err)
+	}
+	if to, err = strconv.ParseInt(strings.TrimSpace(bounds[1]), 10, 64); err != nil {
+		return 0, 0, fmt.Errorf("invalid seed range %q: %w", part, err)
+	}
+	if to < from {
+		return 0, 0, fmt.Errorf("invalid seed range %q: end before start", part)
+	}
+	if to == math.MaxInt64 {
+		return 0, 0, fmt.Errorf("invalid seed range %q: end must be below %d", part, int64(math.MaxInt64))
+	}
+	return from, to, nil
+}
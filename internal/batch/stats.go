@@ -0,0 +1,125 @@
+package batch
+
+import (
+	"github.com/aiseeq/savanna/internal/core"
+	"github.com/aiseeq/savanna/internal/generator"
+	"github.com/aiseeq/savanna/internal/lifestats"
+)
+
+// IntervalStats статистика одного интервала прогона
+// Численность - на конец интервала, рождения/смерти/убийства - за интервал
+type IntervalStats struct {
+	Seed         int64          `json:"seed"`
+	Tick         int            `json:"tick"`
+	Time         float32        `json:"time"`       // Время симуляции (секунды)
+	Population   map[string]int `json:"population"` // Живые животные по имени вида
+	Births       int            `json:"births"`
+	Deaths       int            `json:"deaths"` // Все смерти, включая убитых хищниками
+	Kills        int            `json:"kills"`  // Животные убитые хищниками (стали трупами)
+	GrassBiomass float64        `json:"grass_biomass"`
+}
+
+// Collector собирает статистику популяций по тикам (SRP: только учёт, без симуляции)
+// Рождения и смерти определяются сравнением живых животных между тиками:
+// EntityID с поколением, поэтому животное на переиспользованном слоте - другой ID
+type Collector struct {
+	species     []core.AnimalType              // Виды в отчёте (колонки CSV)
+	alive       map[core.EntityID]animalRecord // Живые животные на последнем наблюдении (одна карта на весь прогон)
+	observation uint64                         // Номер наблюдения
+
+	births, deaths, kills int
+}
+
+// animalRecord запись о живом животном
+type animalRecord struct {
+	animalType core.AnimalType
+	seen       uint64 // Последнее наблюдение, на котором животное было живым
+}
+
+// NewCollector создаёт сборщик и запоминает начальную популяцию (она не считается рождениями)
+func NewCollector(world *core.World, species []core.AnimalType) *Collector {
+	c := &Collector{
+		species: species,
+		alive:   make(map[core.EntityID]animalRecord),
+	}
+	c.markLiving(world)
+	return c
+}
+
+// Observe учитывает изменения популяции после тика симуляции
+func (c *Collector) Observe(world *core.World) {
+	c.births += c.markLiving(world)
+
+	for entity, record := range c.alive {
+		if record.seen == c.observation {
+			continue
+		}
+
+		c.deaths++
+		if world.IsAlive(entity) && world.HasComponent(entity, core.MaskCorpse) {
+			c.kills++ // Убитые хищником становятся трупами, остальные мёртвые удаляются
+		}
+		delete(c.alive, entity)
+	}
+}
+
+// Flush возвращает статистику интервала и сбрасывает счётчики событий
+func (c *Collector) Flush(seed int64, tick int, world *core.World, terrain *generator.Terrain) IntervalStats {
+	stats := IntervalStats{
+		Seed:         seed,
+		Tick:         tick,
+		Time:         world.GetTime(),
+		Population:   make(map[string]int, len(c.species)),
+		Births:       c.births,
+		Deaths:       c.deaths,
+		Kills:        c.kills,
+		GrassBiomass: grassBiomass(terrain),
+	}
+
+	for _, animalType := range c.species {
+		stats.Population[SpeciesName(animalType)] = 0
+	}
+	for _, record := range c.alive {
+		stats.Population[SpeciesName(record.animalType)]++
+	}
+
+	c.births, c.deaths, c.kills = 0, 0, 0
+	return stats
+}
+
+// SpeciesName имя вида в отчётах (нижний регистр, как в файлах видов)
+func SpeciesName(animalType core.AnimalType) string {
+	return lifestats.SpeciesName(animalType)
+}
+
+// markLiving отмечает живых животных текущим наблюдением и возвращает число новых (трупы не учитываются)
+func (c *Collector) markLiving(world *core.World) int {
+	c.observation++
+	added := 0
+
+	world.ForEachWith(core.MaskAnimalType, func(entity core.EntityID) {
+		if world.HasComponent(entity, core.MaskCorpse) {
+			return
+		}
+		record, known := c.alive[entity]
+		if !known {
+			record.animalType, _ = world.GetAnimalType(entity)
+			added++
+		}
+		record.seen = c.observation
+		c.alive[entity] = record
+	})
+
+	return added
+}
+
+// grassBiomass суммарное количество травы на всех тайлах
+func grassBiomass(terrain *generator.Terrain) float64 {
+	var total float64
+	for _, row := range terrain.Grass {
+		for _, amount := range row {
+			total += float64(amount)
+		}
+	}
+	return total
+}
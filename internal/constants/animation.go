@@ -11,6 +11,8 @@ const (
 	AnimDeathDecay
 	AnimEat
 	AnimAttack
+	AnimBreed // Размножение (сердечки)
+	AnimDrink // Питьё у водоёма
 )
 
 // String возвращает название анимации
@@ -30,6 +32,10 @@ func (at AnimationType) String() string {
 		return "Eat"
 	case AnimAttack:
 		return "Attack"
+	case AnimBreed:
+		return "Breed"
+	case AnimDrink:
+		return "Drink"
 	default:
 		return "Unknown"
 	}
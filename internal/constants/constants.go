@@ -1,5 +1,7 @@
 package constants
 
+import "math"
+
 // Общие константы для всего проекта (устраняет gomnd нарушения)
 // Все магические числа заменены на именованные константы с объяснениями
 
@@ -138,6 +140,11 @@ func PositionToTiles(posX, posY float32) (float32, float32) {
 	return PixelsToTiles(posX), PixelsToTiles(posY)
 }
 
+// TileIndex возвращает индекс тайла, содержащего координату в тайлах (отрицательные - за левой/верхней границей)
+func TileIndex(coordinate float32) int {
+	return int(math.Floor(float64(coordinate)))
+}
+
 // WorldBoundsToPixels конвертирует размеры мира из тайлов в пиксели
 func WorldBoundsToPixels(worldWidth, worldHeight float32) (float32, float32) {
 	return TilesToPixels(worldWidth), TilesToPixels(worldHeight)
@@ -0,0 +1,86 @@
+package core
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+)
+
+// animalTypeRegistry реестр имён типов животных
+// Встроенные типы зарегистрированы заранее, виды из файлов описаний получают следующие свободные ID
+type animalTypeRegistry struct {
+	mu     sync.RWMutex
+	names  []string              // Имя типа по индексу AnimalType
+	byName map[string]AnimalType // Поиск по имени без учёта регистра
+}
+
+// animalTypes глобальный реестр типов (виды загружаются один раз при старте)
+var animalTypes = newAnimalTypeRegistry()
+
+// newAnimalTypeRegistry создаёт реестр со встроенными типами
+func newAnimalTypeRegistry() *animalTypeRegistry {
+	registry := &animalTypeRegistry{byName: make(map[string]AnimalType)}
+
+	// ВАЖНО: порядок совпадает с константами TypeNone..TypeGrass
+	for _, name := range []string{"None", "Rabbit", "Wolf", "Grass"} {
+		_, _ = registry.register(name)
+	}
+
+	return registry
+}
+
+// register возвращает тип с указанным именем, выделяя новый ID для неизвестного имени
+func (r *animalTypeRegistry) register(name string) (AnimalType, error) {
+	key := strings.ToLower(name)
+	if animalType, exists := r.byName[key]; exists {
+		return animalType, nil
+	}
+
+	if len(r.names) > math.MaxUint8 {
+		return TypeNone, fmt.Errorf("too many animal types: cannot register %q", name)
+	}
+
+	animalType := AnimalType(len(r.names))
+	r.names = append(r.names, name)
+	r.byName[key] = animalType
+	return animalType, nil
+}
+
+// RegisterAnimalType регистрирует вид животного по имени и возвращает его тип
+// Повторная регистрация того же имени (без учёта регистра) возвращает существующий тип,
+// поэтому файл "rabbit" переопределяет встроенного зайца, а не создаёт второй вид
+func RegisterAnimalType(name string) (AnimalType, error) {
+	animalTypes.mu.Lock()
+	defer animalTypes.mu.Unlock()
+
+	return animalTypes.register(name)
+}
+
+// AnimalTypeByName возвращает тип животного по имени вида (без учёта регистра)
+func AnimalTypeByName(name string) (AnimalType, bool) {
+	animalTypes.mu.RLock()
+	defer animalTypes.mu.RUnlock()
+
+	animalType, exists := animalTypes.byName[strings.ToLower(name)]
+	return animalType, exists
+}
+
+// animalTypeName возвращает имя зарегистрированного типа
+func animalTypeName(animalType AnimalType) (string, bool) {
+	animalTypes.mu.RLock()
+	defer animalTypes.mu.RUnlock()
+
+	if int(animalType) >= len(animalTypes.names) {
+		return "", false
+	}
+	return animalTypes.names[animalType], true
+}
+
+// animalTypeNames возвращает копию таблицы имён типов (индекс - AnimalType)
+func animalTypeNames() []string {
+	animalTypes.mu.RLock()
+	defer animalTypes.mu.RUnlock()
+
+	return append([]string(nil), animalTypes.names...)
+}
@@ -0,0 +1,93 @@
+package core
+
+// EntityCommand отложенная операция над сущностью
+type EntityCommand func(world *World, entity EntityID)
+
+// commandKind вид отложенной команды
+type commandKind uint8
+
+const (
+	commandCreate commandKind = iota
+	commandDestroy
+	commandModify
+)
+
+// queuedCommand команда в очереди
+type queuedCommand struct {
+	kind   commandKind
+	entity EntityID
+	apply  EntityCommand
+}
+
+// CommandBuffer очередь структурных изменений мира (создание, удаление, добавление и удаление компонентов)
+// Системы ставят команды в очередь во время итерации, SystemManager применяет их после каждой системы.
+// Команды применяются в порядке постановки - результат детерминирован.
+// Команды к сущности, уничтоженной до применения, пропускаются
+type CommandBuffer struct {
+	commands []queuedCommand
+}
+
+// CreateEntity ставит в очередь создание сущности; init получает её после создания (может быть nil)
+func (cb *CommandBuffer) CreateEntity(init EntityCommand) {
+	cb.commands = append(cb.commands, queuedCommand{kind: commandCreate, apply: init})
+}
+
+// DestroyEntity ставит в очередь уничтожение сущности (повторное уничтожение безопасно)
+func (cb *CommandBuffer) DestroyEntity(entity EntityID) {
+	cb.commands = append(cb.commands, queuedCommand{kind: commandDestroy, entity: entity})
+}
+
+// Modify ставит в очередь изменение сущности, например добавление или удаление компонентов
+func (cb *CommandBuffer) Modify(entity EntityID, apply EntityCommand) {
+	cb.commands = append(cb.commands, queuedCommand{kind: commandModify, entity: entity, apply: apply})
+}
+
+// Len возвращает количество команд в очереди
+func (cb *CommandBuffer) Len() int {
+	return len(cb.commands)
+}
+
+// Playback применяет команды к миру и очищает очередь, возвращает количество применённых команд
+// Команды, поставленные во время применения, выполняются в этом же вызове после текущих
+func (cb *CommandBuffer) Playback(world *World) int {
+	applied := 0
+	for i := 0; i < len(cb.commands); i++ {
+		command := cb.commands[i]
+
+		switch command.kind {
+		case commandCreate:
+			entity := world.CreateEntity()
+			if command.apply != nil {
+				command.apply(world, entity)
+			}
+		case commandDestroy:
+			if !world.DestroyEntity(command.entity) {
+				continue
+			}
+		case commandModify:
+			if !world.IsAlive(command.entity) {
+				continue
+			}
+			command.apply(world, command.entity)
+		}
+		applied++
+	}
+
+	clear(cb.commands)
+	cb.commands = cb.commands[:0]
+	return applied
+}
+
+// DeferAdd ставит в очередь добавление пользовательского компонента
+func DeferAdd[T any](cb *CommandBuffer, component *Component[T], entity EntityID, value T) {
+	cb.Modify(entity, func(_ *World, entity EntityID) {
+		component.Add(entity, value)
+	})
+}
+
+// DeferRemove ставит в очередь удаление пользовательского компонента
+func DeferRemove[T any](cb *CommandBuffer, component *Component[T], entity EntityID) {
+	cb.Modify(entity, func(_ *World, entity EntityID) {
+		component.Remove(entity)
+	})
+}
@@ -5,52 +5,181 @@ import "github.com/aiseeq/savanna/internal/constants"
 // ComponentManager управляет компонентами сущностей
 // Соблюдает Single Responsibility Principle - только управление компонентами
 type ComponentManager struct {
-	// Компоненты - индексируются по EntityID (Structure of Arrays для производительности)
-	positions     [MaxEntities]Position
-	velocities    [MaxEntities]Velocity
-	healths       [MaxEntities]Health
-	satiations    [MaxEntities]Satiation
-	types         [MaxEntities]AnimalType
-	sizes         [MaxEntities]Size
-	speeds        [MaxEntities]Speed
-	animations    [MaxEntities]Animation
-	damageFlashes [MaxEntities]DamageFlash
-	corpses       [MaxEntities]Corpse
-	carrions      [MaxEntities]Carrion
-	eatingStates  [MaxEntities]EatingState
-	attackStates  [MaxEntities]AttackState
-	behaviors     [MaxEntities]Behavior
-	animalConfigs [MaxEntities]AnimalConfig
+	entities *EntityManager    // Проверка дескрипторов: компоненты устаревшего дескриптора недоступны
+	capacity int               // Количество слотов, покрытых хранилищами (растёт блоками EntityChunkSize)
+	observer componentObserver // Получает изменения наборов компонентов (QueryManager)
+
+	// Компоненты - индексируются по слоту EntityID.Index() (Structure of Arrays для производительности)
+	positions      []Position
+	velocities     []Velocity
+	healths        []Health
+	satiations     []Satiation
+	types          []AnimalType
+	sizes          []Size
+	speeds         []Speed
+	animations     []Animation
+	damageFlashes  []DamageFlash
+	corpses        []Corpse
+	carrions       []Carrion
+	eatingStates   []EatingState
+	attackStates   []AttackState
+	behaviors      []Behavior
+	animalConfigs  []AnimalConfig
+	cooldowns      []ReproductionCooldown
+	pregnancies    []Pregnancy
+	ages           []Age
+	hydrations     []Hydration
+	drinkingStates []DrinkingState
+	packs          []PackMembership
+	concealments   []Concealment
 
 	// Битовые маски для быстрой проверки наличия компонентов
-	hasPosition     [MaxEntities/64 + 1]uint64
-	hasVelocity     [MaxEntities/64 + 1]uint64
-	hasHealth       [MaxEntities/64 + 1]uint64
-	hasSatiation    [MaxEntities/64 + 1]uint64
-	hasType         [MaxEntities/64 + 1]uint64
-	hasSize         [MaxEntities/64 + 1]uint64
-	hasSpeed        [MaxEntities/64 + 1]uint64
-	hasAnimation    [MaxEntities/64 + 1]uint64
-	hasDamageFlash  [MaxEntities/64 + 1]uint64
-	hasCorpse       [MaxEntities/64 + 1]uint64
-	hasCarrion      [MaxEntities/64 + 1]uint64
-	hasEatingState  [MaxEntities/64 + 1]uint64
-	hasAttackState  [MaxEntities/64 + 1]uint64
-	hasBehavior     [MaxEntities/64 + 1]uint64
-	hasAnimalConfig [MaxEntities/64 + 1]uint64
+	hasPosition      []uint64
+	hasVelocity      []uint64
+	hasHealth        []uint64
+	hasSatiation     []uint64
+	hasType          []uint64
+	hasSize          []uint64
+	hasSpeed         []uint64
+	hasAnimation     []uint64
+	hasDamageFlash   []uint64
+	hasCorpse        []uint64
+	hasCarrion       []uint64
+	hasEatingState   []uint64
+	hasAttackState   []uint64
+	hasBehavior      []uint64
+	hasAnimalConfig  []uint64
+	hasCooldown      []uint64
+	hasPregnancy     []uint64
+	hasAge           []uint64
+	hasHydration     []uint64
+	hasDrinkingState []uint64
+	hasPack          []uint64
+	hasConcealment   []uint64
+
+	// Пользовательские компоненты (RegisterComponent) в порядке регистрации
+	custom []customStorage
+}
+
+// componentObserver получает уведомления об изменении набора компонентов сущности
+type componentObserver interface {
+	componentsChanged(entity EntityID)
 }
 
-// NewComponentManager создаёт новый менеджер компонентов
-func NewComponentManager() *ComponentManager {
-	return &ComponentManager{}
+// componentBitset битовая маска наличия одного типа компонента
+type componentBitset struct {
+	mask ComponentMask
+	bits []uint64
 }
 
-// HasComponent проверяет наличие компонента у сущности
+// componentTypeCount количество типов компонентов
+const componentTypeCount = 22
+
+// NewComponentManager создаёт новый менеджер компонентов для сущностей менеджера entities
+func NewComponentManager(entities *EntityManager) *ComponentManager {
+	cm := &ComponentManager{entities: entities}
+	cm.ensureCapacity(EntityChunkSize - 1)
+	return cm
+}
+
+// reserve готовит хранилища к добавлению компонента
+// Возвращает false для мёртвой сущности или устаревшего дескриптора - компонент не добавляется,
+// иначе он достался бы новой сущности в том же слоте
+func (cm *ComponentManager) reserve(entity EntityID) bool {
+	if !cm.entities.IsAlive(entity) {
+		return false
+	}
+	cm.ensureCapacity(entity)
+	return true
+}
+
+// ensureCapacity расширяет все хранилища компонентов чтобы вместить сущность
+// Вызывается при добавлении компонента: чтение за пределами хранилищ отсекает HasComponent
+func (cm *ComponentManager) ensureCapacity(entity EntityID) {
+	if int(entity.Index()) < cm.capacity {
+		return
+	}
+
+	cm.positions = growStorage(cm.positions, int(entity.Index())+1)
+	capacity := len(cm.positions)
+
+	cm.velocities = growStorage(cm.velocities, capacity)
+	cm.healths = growStorage(cm.healths, capacity)
+	cm.satiations = growStorage(cm.satiations, capacity)
+	cm.types = growStorage(cm.types, capacity)
+	cm.sizes = growStorage(cm.sizes, capacity)
+	cm.speeds = growStorage(cm.speeds, capacity)
+	cm.animations = growStorage(cm.animations, capacity)
+	cm.damageFlashes = growStorage(cm.damageFlashes, capacity)
+	cm.corpses = growStorage(cm.corpses, capacity)
+	cm.carrions = growStorage(cm.carrions, capacity)
+	cm.eatingStates = growStorage(cm.eatingStates, capacity)
+	cm.attackStates = growStorage(cm.attackStates, capacity)
+	cm.behaviors = growStorage(cm.behaviors, capacity)
+	cm.animalConfigs = growStorage(cm.animalConfigs, capacity)
+	cm.cooldowns = growStorage(cm.cooldowns, capacity)
+	cm.pregnancies = growStorage(cm.pregnancies, capacity)
+	cm.ages = growStorage(cm.ages, capacity)
+	cm.hydrations = growStorage(cm.hydrations, capacity)
+	cm.drinkingStates = growStorage(cm.drinkingStates, capacity)
+	cm.packs = growStorage(cm.packs, capacity)
+	cm.concealments = growStorage(cm.concealments, capacity)
+
+	// Длина хранилища кратна EntityChunkSize, а значит и 64 - битовые маски покрывают его целиком
+	words := capacity / constants.BitsPerUint64
+	cm.hasPosition = growBitset(cm.hasPosition, words)
+	cm.hasVelocity = growBitset(cm.hasVelocity, words)
+	cm.hasHealth = growBitset(cm.hasHealth, words)
+	cm.hasSatiation = growBitset(cm.hasSatiation, words)
+	cm.hasType = growBitset(cm.hasType, words)
+	cm.hasSize = growBitset(cm.hasSize, words)
+	cm.hasSpeed = growBitset(cm.hasSpeed, words)
+	cm.hasAnimation = growBitset(cm.hasAnimation, words)
+	cm.hasDamageFlash = growBitset(cm.hasDamageFlash, words)
+	cm.hasCorpse = growBitset(cm.hasCorpse, words)
+	cm.hasCarrion = growBitset(cm.hasCarrion, words)
+	cm.hasEatingState = growBitset(cm.hasEatingState, words)
+	cm.hasAttackState = growBitset(cm.hasAttackState, words)
+	cm.hasBehavior = growBitset(cm.hasBehavior, words)
+	cm.hasAnimalConfig = growBitset(cm.hasAnimalConfig, words)
+	cm.hasCooldown = growBitset(cm.hasCooldown, words)
+	cm.hasPregnancy = growBitset(cm.hasPregnancy, words)
+	cm.hasAge = growBitset(cm.hasAge, words)
+	cm.hasHydration = growBitset(cm.hasHydration, words)
+	cm.hasDrinkingState = growBitset(cm.hasDrinkingState, words)
+	cm.hasPack = growBitset(cm.hasPack, words)
+	cm.hasConcealment = growBitset(cm.hasConcealment, words)
+
+	for _, storage := range cm.custom {
+		storage.grow(capacity)
+	}
+
+	cm.capacity = capacity
+}
+
+// addCustomStorage подключает хранилище пользовательского компонента
+func (cm *ComponentManager) addCustomStorage(storage customStorage) {
+	storage.grow(cm.capacity)
+	cm.custom = append(cm.custom, storage)
+}
+
+// growBitset расширяет битовую маску до words слов с сохранением битов
+func growBitset(bits []uint64, words int) []uint64 {
+	grown := make([]uint64, words)
+	copy(grown, bits)
+	return grown
+}
+
+// HasComponent проверяет наличие компонента у сущности (устаревший дескриптор - компонентов нет)
 //
 //nolint:gocyclo // Оптимальный switch для производительности ECS
 func (cm *ComponentManager) HasComponent(entity EntityID, component ComponentMask) bool {
-	index := uint(entity) / constants.BitsPerUint64
-	bit := uint(entity) % constants.BitsPerUint64
+	if int(entity.Index()) >= cm.capacity || !cm.entities.IsAlive(entity) {
+		return false
+	}
+
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
 
 	switch component {
 	case MaskPosition:
@@ -83,39 +212,44 @@ func (cm *ComponentManager) HasComponent(entity EntityID, component ComponentMas
 		return cm.hasBehavior[index]&(1<<bit) != 0
 	case MaskAnimalConfig:
 		return cm.hasAnimalConfig[index]&(1<<bit) != 0
+	case MaskReproductionCooldown:
+		return cm.hasCooldown[index]&(1<<bit) != 0
+	case MaskPregnancy:
+		return cm.hasPregnancy[index]&(1<<bit) != 0
+	case MaskAge:
+		return cm.hasAge[index]&(1<<bit) != 0
+	case MaskHydration:
+		return cm.hasHydration[index]&(1<<bit) != 0
+	case MaskDrinkingState:
+		return cm.hasDrinkingState[index]&(1<<bit) != 0
+	case MaskPackMembership:
+		return cm.hasPack[index]&(1<<bit) != 0
+	case MaskConcealment:
+		return cm.hasConcealment[index]&(1<<bit) != 0
 	default:
+		for _, storage := range cm.custom {
+			if comp := storage.componentBitset(); comp.mask == component {
+				return comp.bits[index]&(1<<bit) != 0
+			}
+		}
 		return false
 	}
 }
 
 // HasComponents проверяет наличие всех указанных компонентов у сущности
 func (cm *ComponentManager) HasComponents(entity EntityID, mask ComponentMask) bool {
-	index := uint(entity) / constants.BitsPerUint64
-	bit := uint(entity) % constants.BitsPerUint64
+	if !cm.entities.IsAlive(entity) {
+		return false // Устаревший дескриптор не видит компоненты новой сущности слота
+	}
+	if int(entity.Index()) >= cm.capacity {
+		return mask == 0
+	}
+
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
 	entityMask := uint64(1 << bit)
 
-	requiredComponents := []struct {
-		mask ComponentMask
-		bits *[MaxEntities/64 + 1]uint64
-	}{
-		{MaskPosition, &cm.hasPosition},
-		{MaskVelocity, &cm.hasVelocity},
-		{MaskHealth, &cm.hasHealth},
-		{MaskSatiation, &cm.hasSatiation},
-		{MaskAnimalType, &cm.hasType},
-		{MaskSize, &cm.hasSize},
-		{MaskSpeed, &cm.hasSpeed},
-		{MaskAnimation, &cm.hasAnimation},
-		{MaskDamageFlash, &cm.hasDamageFlash},
-		{MaskCorpse, &cm.hasCorpse},
-		{MaskCarrion, &cm.hasCarrion},
-		{MaskEatingState, &cm.hasEatingState},
-		{MaskAttackState, &cm.hasAttackState},
-		{MaskBehavior, &cm.hasBehavior},
-		{MaskAnimalConfig, &cm.hasAnimalConfig},
-	}
-
-	for _, comp := range requiredComponents {
+	for _, comp := range cm.bitsets() {
 		if mask&comp.mask != 0 {
 			if comp.bits[index]&entityMask == 0 {
 				return false
@@ -123,13 +257,84 @@ func (cm *ComponentManager) HasComponents(entity EntityID, mask ComponentMask) b
 		}
 	}
 
+	if mask < 1<<firstCustomComponentBit {
+		return true
+	}
+	for _, storage := range cm.custom {
+		if comp := storage.componentBitset(); mask&comp.mask != 0 && comp.bits[index]&entityMask == 0 {
+			return false
+		}
+	}
+
 	return true
 }
 
+// componentMask возвращает маску всех компонентов сущности (0 для мёртвой сущности)
+func (cm *ComponentManager) componentMask(entity EntityID) ComponentMask {
+	if int(entity.Index()) >= cm.capacity || !cm.entities.IsAlive(entity) {
+		return 0
+	}
+
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
+
+	var mask ComponentMask
+	for _, comp := range cm.bitsets() {
+		if comp.bits[index]&(1<<bit) != 0 {
+			mask |= comp.mask
+		}
+	}
+	for _, storage := range cm.custom {
+		if comp := storage.componentBitset(); comp.bits[index]&(1<<bit) != 0 {
+			mask |= comp.mask
+		}
+	}
+	return mask
+}
+
+// bitsets возвращает битовые маски всех типов компонентов
+func (cm *ComponentManager) bitsets() [componentTypeCount]componentBitset {
+	return [componentTypeCount]componentBitset{
+		{MaskPosition, cm.hasPosition},
+		{MaskVelocity, cm.hasVelocity},
+		{MaskHealth, cm.hasHealth},
+		{MaskSatiation, cm.hasSatiation},
+		{MaskAnimalType, cm.hasType},
+		{MaskSize, cm.hasSize},
+		{MaskSpeed, cm.hasSpeed},
+		{MaskAnimation, cm.hasAnimation},
+		{MaskDamageFlash, cm.hasDamageFlash},
+		{MaskCorpse, cm.hasCorpse},
+		{MaskCarrion, cm.hasCarrion},
+		{MaskEatingState, cm.hasEatingState},
+		{MaskAttackState, cm.hasAttackState},
+		{MaskBehavior, cm.hasBehavior},
+		{MaskAnimalConfig, cm.hasAnimalConfig},
+		{MaskReproductionCooldown, cm.hasCooldown},
+		{MaskPregnancy, cm.hasPregnancy},
+		{MaskAge, cm.hasAge},
+		{MaskHydration, cm.hasHydration},
+		{MaskDrinkingState, cm.hasDrinkingState},
+		{MaskPackMembership, cm.hasPack},
+		{MaskConcealment, cm.hasConcealment},
+	}
+}
+
+// notify сообщает наблюдателю что набор компонентов сущности изменился
+func (cm *ComponentManager) notify(entity EntityID) {
+	if cm.observer != nil {
+		cm.observer.componentsChanged(entity)
+	}
+}
+
 // ClearAllComponents удаляет все компоненты у сущности (для DestroyEntity)
 func (cm *ComponentManager) ClearAllComponents(entity EntityID) {
-	index := uint(entity) / constants.BitsPerUint64
-	bit := uint(entity) % constants.BitsPerUint64
+	if int(entity.Index()) >= cm.capacity || !cm.entities.IsAlive(entity) {
+		return // Компонентов не было или дескриптор устарел
+	}
+
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
 	clearMask := ^(uint64(1) << bit) // Инвертированная маска для очистки бита
 
 	// Очищаем все битовые маски
@@ -148,21 +353,41 @@ func (cm *ComponentManager) ClearAllComponents(entity EntityID) {
 	cm.hasAttackState[index] &= clearMask
 	cm.hasBehavior[index] &= clearMask
 	cm.hasAnimalConfig[index] &= clearMask
+	cm.hasCooldown[index] &= clearMask
+	cm.hasPregnancy[index] &= clearMask
+	cm.hasAge[index] &= clearMask
+	cm.hasHydration[index] &= clearMask
+	cm.hasDrinkingState[index] &= clearMask
+	cm.hasPack[index] &= clearMask
+	cm.hasConcealment[index] &= clearMask
 
 	// Очищаем данные компонентов (обнуляем для предотвращения утечек памяти)
-	cm.positions[entity] = NewPosition(0, 0)
-	cm.velocities[entity] = NewVelocity(0, 0)
-	cm.healths[entity] = Health{}
-	cm.satiations[entity] = Satiation{}
-	cm.types[entity] = AnimalType(0)
-	cm.sizes[entity] = Size{}
-	cm.speeds[entity] = Speed{}
-	cm.animations[entity] = Animation{}
-	cm.damageFlashes[entity] = DamageFlash{}
-	cm.corpses[entity] = Corpse{}
-	cm.carrions[entity] = Carrion{}
-	cm.eatingStates[entity] = EatingState{}
-	cm.attackStates[entity] = AttackState{}
-	cm.behaviors[entity] = Behavior{}
-	cm.animalConfigs[entity] = AnimalConfig{}
+	cm.positions[entity.Index()] = NewPosition(0, 0)
+	cm.velocities[entity.Index()] = NewVelocity(0, 0)
+	cm.healths[entity.Index()] = Health{}
+	cm.satiations[entity.Index()] = Satiation{}
+	cm.types[entity.Index()] = AnimalType(0)
+	cm.sizes[entity.Index()] = Size{}
+	cm.speeds[entity.Index()] = Speed{}
+	cm.animations[entity.Index()] = Animation{}
+	cm.damageFlashes[entity.Index()] = DamageFlash{}
+	cm.corpses[entity.Index()] = Corpse{}
+	cm.carrions[entity.Index()] = Carrion{}
+	cm.eatingStates[entity.Index()] = EatingState{}
+	cm.attackStates[entity.Index()] = AttackState{}
+	cm.behaviors[entity.Index()] = Behavior{}
+	cm.animalConfigs[entity.Index()] = AnimalConfig{}
+	cm.cooldowns[entity.Index()] = ReproductionCooldown{}
+	cm.pregnancies[entity.Index()] = Pregnancy{}
+	cm.ages[entity.Index()] = Age{}
+	cm.hydrations[entity.Index()] = Hydration{}
+	cm.drinkingStates[entity.Index()] = DrinkingState{}
+	cm.packs[entity.Index()] = PackMembership{}
+	cm.concealments[entity.Index()] = Concealment{}
+
+	for _, storage := range cm.custom {
+		storage.clear(entity.Index())
+	}
+
+	cm.notify(entity)
 }
@@ -5,12 +5,17 @@ import "github.com/aiseeq/savanna/internal/constants"
 // AnimalType component management
 
 // AddAnimalType добавляет компонент AnimalType к сущности
-func (cm *ComponentManager) AddAnimalType(entity EntityID, animalType AnimalType) {
-	cm.types[entity] = animalType
+func (cm *ComponentManager) AddAnimalType(entity EntityID, animalType AnimalType) bool {
+	if !cm.reserve(entity) {
+		return false
+	}
+	cm.types[entity.Index()] = animalType
 
-	index := uint(entity) / constants.BitsPerUint64
-	bit := uint(entity) % constants.BitsPerUint64
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
 	cm.hasType[index] |= 1 << bit
+	cm.notify(entity)
+	return true
 }
 
 // GetAnimalType возвращает компонент AnimalType сущности
@@ -18,7 +23,7 @@ func (cm *ComponentManager) GetAnimalType(entity EntityID) (AnimalType, bool) {
 	if !cm.HasComponent(entity, MaskAnimalType) {
 		return AnimalType(0), false
 	}
-	return cm.types[entity], true
+	return cm.types[entity.Index()], true
 }
 
 // SetAnimalType обновляет компонент AnimalType сущности
@@ -26,7 +31,7 @@ func (cm *ComponentManager) SetAnimalType(entity EntityID, animalType AnimalType
 	if !cm.HasComponent(entity, MaskAnimalType) {
 		return false
 	}
-	cm.types[entity] = animalType
+	cm.types[entity.Index()] = animalType
 	return true
 }
 
@@ -36,10 +41,11 @@ func (cm *ComponentManager) RemoveAnimalType(entity EntityID) bool {
 		return false
 	}
 
-	index := uint(entity) / constants.BitsPerUint64
-	bit := uint(entity) % constants.BitsPerUint64
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
 	cm.hasType[index] &= ^(1 << bit)
-	cm.types[entity] = AnimalType(0)
+	cm.notify(entity)
+	cm.types[entity.Index()] = AnimalType(0)
 
 	return true
 }
@@ -47,12 +53,17 @@ func (cm *ComponentManager) RemoveAnimalType(entity EntityID) bool {
 // Size component management
 
 // AddSize добавляет компонент Size к сущности
-func (cm *ComponentManager) AddSize(entity EntityID, size Size) {
-	cm.sizes[entity] = size
+func (cm *ComponentManager) AddSize(entity EntityID, size Size) bool {
+	if !cm.reserve(entity) {
+		return false
+	}
+	cm.sizes[entity.Index()] = size
 
-	index := uint(entity) / constants.BitsPerUint64
-	bit := uint(entity) % constants.BitsPerUint64
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
 	cm.hasSize[index] |= 1 << bit
+	cm.notify(entity)
+	return true
 }
 
 // GetSize возвращает компонент Size сущности
@@ -60,7 +71,7 @@ func (cm *ComponentManager) GetSize(entity EntityID) (Size, bool) {
 	if !cm.HasComponent(entity, MaskSize) {
 		return Size{}, false
 	}
-	return cm.sizes[entity], true
+	return cm.sizes[entity.Index()], true
 }
 
 // SetSize обновляет компонент Size сущности
@@ -68,7 +79,7 @@ func (cm *ComponentManager) SetSize(entity EntityID, size Size) bool {
 	if !cm.HasComponent(entity, MaskSize) {
 		return false
 	}
-	cm.sizes[entity] = size
+	cm.sizes[entity.Index()] = size
 	return true
 }
 
@@ -78,10 +89,11 @@ func (cm *ComponentManager) RemoveSize(entity EntityID) bool {
 		return false
 	}
 
-	index := uint(entity) / constants.BitsPerUint64
-	bit := uint(entity) % constants.BitsPerUint64
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
 	cm.hasSize[index] &= ^(1 << bit)
-	cm.sizes[entity] = Size{}
+	cm.notify(entity)
+	cm.sizes[entity.Index()] = Size{}
 
 	return true
 }
@@ -89,12 +101,17 @@ func (cm *ComponentManager) RemoveSize(entity EntityID) bool {
 // Speed component management
 
 // AddSpeed добавляет компонент Speed к сущности
-func (cm *ComponentManager) AddSpeed(entity EntityID, speed Speed) {
-	cm.speeds[entity] = speed
+func (cm *ComponentManager) AddSpeed(entity EntityID, speed Speed) bool {
+	if !cm.reserve(entity) {
+		return false
+	}
+	cm.speeds[entity.Index()] = speed
 
-	index := uint(entity) / constants.BitsPerUint64
-	bit := uint(entity) % constants.BitsPerUint64
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
 	cm.hasSpeed[index] |= 1 << bit
+	cm.notify(entity)
+	return true
 }
 
 // GetSpeed возвращает компонент Speed сущности
@@ -102,7 +119,7 @@ func (cm *ComponentManager) GetSpeed(entity EntityID) (Speed, bool) {
 	if !cm.HasComponent(entity, MaskSpeed) {
 		return Speed{}, false
 	}
-	return cm.speeds[entity], true
+	return cm.speeds[entity.Index()], true
 }
 
 // SetSpeed обновляет компонент Speed сущности
@@ -110,7 +127,7 @@ func (cm *ComponentManager) SetSpeed(entity EntityID, speed Speed) bool {
 	if !cm.HasComponent(entity, MaskSpeed) {
 		return false
 	}
-	cm.speeds[entity] = speed
+	cm.speeds[entity.Index()] = speed
 	return true
 }
 
@@ -120,10 +137,11 @@ func (cm *ComponentManager) RemoveSpeed(entity EntityID) bool {
 		return false
 	}
 
-	index := uint(entity) / constants.BitsPerUint64
-	bit := uint(entity) % constants.BitsPerUint64
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
 	cm.hasSpeed[index] &= ^(1 << bit)
-	cm.speeds[entity] = Speed{}
+	cm.notify(entity)
+	cm.speeds[entity.Index()] = Speed{}
 
 	return true
 }
@@ -131,12 +149,17 @@ func (cm *ComponentManager) RemoveSpeed(entity EntityID) bool {
 // Animation component management
 
 // AddAnimation добавляет компонент Animation к сущности
-func (cm *ComponentManager) AddAnimation(entity EntityID, animation Animation) {
-	cm.animations[entity] = animation
+func (cm *ComponentManager) AddAnimation(entity EntityID, animation Animation) bool {
+	if !cm.reserve(entity) {
+		return false
+	}
+	cm.animations[entity.Index()] = animation
 
-	index := uint(entity) / constants.BitsPerUint64
-	bit := uint(entity) % constants.BitsPerUint64
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
 	cm.hasAnimation[index] |= 1 << bit
+	cm.notify(entity)
+	return true
 }
 
 // GetAnimation возвращает компонент Animation сущности
@@ -144,7 +167,7 @@ func (cm *ComponentManager) GetAnimation(entity EntityID) (Animation, bool) {
 	if !cm.HasComponent(entity, MaskAnimation) {
 		return Animation{}, false
 	}
-	return cm.animations[entity], true
+	return cm.animations[entity.Index()], true
 }
 
 // SetAnimation обновляет компонент Animation сущности
@@ -152,7 +175,7 @@ func (cm *ComponentManager) SetAnimation(entity EntityID, animation Animation) b
 	if !cm.HasComponent(entity, MaskAnimation) {
 		return false
 	}
-	cm.animations[entity] = animation
+	cm.animations[entity.Index()] = animation
 	return true
 }
 
@@ -162,10 +185,11 @@ func (cm *ComponentManager) RemoveAnimation(entity EntityID) bool {
 		return false
 	}
 
-	index := uint(entity) / constants.BitsPerUint64
-	bit := uint(entity) % constants.BitsPerUint64
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
 	cm.hasAnimation[index] &= ^(1 << bit)
-	cm.animations[entity] = Animation{}
+	cm.notify(entity)
+	cm.animations[entity.Index()] = Animation{}
 
 	return true
 }
@@ -173,12 +197,17 @@ func (cm *ComponentManager) RemoveAnimation(entity EntityID) bool {
 // DamageFlash component management
 
 // AddDamageFlash добавляет компонент DamageFlash к сущности
-func (cm *ComponentManager) AddDamageFlash(entity EntityID, damageFlash DamageFlash) {
-	cm.damageFlashes[entity] = damageFlash
+func (cm *ComponentManager) AddDamageFlash(entity EntityID, damageFlash DamageFlash) bool {
+	if !cm.reserve(entity) {
+		return false
+	}
+	cm.damageFlashes[entity.Index()] = damageFlash
 
-	index := uint(entity) / constants.BitsPerUint64
-	bit := uint(entity) % constants.BitsPerUint64
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
 	cm.hasDamageFlash[index] |= 1 << bit
+	cm.notify(entity)
+	return true
 }
 
 // GetDamageFlash возвращает компонент DamageFlash сущности
@@ -186,7 +215,7 @@ func (cm *ComponentManager) GetDamageFlash(entity EntityID) (DamageFlash, bool)
 	if !cm.HasComponent(entity, MaskDamageFlash) {
 		return DamageFlash{}, false
 	}
-	return cm.damageFlashes[entity], true
+	return cm.damageFlashes[entity.Index()], true
 }
 
 // SetDamageFlash обновляет компонент DamageFlash сущности
@@ -194,7 +223,7 @@ func (cm *ComponentManager) SetDamageFlash(entity EntityID, damageFlash DamageFl
 	if !cm.HasComponent(entity, MaskDamageFlash) {
 		return false
 	}
-	cm.damageFlashes[entity] = damageFlash
+	cm.damageFlashes[entity.Index()] = damageFlash
 	return true
 }
 
@@ -204,10 +233,11 @@ func (cm *ComponentManager) RemoveDamageFlash(entity EntityID) bool {
 		return false
 	}
 
-	index := uint(entity) / constants.BitsPerUint64
-	bit := uint(entity) % constants.BitsPerUint64
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
 	cm.hasDamageFlash[index] &= ^(1 << bit)
-	cm.damageFlashes[entity] = DamageFlash{}
+	cm.notify(entity)
+	cm.damageFlashes[entity.Index()] = DamageFlash{}
 
 	return true
 }
@@ -215,12 +245,17 @@ func (cm *ComponentManager) RemoveDamageFlash(entity EntityID) bool {
 // Corpse component management
 
 // AddCorpse добавляет компонент Corpse к сущности
-func (cm *ComponentManager) AddCorpse(entity EntityID, corpse Corpse) {
-	cm.corpses[entity] = corpse
+func (cm *ComponentManager) AddCorpse(entity EntityID, corpse Corpse) bool {
+	if !cm.reserve(entity) {
+		return false
+	}
+	cm.corpses[entity.Index()] = corpse
 
-	index := uint(entity) / constants.BitsPerUint64
-	bit := uint(entity) % constants.BitsPerUint64
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
 	cm.hasCorpse[index] |= 1 << bit
+	cm.notify(entity)
+	return true
 }
 
 // GetCorpse возвращает компонент Corpse сущности
@@ -228,7 +263,7 @@ func (cm *ComponentManager) GetCorpse(entity EntityID) (Corpse, bool) {
 	if !cm.HasComponent(entity, MaskCorpse) {
 		return Corpse{}, false
 	}
-	return cm.corpses[entity], true
+	return cm.corpses[entity.Index()], true
 }
 
 // SetCorpse обновляет компонент Corpse сущности
@@ -236,7 +271,7 @@ func (cm *ComponentManager) SetCorpse(entity EntityID, corpse Corpse) bool {
 	if !cm.HasComponent(entity, MaskCorpse) {
 		return false
 	}
-	cm.corpses[entity] = corpse
+	cm.corpses[entity.Index()] = corpse
 	return true
 }
 
@@ -246,10 +281,11 @@ func (cm *ComponentManager) RemoveCorpse(entity EntityID) bool {
 		return false
 	}
 
-	index := uint(entity) / constants.BitsPerUint64
-	bit := uint(entity) % constants.BitsPerUint64
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
 	cm.hasCorpse[index] &= ^(1 << bit)
-	cm.corpses[entity] = Corpse{}
+	cm.notify(entity)
+	cm.corpses[entity.Index()] = Corpse{}
 
 	return true
 }
@@ -257,12 +293,17 @@ func (cm *ComponentManager) RemoveCorpse(entity EntityID) bool {
 // Carrion component management
 
 // AddCarrion добавляет компонент Carrion к сущности
-func (cm *ComponentManager) AddCarrion(entity EntityID, carrion Carrion) {
-	cm.carrions[entity] = carrion
+func (cm *ComponentManager) AddCarrion(entity EntityID, carrion Carrion) bool {
+	if !cm.reserve(entity) {
+		return false
+	}
+	cm.carrions[entity.Index()] = carrion
 
-	index := uint(entity) / constants.BitsPerUint64
-	bit := uint(entity) % constants.BitsPerUint64
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
 	cm.hasCarrion[index] |= 1 << bit
+	cm.notify(entity)
+	return true
 }
 
 // GetCarrion возвращает компонент Carrion сущности
@@ -270,7 +311,7 @@ func (cm *ComponentManager) GetCarrion(entity EntityID) (Carrion, bool) {
 	if !cm.HasComponent(entity, MaskCarrion) {
 		return Carrion{}, false
 	}
-	return cm.carrions[entity], true
+	return cm.carrions[entity.Index()], true
 }
 
 // SetCarrion обновляет компонент Carrion сущности
@@ -278,7 +319,7 @@ func (cm *ComponentManager) SetCarrion(entity EntityID, carrion Carrion) bool {
 	if !cm.HasComponent(entity, MaskCarrion) {
 		return false
 	}
-	cm.carrions[entity] = carrion
+	cm.carrions[entity.Index()] = carrion
 	return true
 }
 
@@ -288,10 +329,11 @@ func (cm *ComponentManager) RemoveCarrion(entity EntityID) bool {
 		return false
 	}
 
-	index := uint(entity) / constants.BitsPerUint64
-	bit := uint(entity) % constants.BitsPerUint64
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
 	cm.hasCarrion[index] &= ^(1 << bit)
-	cm.carrions[entity] = Carrion{}
+	cm.notify(entity)
+	cm.carrions[entity.Index()] = Carrion{}
 
 	return true
 }
@@ -299,12 +341,17 @@ func (cm *ComponentManager) RemoveCarrion(entity EntityID) bool {
 // EatingState component management
 
 // AddEatingState добавляет компонент EatingState к сущности
-func (cm *ComponentManager) AddEatingState(entity EntityID, eatingState EatingState) {
-	cm.eatingStates[entity] = eatingState
+func (cm *ComponentManager) AddEatingState(entity EntityID, eatingState EatingState) bool {
+	if !cm.reserve(entity) {
+		return false
+	}
+	cm.eatingStates[entity.Index()] = eatingState
 
-	index := uint(entity) / constants.BitsPerUint64
-	bit := uint(entity) % constants.BitsPerUint64
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
 	cm.hasEatingState[index] |= 1 << bit
+	cm.notify(entity)
+	return true
 }
 
 // GetEatingState возвращает компонент EatingState сущности
@@ -312,7 +359,7 @@ func (cm *ComponentManager) GetEatingState(entity EntityID) (EatingState, bool)
 	if !cm.HasComponent(entity, MaskEatingState) {
 		return EatingState{}, false
 	}
-	return cm.eatingStates[entity], true
+	return cm.eatingStates[entity.Index()], true
 }
 
 // SetEatingState обновляет компонент EatingState сущности
@@ -320,7 +367,7 @@ func (cm *ComponentManager) SetEatingState(entity EntityID, eatingState EatingSt
 	if !cm.HasComponent(entity, MaskEatingState) {
 		return false
 	}
-	cm.eatingStates[entity] = eatingState
+	cm.eatingStates[entity.Index()] = eatingState
 	return true
 }
 
@@ -330,10 +377,11 @@ func (cm *ComponentManager) RemoveEatingState(entity EntityID) bool {
 		return false
 	}
 
-	index := uint(entity) / constants.BitsPerUint64
-	bit := uint(entity) % constants.BitsPerUint64
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
 	cm.hasEatingState[index] &= ^(1 << bit)
-	cm.eatingStates[entity] = EatingState{}
+	cm.notify(entity)
+	cm.eatingStates[entity.Index()] = EatingState{}
 
 	return true
 }
@@ -341,12 +389,17 @@ func (cm *ComponentManager) RemoveEatingState(entity EntityID) bool {
 // AttackState component management
 
 // AddAttackState добавляет компонент AttackState к сущности
-func (cm *ComponentManager) AddAttackState(entity EntityID, attackState AttackState) {
-	cm.attackStates[entity] = attackState
+func (cm *ComponentManager) AddAttackState(entity EntityID, attackState AttackState) bool {
+	if !cm.reserve(entity) {
+		return false
+	}
+	cm.attackStates[entity.Index()] = attackState
 
-	index := uint(entity) / constants.BitsPerUint64
-	bit := uint(entity) % constants.BitsPerUint64
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
 	cm.hasAttackState[index] |= 1 << bit
+	cm.notify(entity)
+	return true
 }
 
 // GetAttackState возвращает компонент AttackState сущности
@@ -354,7 +407,7 @@ func (cm *ComponentManager) GetAttackState(entity EntityID) (AttackState, bool)
 	if !cm.HasComponent(entity, MaskAttackState) {
 		return AttackState{}, false
 	}
-	return cm.attackStates[entity], true
+	return cm.attackStates[entity.Index()], true
 }
 
 // SetAttackState обновляет компонент AttackState сущности
@@ -362,7 +415,7 @@ func (cm *ComponentManager) SetAttackState(entity EntityID, attackState AttackSt
 	if !cm.HasComponent(entity, MaskAttackState) {
 		return false
 	}
-	cm.attackStates[entity] = attackState
+	cm.attackStates[entity.Index()] = attackState
 	return true
 }
 
@@ -372,10 +425,11 @@ func (cm *ComponentManager) RemoveAttackState(entity EntityID) bool {
 		return false
 	}
 
-	index := uint(entity) / constants.BitsPerUint64
-	bit := uint(entity) % constants.BitsPerUint64
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
 	cm.hasAttackState[index] &= ^(1 << bit)
-	cm.attackStates[entity] = AttackState{}
+	cm.notify(entity)
+	cm.attackStates[entity.Index()] = AttackState{}
 
 	return true
 }
@@ -383,12 +437,17 @@ func (cm *ComponentManager) RemoveAttackState(entity EntityID) bool {
 // Behavior component management
 
 // AddBehavior добавляет компонент Behavior к сущности
-func (cm *ComponentManager) AddBehavior(entity EntityID, behavior Behavior) {
-	cm.behaviors[entity] = behavior
+func (cm *ComponentManager) AddBehavior(entity EntityID, behavior Behavior) bool {
+	if !cm.reserve(entity) {
+		return false
+	}
+	cm.behaviors[entity.Index()] = behavior
 
-	index := uint(entity) / constants.BitsPerUint64
-	bit := uint(entity) % constants.BitsPerUint64
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
 	cm.hasBehavior[index] |= 1 << bit
+	cm.notify(entity)
+	return true
 }
 
 // GetBehavior возвращает компонент Behavior сущности
@@ -396,7 +455,7 @@ func (cm *ComponentManager) GetBehavior(entity EntityID) (Behavior, bool) {
 	if !cm.HasComponent(entity, MaskBehavior) {
 		return Behavior{}, false
 	}
-	return cm.behaviors[entity], true
+	return cm.behaviors[entity.Index()], true
 }
 
 // SetBehavior обновляет компонент Behavior сущности
@@ -404,7 +463,7 @@ func (cm *ComponentManager) SetBehavior(entity EntityID, behavior Behavior) bool
 	if !cm.HasComponent(entity, MaskBehavior) {
 		return false
 	}
-	cm.behaviors[entity] = behavior
+	cm.behaviors[entity.Index()] = behavior
 	return true
 }
 
@@ -414,10 +473,11 @@ func (cm *ComponentManager) RemoveBehavior(entity EntityID) bool {
 		return false
 	}
 
-	index := uint(entity) / constants.BitsPerUint64
-	bit := uint(entity) % constants.BitsPerUint64
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
 	cm.hasBehavior[index] &= ^(1 << bit)
-	cm.behaviors[entity] = Behavior{}
+	cm.notify(entity)
+	cm.behaviors[entity.Index()] = Behavior{}
 
 	return true
 }
@@ -425,12 +485,17 @@ func (cm *ComponentManager) RemoveBehavior(entity EntityID) bool {
 // AnimalConfig component management
 
 // AddAnimalConfig добавляет компонент AnimalConfig к сущности
-func (cm *ComponentManager) AddAnimalConfig(entity EntityID, config AnimalConfig) {
-	cm.animalConfigs[entity] = config
+func (cm *ComponentManager) AddAnimalConfig(entity EntityID, config AnimalConfig) bool {
+	if !cm.reserve(entity) {
+		return false
+	}
+	cm.animalConfigs[entity.Index()] = config
 
-	index := uint(entity) / constants.BitsPerUint64
-	bit := uint(entity) % constants.BitsPerUint64
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
 	cm.hasAnimalConfig[index] |= 1 << bit
+	cm.notify(entity)
+	return true
 }
 
 // GetAnimalConfig возвращает компонент AnimalConfig сущности
@@ -438,7 +503,7 @@ func (cm *ComponentManager) GetAnimalConfig(entity EntityID) (AnimalConfig, bool
 	if !cm.HasComponent(entity, MaskAnimalConfig) {
 		return AnimalConfig{}, false
 	}
-	return cm.animalConfigs[entity], true
+	return cm.animalConfigs[entity.Index()], true
 }
 
 // SetAnimalConfig обновляет компонент AnimalConfig сущности
@@ -446,7 +511,7 @@ func (cm *ComponentManager) SetAnimalConfig(entity EntityID, config AnimalConfig
 	if !cm.HasComponent(entity, MaskAnimalConfig) {
 		return false
 	}
-	cm.animalConfigs[entity] = config
+	cm.animalConfigs[entity.Index()] = config
 	return true
 }
 
@@ -456,10 +521,347 @@ func (cm *ComponentManager) RemoveAnimalConfig(entity EntityID) bool {
 		return false
 	}
 
-	index := uint(entity) / constants.BitsPerUint64
-	bit := uint(entity) % constants.BitsPerUint64
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
 	cm.hasAnimalConfig[index] &= ^(1 << bit)
-	cm.animalConfigs[entity] = AnimalConfig{}
+	cm.notify(entity)
+	cm.animalConfigs[entity.Index()] = AnimalConfig{}
+
+	return true
+}
+
+// ReproductionCooldown component management
+
+// AddReproductionCooldown добавляет компонент ReproductionCooldown к сущности
+func (cm *ComponentManager) AddReproductionCooldown(entity EntityID, cooldown ReproductionCooldown) bool {
+	if !cm.reserve(entity) {
+		return false
+	}
+	cm.cooldowns[entity.Index()] = cooldown
+
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
+	cm.hasCooldown[index] |= 1 << bit
+	cm.notify(entity)
+	return true
+}
+
+// GetReproductionCooldown возвращает компонент ReproductionCooldown сущности
+func (cm *ComponentManager) GetReproductionCooldown(entity EntityID) (ReproductionCooldown, bool) {
+	if !cm.HasComponent(entity, MaskReproductionCooldown) {
+		return ReproductionCooldown{}, false
+	}
+	return cm.cooldowns[entity.Index()], true
+}
+
+// SetReproductionCooldown обновляет компонент ReproductionCooldown сущности
+func (cm *ComponentManager) SetReproductionCooldown(entity EntityID, cooldown ReproductionCooldown) bool {
+	if !cm.HasComponent(entity, MaskReproductionCooldown) {
+		return false
+	}
+	cm.cooldowns[entity.Index()] = cooldown
+	return true
+}
+
+// RemoveReproductionCooldown удаляет компонент ReproductionCooldown у сущности
+func (cm *ComponentManager) RemoveReproductionCooldown(entity EntityID) bool {
+	if !cm.HasComponent(entity, MaskReproductionCooldown) {
+		return false
+	}
+
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
+	cm.hasCooldown[index] &= ^(1 << bit)
+	cm.notify(entity)
+	cm.cooldowns[entity.Index()] = ReproductionCooldown{}
+
+	return true
+}
+
+// Pregnancy component management
+
+// AddPregnancy добавляет компонент Pregnancy к сущности
+func (cm *ComponentManager) AddPregnancy(entity EntityID, pregnancy Pregnancy) bool {
+	if !cm.reserve(entity) {
+		return false
+	}
+	cm.pregnancies[entity.Index()] = pregnancy
+
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
+	cm.hasPregnancy[index] |= 1 << bit
+	cm.notify(entity)
+	return true
+}
+
+// GetPregnancy возвращает компонент Pregnancy сущности
+func (cm *ComponentManager) GetPregnancy(entity EntityID) (Pregnancy, bool) {
+	if !cm.HasComponent(entity, MaskPregnancy) {
+		return Pregnancy{}, false
+	}
+	return cm.pregnancies[entity.Index()], true
+}
+
+// SetPregnancy обновляет компонент Pregnancy сущности
+func (cm *ComponentManager) SetPregnancy(entity EntityID, pregnancy Pregnancy) bool {
+	if !cm.HasComponent(entity, MaskPregnancy) {
+		return false
+	}
+	cm.pregnancies[entity.Index()] = pregnancy
+	return true
+}
+
+// RemovePregnancy удаляет компонент Pregnancy у сущности
+func (cm *ComponentManager) RemovePregnancy(entity EntityID) bool {
+	if !cm.HasComponent(entity, MaskPregnancy) {
+		return false
+	}
+
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
+	cm.hasPregnancy[index] &= ^(1 << bit)
+	cm.notify(entity)
+	cm.pregnancies[entity.Index()] = Pregnancy{}
+
+	return true
+}
+
+// Age component management
+
+// AddAge добавляет компонент Age к сущности
+func (cm *ComponentManager) AddAge(entity EntityID, age Age) bool {
+	if !cm.reserve(entity) {
+		return false
+	}
+	cm.ages[entity.Index()] = age
+
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
+	cm.hasAge[index] |= 1 << bit
+	cm.notify(entity)
+	return true
+}
+
+// GetAge возвращает компонент Age сущности
+func (cm *ComponentManager) GetAge(entity EntityID) (Age, bool) {
+	if !cm.HasComponent(entity, MaskAge) {
+		return Age{}, false
+	}
+	return cm.ages[entity.Index()], true
+}
+
+// SetAge обновляет компонент Age сущности
+func (cm *ComponentManager) SetAge(entity EntityID, age Age) bool {
+	if !cm.HasComponent(entity, MaskAge) {
+		return false
+	}
+	cm.ages[entity.Index()] = age
+	return true
+}
+
+// RemoveAge удаляет компонент Age у сущности
+func (cm *ComponentManager) RemoveAge(entity EntityID) bool {
+	if !cm.HasComponent(entity, MaskAge) {
+		return false
+	}
+
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
+	cm.hasAge[index] &= ^(1 << bit)
+	cm.notify(entity)
+	cm.ages[entity.Index()] = Age{}
+
+	return true
+}
+
+// Hydration component management
+
+// AddHydration добавляет компонент Hydration к сущности
+func (cm *ComponentManager) AddHydration(entity EntityID, hydration Hydration) bool {
+	if !cm.reserve(entity) {
+		return false
+	}
+	cm.hydrations[entity.Index()] = hydration
+
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
+	cm.hasHydration[index] |= 1 << bit
+	cm.notify(entity)
+	return true
+}
+
+// GetHydration возвращает компонент Hydration сущности
+func (cm *ComponentManager) GetHydration(entity EntityID) (Hydration, bool) {
+	if !cm.HasComponent(entity, MaskHydration) {
+		return Hydration{}, false
+	}
+	return cm.hydrations[entity.Index()], true
+}
+
+// SetHydration обновляет компонент Hydration сущности
+func (cm *ComponentManager) SetHydration(entity EntityID, hydration Hydration) bool {
+	if !cm.HasComponent(entity, MaskHydration) {
+		return false
+	}
+	cm.hydrations[entity.Index()] = hydration
+	return true
+}
+
+// RemoveHydration удаляет компонент Hydration у сущности
+func (cm *ComponentManager) RemoveHydration(entity EntityID) bool {
+	if !cm.HasComponent(entity, MaskHydration) {
+		return false
+	}
+
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
+	cm.hasHydration[index] &= ^(1 << bit)
+	cm.notify(entity)
+	cm.hydrations[entity.Index()] = Hydration{}
+
+	return true
+}
+
+// DrinkingState component management
+
+// AddDrinkingState добавляет компонент DrinkingState к сущности
+func (cm *ComponentManager) AddDrinkingState(entity EntityID, drinkingState DrinkingState) bool {
+	if !cm.reserve(entity) {
+		return false
+	}
+	cm.drinkingStates[entity.Index()] = drinkingState
+
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
+	cm.hasDrinkingState[index] |= 1 << bit
+	cm.notify(entity)
+	return true
+}
+
+// GetDrinkingState возвращает компонент DrinkingState сущности
+func (cm *ComponentManager) GetDrinkingState(entity EntityID) (DrinkingState, bool) {
+	if !cm.HasComponent(entity, MaskDrinkingState) {
+		return DrinkingState{}, false
+	}
+	return cm.drinkingStates[entity.Index()], true
+}
+
+// SetDrinkingState обновляет компонент DrinkingState сущности
+func (cm *ComponentManager) SetDrinkingState(entity EntityID, drinkingState DrinkingState) bool {
+	if !cm.HasComponent(entity, MaskDrinkingState) {
+		return false
+	}
+	cm.drinkingStates[entity.Index()] = drinkingState
+	return true
+}
+
+// RemoveDrinkingState удаляет компонент DrinkingState у сущности
+func (cm *ComponentManager) RemoveDrinkingState(entity EntityID) bool {
+	if !cm.HasComponent(entity, MaskDrinkingState) {
+		return false
+	}
+
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
+	cm.hasDrinkingState[index] &= ^(1 << bit)
+	cm.notify(entity)
+	cm.drinkingStates[entity.Index()] = DrinkingState{}
+
+	return true
+}
+
+// PackMembership component management
+
+// AddPackMembership добавляет компонент PackMembership к сущности
+func (cm *ComponentManager) AddPackMembership(entity EntityID, pack PackMembership) bool {
+	if !cm.reserve(entity) {
+		return false
+	}
+	cm.packs[entity.Index()] = pack
+
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
+	cm.hasPack[index] |= 1 << bit
+	cm.notify(entity)
+	return true
+}
+
+// GetPackMembership возвращает компонент PackMembership сущности
+func (cm *ComponentManager) GetPackMembership(entity EntityID) (PackMembership, bool) {
+	if !cm.HasComponent(entity, MaskPackMembership) {
+		return PackMembership{}, false
+	}
+	return cm.packs[entity.Index()], true
+}
+
+// SetPackMembership обновляет компонент PackMembership сущности
+func (cm *ComponentManager) SetPackMembership(entity EntityID, pack PackMembership) bool {
+	if !cm.HasComponent(entity, MaskPackMembership) {
+		return false
+	}
+	cm.packs[entity.Index()] = pack
+	return true
+}
+
+// RemovePackMembership удаляет компонент PackMembership у сущности
+func (cm *ComponentManager) RemovePackMembership(entity EntityID) bool {
+	if !cm.HasComponent(entity, MaskPackMembership) {
+		return false
+	}
+
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
+	cm.hasPack[index] &= ^(1 << bit)
+	cm.notify(entity)
+	cm.packs[entity.Index()] = PackMembership{}
+
+	return true
+}
+
+// Concealment component management
+
+// AddConcealment добавляет компонент Concealment к сущности
+func (cm *ComponentManager) AddConcealment(entity EntityID, concealment Concealment) bool {
+	if !cm.reserve(entity) {
+		return false
+	}
+	cm.concealments[entity.Index()] = concealment
+
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
+	cm.hasConcealment[index] |= 1 << bit
+	cm.notify(entity)
+	return true
+}
+
+// GetConcealment возвращает компонент Concealment сущности
+func (cm *ComponentManager) GetConcealment(entity EntityID) (Concealment, bool) {
+	if !cm.HasComponent(entity, MaskConcealment) {
+		return Concealment{}, false
+	}
+	return cm.concealments[entity.Index()], true
+}
+
+// SetConcealment обновляет компонент Concealment сущности
+func (cm *ComponentManager) SetConcealment(entity EntityID, concealment Concealment) bool {
+	if !cm.HasComponent(entity, MaskConcealment) {
+		return false
+	}
+	cm.concealments[entity.Index()] = concealment
+	return true
+}
+
+// RemoveConcealment удаляет компонент Concealment у сущности
+func (cm *ComponentManager) RemoveConcealment(entity EntityID) bool {
+	if !cm.HasComponent(entity, MaskConcealment) {
+		return false
+	}
+
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
+	cm.hasConcealment[index] &= ^(1 << bit)
+	cm.notify(entity)
+	cm.concealments[entity.Index()] = Concealment{}
 
 	return true
 }
@@ -5,12 +5,17 @@ import "github.com/aiseeq/savanna/internal/constants"
 // Position component management
 
 // AddPosition добавляет компонент Position к сущности
-func (cm *ComponentManager) AddPosition(entity EntityID, position Position) {
-	cm.positions[entity] = position
+func (cm *ComponentManager) AddPosition(entity EntityID, position Position) bool {
+	if !cm.reserve(entity) {
+		return false
+	}
+	cm.positions[entity.Index()] = position
 
-	index := uint(entity) / constants.BitsPerUint64
-	bit := uint(entity) % constants.BitsPerUint64
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
 	cm.hasPosition[index] |= 1 << bit
+	cm.notify(entity)
+	return true
 }
 
 // GetPosition возвращает компонент Position сущности
@@ -18,7 +23,7 @@ func (cm *ComponentManager) GetPosition(entity EntityID) (Position, bool) {
 	if !cm.HasComponent(entity, MaskPosition) {
 		return NewPosition(0, 0), false
 	}
-	return cm.positions[entity], true
+	return cm.positions[entity.Index()], true
 }
 
 // SetPosition обновляет компонент Position сущности
@@ -26,7 +31,7 @@ func (cm *ComponentManager) SetPosition(entity EntityID, position Position) bool
 	if !cm.HasComponent(entity, MaskPosition) {
 		return false
 	}
-	cm.positions[entity] = position
+	cm.positions[entity.Index()] = position
 	return true
 }
 
@@ -36,10 +41,11 @@ func (cm *ComponentManager) RemovePosition(entity EntityID) bool {
 		return false
 	}
 
-	index := uint(entity) / constants.BitsPerUint64
-	bit := uint(entity) % constants.BitsPerUint64
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
 	cm.hasPosition[index] &= ^(1 << bit)
-	cm.positions[entity] = NewPosition(0, 0) // Очистка данных
+	cm.notify(entity)
+	cm.positions[entity.Index()] = NewPosition(0, 0) // Очистка данных
 
 	return true
 }
@@ -47,12 +53,17 @@ func (cm *ComponentManager) RemovePosition(entity EntityID) bool {
 // Velocity component management
 
 // AddVelocity добавляет компонент Velocity к сущности
-func (cm *ComponentManager) AddVelocity(entity EntityID, velocity Velocity) {
-	cm.velocities[entity] = velocity
+func (cm *ComponentManager) AddVelocity(entity EntityID, velocity Velocity) bool {
+	if !cm.reserve(entity) {
+		return false
+	}
+	cm.velocities[entity.Index()] = velocity
 
-	index := uint(entity) / constants.BitsPerUint64
-	bit := uint(entity) % constants.BitsPerUint64
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
 	cm.hasVelocity[index] |= 1 << bit
+	cm.notify(entity)
+	return true
 }
 
 // GetVelocity возвращает компонент Velocity сущности
@@ -60,7 +71,7 @@ func (cm *ComponentManager) GetVelocity(entity EntityID) (Velocity, bool) {
 	if !cm.HasComponent(entity, MaskVelocity) {
 		return NewVelocity(0, 0), false
 	}
-	return cm.velocities[entity], true
+	return cm.velocities[entity.Index()], true
 }
 
 // SetVelocity обновляет компонент Velocity сущности
@@ -68,7 +79,7 @@ func (cm *ComponentManager) SetVelocity(entity EntityID, velocity Velocity) bool
 	if !cm.HasComponent(entity, MaskVelocity) {
 		return false
 	}
-	cm.velocities[entity] = velocity
+	cm.velocities[entity.Index()] = velocity
 	return true
 }
 
@@ -78,10 +89,11 @@ func (cm *ComponentManager) RemoveVelocity(entity EntityID) bool {
 		return false
 	}
 
-	index := uint(entity) / constants.BitsPerUint64
-	bit := uint(entity) % constants.BitsPerUint64
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
 	cm.hasVelocity[index] &= ^(1 << bit)
-	cm.velocities[entity] = NewVelocity(0, 0) // Очистка данных
+	cm.notify(entity)
+	cm.velocities[entity.Index()] = NewVelocity(0, 0) // Очистка данных
 
 	return true
 }
@@ -89,12 +101,17 @@ func (cm *ComponentManager) RemoveVelocity(entity EntityID) bool {
 // Health component management
 
 // AddHealth добавляет компонент Health к сущности
-func (cm *ComponentManager) AddHealth(entity EntityID, health Health) {
-	cm.healths[entity] = health
+func (cm *ComponentManager) AddHealth(entity EntityID, health Health) bool {
+	if !cm.reserve(entity) {
+		return false
+	}
+	cm.healths[entity.Index()] = health
 
-	index := uint(entity) / constants.BitsPerUint64
-	bit := uint(entity) % constants.BitsPerUint64
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
 	cm.hasHealth[index] |= 1 << bit
+	cm.notify(entity)
+	return true
 }
 
 // GetHealth возвращает компонент Health сущности
@@ -102,7 +119,7 @@ func (cm *ComponentManager) GetHealth(entity EntityID) (Health, bool) {
 	if !cm.HasComponent(entity, MaskHealth) {
 		return Health{}, false
 	}
-	return cm.healths[entity], true
+	return cm.healths[entity.Index()], true
 }
 
 // SetHealth обновляет компонент Health сущности
@@ -110,7 +127,7 @@ func (cm *ComponentManager) SetHealth(entity EntityID, health Health) bool {
 	if !cm.HasComponent(entity, MaskHealth) {
 		return false
 	}
-	cm.healths[entity] = health
+	cm.healths[entity.Index()] = health
 	return true
 }
 
@@ -120,10 +137,11 @@ func (cm *ComponentManager) RemoveHealth(entity EntityID) bool {
 		return false
 	}
 
-	index := uint(entity) / constants.BitsPerUint64
-	bit := uint(entity) % constants.BitsPerUint64
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
 	cm.hasHealth[index] &= ^(1 << bit)
-	cm.healths[entity] = Health{} // Очистка данных
+	cm.notify(entity)
+	cm.healths[entity.Index()] = Health{} // Очистка данных
 
 	return true
 }
@@ -131,12 +149,17 @@ func (cm *ComponentManager) RemoveHealth(entity EntityID) bool {
 // Satiation component management
 
 // AddSatiation добавляет компонент Satiation к сущности
-func (cm *ComponentManager) AddSatiation(entity EntityID, satiation Satiation) {
-	cm.satiations[entity] = satiation
+func (cm *ComponentManager) AddSatiation(entity EntityID, satiation Satiation) bool {
+	if !cm.reserve(entity) {
+		return false
+	}
+	cm.satiations[entity.Index()] = satiation
 
-	index := uint(entity) / constants.BitsPerUint64
-	bit := uint(entity) % constants.BitsPerUint64
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
 	cm.hasSatiation[index] |= 1 << bit
+	cm.notify(entity)
+	return true
 }
 
 // GetSatiation возвращает компонент Satiation сущности
@@ -144,7 +167,7 @@ func (cm *ComponentManager) GetSatiation(entity EntityID) (Satiation, bool) {
 	if !cm.HasComponent(entity, MaskSatiation) {
 		return Satiation{}, false
 	}
-	return cm.satiations[entity], true
+	return cm.satiations[entity.Index()], true
 }
 
 // SetSatiation обновляет компонент Satiation сущности
@@ -152,7 +175,7 @@ func (cm *ComponentManager) SetSatiation(entity EntityID, satiation Satiation) b
 	if !cm.HasComponent(entity, MaskSatiation) {
 		return false
 	}
-	cm.satiations[entity] = satiation
+	cm.satiations[entity.Index()] = satiation
 	return true
 }
 
@@ -162,10 +185,11 @@ func (cm *ComponentManager) RemoveSatiation(entity EntityID) bool {
 		return false
 	}
 
-	index := uint(entity) / constants.BitsPerUint64
-	bit := uint(entity) % constants.BitsPerUint64
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
 	cm.hasSatiation[index] &= ^(1 << bit)
-	cm.satiations[entity] = Satiation{} // Очистка данных
+	cm.notify(entity)
+	cm.satiations[entity.Index()] = Satiation{} // Очистка данных
 
 	return true
 }
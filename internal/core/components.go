@@ -25,6 +25,11 @@ type Satiation struct {
 	Value float32 // 0 = умирает от голода, 100 = сыт
 }
 
+// Hydration уровень гидратации (0-100)
+type Hydration struct {
+	Value float32 // 0 = умирает от жажды, 100 = напился
+}
+
 // AnimalType тип животного
 type AnimalType uint8
 
@@ -33,20 +38,17 @@ const (
 	TypeRabbit                   // Заяц (травоядное)
 	TypeWolf                     // Волк (хищник)
 	TypeGrass                    // Трава (для будущего расширения)
+
+	// Типы видов из файлов описаний выделяются динамически после встроенных (RegisterAnimalType)
 )
 
 // String возвращает строковое представление типа животного
+// Имена встроенных и загруженных из файлов видов хранятся в реестре типов
 func (at AnimalType) String() string {
-	switch at {
-	case TypeRabbit:
-		return "Rabbit"
-	case TypeWolf:
-		return "Wolf"
-	case TypeGrass:
-		return "Grass"
-	default:
-		return "None"
+	if name, ok := animalTypeName(at); ok {
+		return name
 	}
+	return "None"
 }
 
 // Size размер сущности (радиус для коллизий и атак)
@@ -108,6 +110,31 @@ type EatingState struct {
 	NutritionGained float32          // Сколько уже получили питательности
 }
 
+// DrinkingState состояние питья
+// Животное стоит на тайле рядом с водой и пьёт, пока не напьётся
+type DrinkingState struct {
+	Duration float32 // Сколько времени уже пьёт (секунды)
+}
+
+// PackMembership членство хищника в стае
+// У вожака Leader указывает на него самого; цель охоты выбирает вожак, PackSystem копирует её всем членам
+type PackMembership struct {
+	Leader EntityID // Вожак стаи
+	Target EntityID // Общая цель: добыча или её труп (0 - стая не охотится)
+	Slot   uint8    // Номер в стае: 0 - вожак, у загонщиков задаёт сторону и дальность обхода
+}
+
+// IsLeader проверяет что член стаи - вожак
+func (m PackMembership) IsLeader(entity EntityID) bool {
+	return m.Leader == entity
+}
+
+// Concealment животное прячется в кустах или прижимается к ним
+// Хищник замечает его только на доле своей дальности зрения
+type Concealment struct {
+	Visibility float32 // Доля дальности зрения хищника, на которой животное заметно (0-1)
+}
+
 // AttackPhase фаза атаки
 type AttackPhase uint8
 
@@ -144,7 +171,8 @@ const (
 	BehaviorNone      BehaviorType = iota // Нет поведения
 	BehaviorHerbivore                     // Травоядное (ищет траву, убегает от хищников)
 	BehaviorPredator                      // Хищник (охотится на других животных)
-	// УДАЛЕНО: BehaviorScavenger - не используется в игре (нет падальщиков)
+	BehaviorScavenger                     // Падальщик (ест трупы и падаль, не охотится)
+	BehaviorOmnivore                      // Всеядное (пасётся и доедает туши, убегает от хищников)
 )
 
 // String возвращает строковое представление типа поведения
@@ -154,7 +182,10 @@ func (bt BehaviorType) String() string {
 		return "Herbivore"
 	case BehaviorPredator:
 		return "Predator"
-	// УДАЛЕНО: BehaviorScavenger
+	case BehaviorScavenger:
+		return "Scavenger"
+	case BehaviorOmnivore:
+		return "Omnivore"
 	default:
 		return "None"
 	}
@@ -174,8 +205,12 @@ type AnimalConfig struct {
 	VisionRange     float32 // Дальность видения (BaseRadius * множитель)
 
 	// Поведение
-	SatiationThreshold float32 // При какой сытости начинает искать еду
-	FleeThreshold      float32 // Дистанция на которой убегает от угрозы
+	Diet               BehaviorType // Тип питания (BehaviorNone - определяется по AttackRange)
+	SatiationThreshold float32      // При какой сытости начинает искать еду
+	FleeThreshold      float32      // Дистанция на которой убегает от угрозы
+
+	// Скорость потери сытости в секунду (0 - базовая скорость с учётом размера животного)
+	SatiationDecreaseRate float32
 
 	// Множители скорости в разных состояниях
 	SearchSpeed    float32 // Множитель скорости при поиске еды (0.8)
@@ -186,10 +221,74 @@ type AnimalConfig struct {
 	MinDirectionTime float32 // Минимальное время случайного движения
 	MaxDirectionTime float32 // Максимальное время случайного движения
 
+	// Стадо (HerdRadius = 0 - животное держится особняком, HearingRange = 0 - не слышит тревоги)
+	HerdRadius     float32 // Радиус соседей по стаду (тайлы)
+	HerdCohesion   float32 // Вес притяжения к центру стада
+	HerdAlignment  float32 // Вес выравнивания направления по соседям
+	HerdSeparation float32 // Вес отталкивания от слишком близких соседей
+	HearingRange   float32 // Дальность, на которой слышна тревога сородичей (тайлы)
+
 	// Боевые характеристики
 	AttackDamage   int16   // Урон атаки
 	AttackCooldown float32 // Кулдаун между атаками
 	HitChance      float32 // Шанс попадания (0.0-1.0)
+
+	// Размножение (GestationTime = 0 - животное не размножается)
+	ReproductionCooldown float32 // Время между спариваниями (секунды)
+	GestationTime        float32 // Время вынашивания потомства (секунды)
+
+	// Возраст (MaxAge = 0 - животное не стареет)
+	MaturityAge float32 // Возраст взросления (секунды)
+	ElderAge    float32 // Возраст начала старения (секунды)
+	MaxAge      float32 // Возраст естественной смерти (секунды)
+}
+
+// ReproductionCooldown время до следующего спаривания
+// Добавляется обоим родителям при спаривании и новорождённым
+type ReproductionCooldown struct {
+	Timer      float32 // Оставшееся время до готовности к спариванию
+	BreedTimer float32 // Оставшееся время анимации спаривания (сердечки)
+}
+
+// Pregnancy вынашивание потомства
+// Конфигурация потомка вычисляется при зачатии (наследование + мутация)
+type Pregnancy struct {
+	Timer           float32      // Оставшееся время вынашивания
+	OffspringConfig AnimalConfig // Конфигурация будущего потомка
+}
+
+// LifeStage стадия жизни животного
+type LifeStage uint8
+
+const (
+	LifeStageJuvenile LifeStage = iota // Детёныш - растёт, слабее и медленнее взрослого
+	LifeStageAdult                     // Взрослый - полные характеристики, может размножаться
+	LifeStageElder                     // Старый - теряет скорость и здоровье до естественной смерти
+)
+
+// String возвращает строковое представление стадии жизни
+func (ls LifeStage) String() string {
+	switch ls {
+	case LifeStageJuvenile:
+		return "Juvenile"
+	case LifeStageAdult:
+		return "Adult"
+	case LifeStageElder:
+		return "Elder"
+	default:
+		return "Unknown"
+	}
+}
+
+// Age возраст животного и множители характеристик текущей стадии жизни
+// Множители пересчитываются системой старения, остальные системы только читают их
+type Age struct {
+	Seconds          float32   // Прожитое время (секунды)
+	Stage            LifeStage // Текущая стадия жизни
+	SizeMultiplier   float32   // Множитель размера тела (радиус коллизий, спрайт)
+	SpeedMultiplier  float32   // Множитель скорости
+	AttackMultiplier float32   // Множитель урона атаки
+	HealthMultiplier float32   // Множитель максимального здоровья
 }
 
 // Behavior поведение животного
@@ -225,6 +324,13 @@ const (
 	MaskAttackState
 	MaskBehavior
 	MaskAnimalConfig
+	MaskReproductionCooldown
+	MaskPregnancy
+	MaskAge
+	MaskHydration
+	MaskDrinkingState
+	MaskPackMembership
+	MaskConcealment
 )
 
 // HasComponent проверяет наличие компонента в маске
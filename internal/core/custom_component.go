@@ -0,0 +1,199 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/aiseeq/savanna/internal/constants"
+)
+
+// Биты масок пользовательских компонентов идут после встроенных
+const (
+	firstCustomComponentBit = componentTypeCount
+	MaxCustomComponents     = constants.BitsPerUint64 - componentTypeCount
+)
+
+// Ошибки регистрации пользовательских компонентов
+var (
+	ErrTooManyComponents = errors.New("too many custom components")
+	ErrComponentName     = errors.New("invalid custom component name")
+)
+
+// customStorage хранилище пользовательского компонента без параметра типа (для ComponentManager)
+type customStorage interface {
+	componentBitset() componentBitset
+	grow(capacity int)
+	clear(index uint32)
+	reset()
+	componentName() string
+	encode(index uint32) (json.RawMessage, bool, error)
+}
+
+// componentStorage SoA-хранилище значений пользовательского компонента
+type componentStorage[T any] struct {
+	name   string // Имя регистрации - ключ значений в снапшоте
+	mask   ComponentMask
+	values []T
+	bits   []uint64
+}
+
+func (s *componentStorage[T]) componentBitset() componentBitset {
+	return componentBitset{mask: s.mask, bits: s.bits}
+}
+
+func (s *componentStorage[T]) grow(capacity int) {
+	s.values = growStorage(s.values, capacity)
+	s.bits = growBitset(s.bits, capacity/constants.BitsPerUint64)
+}
+
+func (s *componentStorage[T]) clear(index uint32) {
+	var zero T
+	s.values[index] = zero
+	s.bits[index/constants.BitsPerUint64] &^= 1 << (index % constants.BitsPerUint64)
+}
+
+// reset удаляет значения всех сущностей (World.Clear), регистрация сохраняется
+func (s *componentStorage[T]) reset() {
+	s.values = nil
+	s.bits = nil
+}
+
+func (s *componentStorage[T]) componentName() string {
+	return s.name
+}
+
+// encode кодирует значение слота в JSON для снапшота; false - у слота нет компонента
+func (s *componentStorage[T]) encode(index uint32) (json.RawMessage, bool, error) {
+	if s.bits[index/constants.BitsPerUint64]&(1<<(index%constants.BitsPerUint64)) == 0 {
+		return nil, false, nil
+	}
+
+	data, err := json.Marshal(s.values[index])
+	if err != nil {
+		return nil, true, fmt.Errorf("failed to encode custom component %q: %w", s.name, err)
+	}
+	return data, true, nil
+}
+
+// Component дескриптор пользовательского компонента T, зарегистрированного в мире
+// Компонент участвует в масках и запросах наравне со встроенными:
+//
+//	wet, _ := core.RegisterComponent[Wetness](world, "wetness")
+//	wet.Add(entity, Wetness{Level: 1})
+//	world.ForEachWith(core.MaskPosition|wet.Mask(), ...)
+//
+// Значения сохраняются в снапшоты под именем регистрации, поэтому T должен кодироваться encoding/json
+type Component[T any] struct {
+	world   *World
+	storage *componentStorage[T]
+}
+
+// RegisterComponent регистрирует компонент T под именем name и выделяет ему бит маски
+// Повторная регистрация того же типа с тем же именем возвращает тот же компонент.
+// В мире, восстановленном из снапшота, компонент получает сохранённые под этим именем значения
+func RegisterComponent[T any](world *World, name string) (*Component[T], error) {
+	componentType := reflect.TypeFor[T]()
+	if existing, ok := world.customComponents[componentType]; ok {
+		storage := existing.(*componentStorage[T])
+		if storage.name != name {
+			return nil, fmt.Errorf("%w: %v is already registered as %q", ErrComponentName, componentType, storage.name)
+		}
+		return &Component[T]{world: world, storage: storage}, nil
+	}
+
+	if name == "" {
+		return nil, fmt.Errorf("%w: %v has empty name", ErrComponentName, componentType)
+	}
+	for _, storage := range world.customComponents {
+		if storage.componentName() == name {
+			return nil, fmt.Errorf("%w: %q is already used by another type", ErrComponentName, name)
+		}
+	}
+	if len(world.customComponents) >= MaxCustomComponents {
+		return nil, fmt.Errorf("%w: cannot register %v, limit %d", ErrTooManyComponents, componentType, MaxCustomComponents)
+	}
+
+	saved, err := decodeCustomValues[T](world.pendingCustom[name])
+	if err != nil {
+		return nil, err
+	}
+
+	bit := firstCustomComponentBit + len(world.customComponents)
+	storage := &componentStorage[T]{name: name, mask: ComponentMask(1) << bit}
+	world.customComponents[componentType] = storage
+	world.componentManager.addCustomStorage(storage)
+
+	component := &Component[T]{world: world, storage: storage}
+	for i, value := range saved {
+		component.Add(world.pendingCustom[name].Values[i].ID, value)
+	}
+	delete(world.pendingCustom, name)
+
+	return component, nil
+}
+
+// decodeCustomValues декодирует сохранённые в снапшоте значения компонента
+func decodeCustomValues[T any](saved CustomComponentSnapshot) ([]T, error) {
+	values := make([]T, len(saved.Values))
+	for i, entry := range saved.Values {
+		if err := json.Unmarshal(entry.Value, &values[i]); err != nil {
+			return nil, fmt.Errorf("%w: custom component %q of entity %d: %v", ErrInvalidSnapshot, saved.Name, entry.ID, err)
+		}
+	}
+	return values, nil
+}
+
+// Mask возвращает бит маски компонента
+func (c *Component[T]) Mask() ComponentMask {
+	return c.storage.mask
+}
+
+// Has проверяет наличие компонента у сущности
+func (c *Component[T]) Has(entity EntityID) bool {
+	return c.world.componentManager.HasComponent(entity, c.storage.mask)
+}
+
+// Add добавляет компонент сущности (false для мёртвой сущности или устаревшего дескриптора)
+func (c *Component[T]) Add(entity EntityID, value T) bool {
+	cm := c.world.componentManager
+	if !cm.reserve(entity) {
+		return false
+	}
+	c.storage.values[entity.Index()] = value
+
+	index := uint(entity.Index()) / constants.BitsPerUint64
+	bit := uint(entity.Index()) % constants.BitsPerUint64
+	c.storage.bits[index] |= 1 << bit
+	cm.notify(entity)
+	return true
+}
+
+// Get возвращает компонент сущности
+func (c *Component[T]) Get(entity EntityID) (T, bool) {
+	if !c.Has(entity) {
+		var zero T
+		return zero, false
+	}
+	return c.storage.values[entity.Index()], true
+}
+
+// Set обновляет компонент сущности
+func (c *Component[T]) Set(entity EntityID, value T) bool {
+	if !c.Has(entity) {
+		return false
+	}
+	c.storage.values[entity.Index()] = value
+	return true
+}
+
+// Remove удаляет компонент у сущности
+func (c *Component[T]) Remove(entity EntityID) bool {
+	if !c.Has(entity) {
+		return false
+	}
+	c.storage.clear(entity.Index())
+	c.world.componentManager.notify(entity)
+	return true
+}
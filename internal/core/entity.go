@@ -1,75 +1,126 @@
 package core
 
-// EntityID уникальный идентификатор сущности
-// Используем uint16 для поддержки до 65535 сущностей
-type EntityID uint16
-
-// MaxEntities максимальное количество сущностей в мире
-const MaxEntities = 1000
+import "math"
+
+// EntityID дескриптор сущности: индекс слота (младшие 32 бита) и поколение слота (старшие 32 бита)
+// Слот уничтоженной сущности переиспользуется с новым поколением, поэтому сохранённые ссылки
+// (EatingState.Target, AttackState.Target, Carrion.AbandonedBy) не указывают на чужую сущность:
+// IsAlive и все геттеры компонентов отвергают дескриптор с устаревшим поколением
+type EntityID uint64
+
+// Параметры дескриптора сущности
+const (
+	EntityIndexBits = 32             // Биты индекса слота
+	MaxEntityIndex  = math.MaxUint32 // Предельный индекс слота (исчерпание пространства ID)
+	MaxGeneration   = math.MaxUint32 // Слот с исчерпанным поколением больше не переиспользуется
+	entityIndexMask = 1<<EntityIndexBits - 1
+)
+
+// EntityChunkSize шаг роста хранилищ сущностей и компонентов
+// Хранилища растут блоками, кратными размеру блока (не меньше удвоения), без копирования на каждую сущность
+const EntityChunkSize = 1024
 
 // InvalidEntity специальное значение для несуществующей сущности
 const InvalidEntity EntityID = 0
 
-// EntityManager управляет созданием, удалением и переиспользованием ID сущностей
+// NewEntityID собирает дескриптор из индекса слота и поколения
+func NewEntityID(index, generation uint32) EntityID {
+	return EntityID(generation)<<EntityIndexBits | EntityID(index)
+}
+
+// Index возвращает индекс слота: по нему хранятся компоненты
+func (id EntityID) Index() uint32 {
+	return uint32(id & entityIndexMask)
+}
+
+// Generation возвращает поколение слота, для которого выдан дескриптор
+func (id EntityID) Generation() uint32 {
+	return uint32(id >> EntityIndexBits)
+}
+
+// EntityManager управляет созданием, удалением и переиспользованием слотов сущностей
 type EntityManager struct {
-	nextID  EntityID          // Следующий доступный ID
-	freeIDs []EntityID        // Освобождённые ID для переиспользования
-	alive   [MaxEntities]bool // Битовая маска живых сущностей
-	count   int               // Количество живых сущностей
+	nextID      EntityID   // Следующий ещё не выданный слот (поколение 0)
+	freeIDs     []EntityID // Дескрипторы для переиспользования: освобождённый слот с новым поколением
+	alive       []bool     // Флаги живых слотов, растут вместе с nextID
+	generations []uint32   // Текущее поколение каждого слота
+	count       int        // Количество живых сущностей
 }
 
 // NewEntityManager создаёт новый менеджер сущностей
 func NewEntityManager() *EntityManager {
 	return &EntityManager{
-		nextID:  1,                                  // Начинаем с 1, т.к. 0 это InvalidEntity
-		freeIDs: make([]EntityID, 0, MaxEntities/4), // Предварительно выделяем память
-		count:   0,
+		nextID:      1,                                      // Начинаем с 1, т.к. 0 это InvalidEntity
+		freeIDs:     make([]EntityID, 0, EntityChunkSize/4), // Предварительно выделяем память
+		alive:       make([]bool, EntityChunkSize),
+		generations: make([]uint32, EntityChunkSize),
+		count:       0,
 	}
 }
 
-// CreateEntity создаёт новую сущность и возвращает её ID
+// CreateEntity создаёт новую сущность и возвращает её дескриптор
 func (em *EntityManager) CreateEntity() EntityID {
 	var id EntityID
 
-	// Если есть освобождённые ID, переиспользуем их
+	// Если есть освобождённые слоты, переиспользуем их (поколение уже увеличено при удалении)
 	if len(em.freeIDs) > 0 {
 		id = em.freeIDs[len(em.freeIDs)-1]
 		em.freeIDs = em.freeIDs[:len(em.freeIDs)-1]
 	} else {
-		// Иначе используем следующий доступный ID
-		if em.nextID >= MaxEntities {
-			return InvalidEntity // Достигли лимита сущностей
+		// Иначе используем следующий доступный слот
+		if em.nextID.Index() == MaxEntityIndex {
+			return InvalidEntity // Исчерпано пространство ID
 		}
 		id = em.nextID
 		em.nextID++
+		em.grow(int(id) + 1)
 	}
 
-	em.alive[id] = true
+	em.alive[id.Index()] = true
 	em.count++
 	return id
 }
 
-// DestroyEntity уничтожает сущность и освобождает её ID для переиспользования
+// DestroyEntity уничтожает сущность и освобождает её слот для переиспользования
 func (em *EntityManager) DestroyEntity(id EntityID) bool {
-	if id == InvalidEntity || id >= MaxEntities || !em.alive[id] {
-		return false // Сущность не существует
+	if !em.IsAlive(id) {
+		return false // Сущность не существует или дескриптор устарел
 	}
 
-	em.alive[id] = false
+	index := id.Index()
+	em.alive[index] = false
 	em.count--
 
-	// Добавляем ID в список свободных для переиспользования
-	em.freeIDs = append(em.freeIDs, id)
+	// Новое поколение делает все сохранённые дескрипторы слота недействительными
+	if id.Generation() == MaxGeneration {
+		return true // Поколения исчерпаны - слот выводится из оборота
+	}
+	em.generations[index] = id.Generation() + 1
+	em.freeIDs = append(em.freeIDs, NewEntityID(index, em.generations[index]))
 
 	return true
 }
 
-// IsAlive проверяет, существует ли сущность
+// IsAlive проверяет, существует ли сущность и не устарел ли дескриптор
 func (em *EntityManager) IsAlive(id EntityID) bool {
-	if id == InvalidEntity || id >= MaxEntities {
+	index := id.Index()
+	if index == 0 || int(index) >= len(em.alive) {
 		return false
 	}
-	return em.alive[id]
+	return em.alive[index] && em.generations[index] == id.Generation()
+}
+
+// entityAt возвращает дескриптор живой сущности в слоте (для итерации запросов)
+func (em *EntityManager) entityAt(index uint32) (EntityID, bool) {
+	if !em.alive[index] {
+		return InvalidEntity, false
+	}
+	return NewEntityID(index, em.generations[index]), true
+}
+
+// slotCount возвращает количество выданных слотов (верхняя граница итерации)
+func (em *EntityManager) slotCount() uint32 {
+	return em.nextID.Index()
 }
 
 // Count возвращает количество живых сущностей
@@ -85,8 +136,8 @@ func (em *EntityManager) GetAliveEntities(buffer []EntityID) []EntityID {
 	}
 	buffer = buffer[:0] // Сбрасываем длину, но сохраняем capacity
 
-	for id := EntityID(1); id < EntityID(len(em.alive)) && len(buffer) < em.count; id++ {
-		if em.alive[id] {
+	for index := uint32(1); index < em.slotCount() && len(buffer) < em.count; index++ {
+		if id, ok := em.entityAt(index); ok {
 			buffer = append(buffer, id)
 		}
 	}
@@ -99,7 +150,74 @@ func (em *EntityManager) Clear() {
 	em.nextID = 1
 	em.freeIDs = em.freeIDs[:0]
 	em.count = 0
-	for i := range em.alive {
-		em.alive[i] = false
+	clear(em.alive)
+	clear(em.generations)
+}
+
+// GetFreeIDs возвращает копию списка дескрипторов для переиспользования (в порядке переиспользования)
+// Используется снапшотами: порядок free-list и поколения определяют ID будущих сущностей
+func (em *EntityManager) GetFreeIDs() []EntityID {
+	result := make([]EntityID, len(em.freeIDs))
+	copy(result, em.freeIDs)
+	return result
+}
+
+// GetNextID возвращает следующий ещё не выданный слот
+func (em *EntityManager) GetNextID() EntityID {
+	return em.nextID
+}
+
+// Restore восстанавливает состояние менеджера (для загрузки снапшотов)
+// Поколения слотов берутся из дескрипторов живых и свободных сущностей
+// Возвращает false если данные противоречивы (слот вне диапазона или занят дважды)
+func (em *EntityManager) Restore(nextID EntityID, freeIDs, aliveIDs []EntityID) bool {
+	if nextID.Index() == 0 || nextID.Generation() != 0 {
+		return false
+	}
+
+	em.Clear()
+	em.nextID = nextID
+
+	claimed := make(map[uint32]bool, len(aliveIDs)+len(freeIDs))
+	for _, id := range append(append([]EntityID(nil), aliveIDs...), freeIDs...) {
+		index := id.Index()
+		if index == 0 || index >= nextID.Index() || claimed[index] {
+			em.Clear()
+			return false
+		}
+		claimed[index] = true
+
+		// Хранилище растёт до используемых слотов, а не до nextID: повреждённый nextID не раздувает память
+		em.grow(int(index) + 1)
+		em.generations[index] = id.Generation()
+	}
+
+	for _, id := range aliveIDs {
+		em.alive[id.Index()] = true
+		em.count++
 	}
+	em.freeIDs = append(em.freeIDs, freeIDs...)
+
+	return true
+}
+
+// grow расширяет хранилища слотов до minLength элементов
+func (em *EntityManager) grow(minLength int) {
+	em.alive = growStorage(em.alive, minLength)
+	em.generations = growStorage(em.generations, minLength)
+}
+
+// growStorage увеличивает хранилище до minLength элементов с сохранением данных
+// Новая длина кратна EntityChunkSize и не меньше удвоенной: рост амортизированно O(1)
+func growStorage[T any](storage []T, minLength int) []T {
+	if minLength <= len(storage) {
+		return storage
+	}
+
+	length := max(2*len(storage), minLength)
+	length = (length + EntityChunkSize - 1) / EntityChunkSize * EntityChunkSize
+
+	grown := make([]T, length)
+	copy(grown, storage)
+	return grown
 }
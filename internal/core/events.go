@@ -0,0 +1,209 @@
+package core
+
+import "reflect"
+
+// Event событие симуляции - значение одного из типов ниже (или пользовательского типа)
+// Системы публикуют события через World.EmitEvent, подписчики получают их в том же тике:
+// SystemManager доставляет события после каждой системы, до применения её отложенных команд
+type Event any
+
+// DeathCause причина смерти животного
+type DeathCause uint8
+
+const (
+	DeathKilled      DeathCause = iota // Убито хищником
+	DeathStarvation                    // Голод
+	DeathDehydration                   // Жажда
+	DeathOldAge                        // Старость
+)
+
+// String возвращает имя причины смерти (для журналов и статистики)
+func (c DeathCause) String() string {
+	switch c {
+	case DeathKilled:
+		return "killed"
+	case DeathStarvation:
+		return "starvation"
+	case DeathDehydration:
+		return "dehydration"
+	case DeathOldAge:
+		return "old_age"
+	default:
+		return "unknown"
+	}
+}
+
+// AnimalDied здоровье животного обнулилось (сущность ещё жива до удаления CorpseSystem)
+type AnimalDied struct {
+	Entity   EntityID
+	Type     AnimalType
+	Cause    DeathCause
+	Killer   EntityID // Только для DeathKilled
+	Position Position
+}
+
+// AttackLanded удар попал в цель
+type AttackLanded struct {
+	Attacker     EntityID
+	Target       EntityID
+	Damage       int16
+	TargetHealth int16 // Здоровье цели после удара
+}
+
+// CorpseCreated животное превратилось в труп на месте
+type CorpseCreated struct {
+	Corpse    EntityID
+	Type      AnimalType
+	Position  Position
+	Nutrition float32
+}
+
+// CarrionCreated хищник наелся и бросил недоеденный труп - он стал падалью
+type CarrionCreated struct {
+	Carrion     EntityID
+	AbandonedBy EntityID
+	Nutrition   float32 // Оставшаяся питательность
+}
+
+// EatingStarted животное начало есть (траву или труп)
+type EatingStarted struct {
+	Eater      EntityID
+	Target     EntityID // 0 для травы
+	TargetType EatingTargetType
+}
+
+// EatingFinished животное перестало есть: наелось, еда кончилась, прервано или погибло
+type EatingFinished struct {
+	Eater           EntityID
+	Target          EntityID
+	TargetType      EatingTargetType
+	NutritionGained float32
+}
+
+// GrassDepleted укус оставил на тайле меньше травы, чем нужно для поедания
+type GrassDepleted struct {
+	TileX, TileY int
+	Eater        EntityID
+}
+
+// Fleeing травоядное убегает от хищника (публикуется каждый тик бегства)
+type Fleeing struct {
+	Entity   EntityID
+	Predator EntityID
+}
+
+// Birth родился детёныш
+type Birth struct {
+	Child    EntityID
+	Mother   EntityID
+	Type     AnimalType
+	Position Position
+}
+
+// eventHandler подписка на события
+type eventHandler struct {
+	id      int
+	handler func(Event)
+}
+
+// EventBus очередь событий симуляции с типизированными подписками
+// Порядок доставки - порядок публикации, события из обработчиков доставляются в том же Dispatch.
+// События без подписчиков отбрасываются сразу - публикация бесплатна, пока никто не слушает
+type EventBus struct {
+	pending  []Event
+	handlers map[reflect.Type][]eventHandler
+	all      []eventHandler
+	nextID   int
+}
+
+// Subscribe подписывает обработчик на события типа T, возвращает функцию отписки
+func Subscribe[T any](bus *EventBus, handler func(T)) (unsubscribe func()) {
+	eventType := reflect.TypeFor[T]()
+	if bus.handlers == nil {
+		bus.handlers = make(map[reflect.Type][]eventHandler)
+	}
+
+	bus.nextID++
+	id := bus.nextID
+	bus.handlers[eventType] = append(bus.handlers[eventType], eventHandler{id: id, handler: func(event Event) {
+		handler(event.(T))
+	}})
+
+	return func() {
+		bus.handlers[eventType] = removeEventHandler(bus.handlers[eventType], id)
+		if len(bus.handlers[eventType]) == 0 {
+			delete(bus.handlers, eventType)
+		}
+	}
+}
+
+// SubscribeAll подписывает обработчик на все события (журналы, реплеи), возвращает функцию отписки
+func (b *EventBus) SubscribeAll(handler func(Event)) (unsubscribe func()) {
+	b.nextID++
+	id := b.nextID
+	b.all = append(b.all, eventHandler{id: id, handler: handler})
+
+	return func() {
+		b.all = removeEventHandler(b.all, id)
+	}
+}
+
+// removeEventHandler удаляет подписку, сохраняя порядок остальных (новый слайс - доставка не ломается)
+func removeEventHandler(handlers []eventHandler, id int) []eventHandler {
+	result := make([]eventHandler, 0, len(handlers))
+	for _, entry := range handlers {
+		if entry.id != id {
+			result = append(result, entry)
+		}
+	}
+	return result
+}
+
+// Emit ставит событие в очередь доставки
+func (b *EventBus) Emit(event Event) {
+	if len(b.handlers) == 0 && len(b.all) == 0 {
+		return
+	}
+	b.pending = append(b.pending, event)
+}
+
+// Pending возвращает количество недоставленных событий
+func (b *EventBus) Pending() int {
+	return len(b.pending)
+}
+
+// Dispatch доставляет события подписчикам по порядку публикации, возвращает количество доставленных
+// Сначала обработчики типа события, затем подписчики на все события
+func (b *EventBus) Dispatch() int {
+	delivered := 0
+	for i := 0; i < len(b.pending); i++ {
+		event := b.pending[i]
+		b.pending[i] = nil
+
+		for _, entry := range b.handlers[reflect.TypeOf(event)] {
+			entry.handler(event)
+		}
+		for _, entry := range b.all {
+			entry.handler(event)
+		}
+		delivered++
+	}
+
+	b.pending = b.pending[:0]
+	return delivered
+}
+
+// EmitEvent публикует событие симуляции (доставка - World.DispatchEvents)
+func (w *World) EmitEvent(event Event) {
+	w.events.Emit(event)
+}
+
+// Events возвращает шину событий мира (подписка: core.Subscribe(world.Events(), ...))
+func (w *World) Events() *EventBus {
+	return &w.events
+}
+
+// DispatchEvents доставляет накопленные события подписчикам, возвращает количество доставленных
+func (w *World) DispatchEvents() int {
+	return w.events.Dispatch()
+}
@@ -50,6 +50,20 @@ type ComponentReader interface {
 	GetCarrion(EntityID) (Carrion, bool)
 	// AnimalConfig
 	GetAnimalConfig(EntityID) (AnimalConfig, bool)
+	// ReproductionCooldown
+	GetReproductionCooldown(EntityID) (ReproductionCooldown, bool)
+	// Pregnancy
+	GetPregnancy(EntityID) (Pregnancy, bool)
+	// Age
+	GetAge(EntityID) (Age, bool)
+	// Hydration
+	GetHydration(EntityID) (Hydration, bool)
+	// DrinkingState
+	GetDrinkingState(EntityID) (DrinkingState, bool)
+	// PackMembership
+	GetPackMembership(EntityID) (PackMembership, bool)
+	// Concealment
+	GetConcealment(EntityID) (Concealment, bool)
 }
 
 // ComponentWriter интерфейс для изменения компонентов
@@ -93,6 +107,34 @@ type ComponentWriter interface {
 	RemoveCarrion(EntityID) bool
 	// AnimalConfig
 	SetAnimalConfig(EntityID, AnimalConfig) bool
+	// ReproductionCooldown
+	SetReproductionCooldown(EntityID, ReproductionCooldown) bool
+	AddReproductionCooldown(EntityID, ReproductionCooldown) bool
+	RemoveReproductionCooldown(EntityID) bool
+	// Pregnancy
+	SetPregnancy(EntityID, Pregnancy) bool
+	AddPregnancy(EntityID, Pregnancy) bool
+	RemovePregnancy(EntityID) bool
+	// Age
+	SetAge(EntityID, Age) bool
+	AddAge(EntityID, Age) bool
+	RemoveAge(EntityID) bool
+	// Hydration
+	SetHydration(EntityID, Hydration) bool
+	AddHydration(EntityID, Hydration) bool
+	RemoveHydration(EntityID) bool
+	// DrinkingState
+	SetDrinkingState(EntityID, DrinkingState) bool
+	AddDrinkingState(EntityID, DrinkingState) bool
+	RemoveDrinkingState(EntityID) bool
+	// PackMembership
+	SetPackMembership(EntityID, PackMembership) bool
+	AddPackMembership(EntityID, PackMembership) bool
+	RemovePackMembership(EntityID) bool
+	// Concealment
+	SetConcealment(EntityID, Concealment) bool
+	AddConcealment(EntityID, Concealment) bool
+	RemoveConcealment(EntityID) bool
 }
 
 // QueryProvider интерфейс для ECS запросов
@@ -124,6 +166,16 @@ type WorldInfo interface {
 	GetWorldDimensions() (width, height float32)
 }
 
+// EventEmitter публикация событий симуляции (смерти, удары, поедание, рождения, бегство)
+type EventEmitter interface {
+	EmitEvent(event Event)
+}
+
+// SectorProvider параллельная обработка секторов карты (только чтение, слияние по порядку секторов)
+type SectorProvider interface {
+	ForEachSector(mask ComponentMask, work SectorFunc)
+}
+
 // ECSAccess объединённый интерфейс для обратной совместимости
 // Deprecated: Используйте специализированные интерфейсы согласно ISP
 type ECSAccess interface {
@@ -155,13 +207,15 @@ type SimulationAccess interface {
 type SatiationSystemAccess interface {
 	// Чтение сытости
 	GetSatiation(EntityID) (Satiation, bool)
-	GetSize(EntityID) (Size, bool) // Для расчёта скорости потери сытости крупных животных
+	GetSize(EntityID) (Size, bool)                 // Для расчёта скорости потери сытости крупных животных
+	GetAnimalConfig(EntityID) (AnimalConfig, bool) // Скорость потери сытости вида из файла описания
 	// Проверка компонентов для определения едят ли животные
 	HasComponent(EntityID, ComponentMask) bool
 	// Изменение сытости
 	SetSatiation(EntityID, Satiation) bool
 	// Итерация
 	ForEachWith(ComponentMask, QueryFunc)
+	SectorProvider // Параллельный расчёт по секторам карты
 }
 
 // GrassSearchSystemAccess специализированный интерфейс для поиска травы
@@ -174,6 +228,10 @@ type GrassSearchSystemAccess interface {
 	GetAnimalConfig(EntityID) (AnimalConfig, bool)
 	GetBehavior(EntityID) (Behavior, bool)
 	GetSize(EntityID) (Size, bool)
+	// Туши поблизости: всеядные выбирают между травой и падалью
+	GetCorpse(EntityID) (Corpse, bool)
+	GetCarrion(EntityID) (Carrion, bool)
+	QueryInRadius(x, y, radius float32) []EntityID
 	// Проверка состояний
 	HasComponent(EntityID, ComponentMask) bool
 	// Создание состояния поедания
@@ -188,25 +246,52 @@ type StarvationDamageSystemAccess interface {
 	// Чтение состояния
 	GetSatiation(EntityID) (Satiation, bool)
 	GetHealth(EntityID) (Health, bool)
+	GetPosition(EntityID) (Position, bool)     // Для события смерти
+	GetAnimalType(EntityID) (AnimalType, bool) // Для события смерти
 	// Изменение здоровья
 	SetHealth(EntityID, Health) bool
 	// Итерация
 	ForEachWith(ComponentMask, QueryFunc)
+	EventEmitter // Смерть от голода
 }
 
 // SatiationSpeedModifierSystemAccess специализированный интерфейс для влияния сытости на скорость
-// Предоставляет: только сытость, здоровье и скорость
+// Предоставляет: только сытость, здоровье, возраст и скорость
 type SatiationSpeedModifierSystemAccess interface {
 	// Чтение состояния
 	GetSatiation(EntityID) (Satiation, bool)
 	GetHealth(EntityID) (Health, bool)
 	GetSpeed(EntityID) (Speed, bool)
+	GetAge(EntityID) (Age, bool) // Множитель скорости стадии жизни
 	// Изменение скорости
 	SetSpeed(EntityID, Speed) bool
 	// Итерация
 	ForEachWith(ComponentMask, QueryFunc)
 }
 
+// ThirstSystemAccess специализированный интерфейс для системы жажды
+// Предоставляет: гидратацию, здоровье, позицию и состояние питья
+type ThirstSystemAccess interface {
+	// Чтение состояния
+	GetHydration(EntityID) (Hydration, bool)
+	GetHealth(EntityID) (Health, bool)
+	GetPosition(EntityID) (Position, bool)
+	GetSize(EntityID) (Size, bool) // Для расчёта скорости потери воды крупных животных
+	GetDrinkingState(EntityID) (DrinkingState, bool)
+	GetAnimalType(EntityID) (AnimalType, bool) // Для события смерти
+	// Проверка компонентов (едят, атакуют, пьют)
+	HasComponent(EntityID, ComponentMask) bool
+	// Изменение состояния
+	SetHydration(EntityID, Hydration) bool
+	SetHealth(EntityID, Health) bool
+	AddDrinkingState(EntityID, DrinkingState) bool
+	SetDrinkingState(EntityID, DrinkingState) bool
+	RemoveDrinkingState(EntityID) bool
+	// Итерация
+	ForEachWith(ComponentMask, QueryFunc)
+	EventEmitter // Смерть от жажды
+}
+
 // MovementSystemAccess специализированный интерфейс для системы движения
 // Предоставляет: компоненты позиции/скорости, границы мира, пространственные обновления
 type MovementSystemAccess interface {
@@ -227,6 +312,8 @@ type BehaviorSystemAccess interface {
 	QueryProvider   // ForEachWith для обработки всех животных
 	SpatialQueries  // FindNearestByType для поиска пищи/хищников
 	WorldInfo       // GetRNG для случайных решений
+	SectorProvider  // Параллельное восприятие по секторам карты
+	EventEmitter    // Бегство от хищника
 }
 
 // CombatSystemAccess специализированный интерфейс для боевой системы
@@ -257,6 +344,44 @@ type VegetationProvider interface {
 	IsPassable(tileX, tileY int) bool
 }
 
+// WaterProvider узкоспециализированный интерфейс для работы с водоёмами
+// Пить можно стоя на проходимом тайле, соседнем с водой (сама вода непроходима)
+type WaterProvider interface {
+	// FindNearestDrinkableTile находит центр ближайшего тайла рядом с водой (в пикселях)
+	FindNearestDrinkableTile(worldX, worldY, searchRadius float32) (tileX, tileY float32, found bool)
+
+	// CanDrinkAt проверяет можно ли пить в указанной точке
+	CanDrinkAt(worldX, worldY float32) bool
+}
+
+// PassabilityProvider узкоспециализированный интерфейс проходимости ландшафта
+// Вода и кусты непроходимы: движение выталкивает животных с таких тайлов, поведение обходит их
+type PassabilityProvider interface {
+	// IsPassable проверяет можно ли пройти через тайл (за границами мира - нельзя)
+	IsPassable(tileX, tileY int) bool
+}
+
+// CoverProvider узкоспециализированный интерфейс укрытий ландшафта (кусты)
+// Мелкие травоядные заходят в укрытия и прячутся в них, крупных животных кусты не пускают
+type CoverProvider interface {
+	// IsCover проверяет что тайл - укрытие (за границами мира - нет)
+	IsCover(tileX, tileY int) bool
+
+	// FindNearestCover находит центр ближайшего тайла-укрытия (в пикселях)
+	FindNearestCover(worldX, worldY, searchRadius float32) (coverX, coverY float32, found bool)
+}
+
+// NavigationProvider узкоспециализированный интерфейс прокладки маршрутов (позиции в пикселях)
+// Маршруты обходят воду и кусты; found=false означает "пути нет или бюджет тика исчерпан" -
+// тогда животное движется к цели напрямую
+type NavigationProvider interface {
+	// NextWaypoint возвращает точку, к которой двигаться по пути от (fromX, fromY) к (toX, toY)
+	NextWaypoint(fromX, fromY, toX, toY float32) (x, y float32, found bool)
+
+	// FlowWaypoint возвращает следующую точку по полю потока к общей цели (например, водопою)
+	FlowWaypoint(field string, fromX, fromY float32) (x, y float32, found bool)
+}
+
 // ===== ПРИНЦИПЫ SOLID: УСТРАНЕНИЕ НАРУШЕНИЙ LSP =====
 // Удалены алиасы интерфейсов которые создавали ложную замещаемость.
 // Теперь системы используют прямые специализированные интерфейсы:
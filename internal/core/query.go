@@ -0,0 +1,92 @@
+package core
+
+import (
+	"math/bits"
+
+	"github.com/aiseeq/savanna/internal/constants"
+)
+
+// Query зарегистрированный запрос: множество сущностей, у которых есть все компоненты mask
+// Множество поддерживается инкрементально при добавлении и удалении компонентов (QueryManager),
+// поэтому итерация не перебирает все сущности мира.
+// Обход идёт по возрастанию слота - в том же порядке, что и полный перебор, результат детерминирован
+type Query struct {
+	mask     ComponentMask
+	entities *EntityManager
+	members  []uint64 // Битовое множество слотов подходящих сущностей
+	count    int
+}
+
+// newQuery создаёт пустой запрос
+func newQuery(mask ComponentMask, entities *EntityManager) *Query {
+	return &Query{mask: mask, entities: entities}
+}
+
+// Mask возвращает маску компонентов запроса
+func (q *Query) Mask() ComponentMask {
+	return q.mask
+}
+
+// Count возвращает количество подходящих сущностей
+func (q *Query) Count() int {
+	return q.count
+}
+
+// ForEach вызывает функцию для каждой подходящей сущности
+// Множество читается во время обхода: сущность, потерявшая компоненты в fn, пропускается,
+// а получившая их в слоте дальше текущего - будет посещена (как при полном переборе)
+func (q *Query) ForEach(fn QueryFunc) {
+	q.ForEachWithBreak(func(entity EntityID) bool {
+		fn(entity)
+		return true
+	})
+}
+
+// ForEachWithBreak вызывает функцию для каждой подходящей сущности, пока она возвращает true
+func (q *Query) ForEachWithBreak(fn func(EntityID) bool) {
+	for word := 0; word < len(q.members); word++ {
+		for bit := 0; bit < constants.BitsPerUint64; bit++ {
+			rest := q.members[word] >> uint(bit)
+			if rest == 0 {
+				break
+			}
+			bit += bits.TrailingZeros64(rest)
+
+			entity, alive := q.entities.entityAt(uint32(word*constants.BitsPerUint64 + bit))
+			if alive && !fn(entity) {
+				return
+			}
+		}
+	}
+}
+
+// Entities возвращает подходящие сущности по возрастанию слота (новый слайс)
+func (q *Query) Entities() []EntityID {
+	result := make([]EntityID, 0, q.count)
+	q.ForEach(func(entity EntityID) {
+		result = append(result, entity)
+	})
+	return result
+}
+
+// update включает или исключает слот из множества
+func (q *Query) update(index uint32, matches bool) {
+	word := int(index) / constants.BitsPerUint64
+	bit := uint64(1) << (index % constants.BitsPerUint64)
+
+	if word >= len(q.members) {
+		if !matches {
+			return
+		}
+		q.members = growBitset(q.members, word+1)
+	}
+
+	switch member := q.members[word]&bit != 0; {
+	case matches && !member:
+		q.members[word] |= bit
+		q.count++
+	case !matches && member:
+		q.members[word] &^= bit
+		q.count--
+	}
+}
@@ -1,28 +1,80 @@
 package core
 
+import "sync"
+
 // QueryManager управляет запросами к сущностям
 // Соблюдает Single Responsibility Principle - только запросы и итерации
 type QueryManager struct {
 	componentManager *ComponentManager
 	entityManager    *EntityManager
 
+	// Зарегистрированные запросы по маске; список - для обновления без обхода map
+	// Секторы карты читают мир параллельно (World.ForEachSector), поэтому регистрация под мьютексом
+	queries   map[ComponentMask]*Query
+	queryList []*Query
+	queriesMu sync.RWMutex
+
 	// Буферы для переиспользования (предотвращение аллокаций)
 	queryBuffer []EntityID
 }
 
-// NewQueryManager создаёт новый менеджер запросов
+// NewQueryManager создаёт новый менеджер запросов и подписывает его на изменения компонентов
 func NewQueryManager(componentManager *ComponentManager, entityManager *EntityManager) *QueryManager {
-	return &QueryManager{
+	qm := &QueryManager{
 		componentManager: componentManager,
 		entityManager:    entityManager,
+		queries:          make(map[ComponentMask]*Query),
 		queryBuffer:      make([]EntityID, 0, 100),
 	}
+	componentManager.observer = qm
+	return qm
+}
+
+// Query возвращает зарегистрированный запрос для маски, регистрируя его при первом обращении
+// Регистрация перебирает все сущности один раз, дальше множество обновляется инкрементально
+func (qm *QueryManager) Query(mask ComponentMask) *Query {
+	qm.queriesMu.RLock()
+	query, ok := qm.queries[mask]
+	qm.queriesMu.RUnlock()
+	if ok {
+		return query
+	}
+
+	qm.queriesMu.Lock()
+	defer qm.queriesMu.Unlock()
+	if query, ok := qm.queries[mask]; ok {
+		return query // Зарегистрирован другим сектором
+	}
+
+	query = newQuery(mask, qm.entityManager)
+	for index := uint32(1); index < qm.entityManager.slotCount(); index++ {
+		if entity, alive := qm.entityManager.entityAt(index); alive && qm.componentManager.HasComponents(entity, mask) {
+			query.update(index, true)
+		}
+	}
+
+	qm.queries[mask] = query
+	qm.queryList = append(qm.queryList, query)
+	return query
+}
+
+// componentsChanged обновляет запросы после изменения компонентов, создания или удаления сущности
+func (qm *QueryManager) componentsChanged(entity EntityID) {
+	if len(qm.queryList) == 0 {
+		return
+	}
+
+	alive := qm.entityManager.IsAlive(entity)
+	mask := qm.componentManager.componentMask(entity)
+	for _, query := range qm.queryList {
+		query.update(entity.Index(), alive && mask&query.mask == query.mask)
+	}
 }
 
 // ForEach вызывает функцию для каждой активной сущности
 func (qm *QueryManager) ForEach(fn func(EntityID)) {
-	for entity := EntityID(0); entity < EntityID(qm.entityManager.nextID); entity++ {
-		if qm.entityManager.IsAlive(entity) {
+	for index := uint32(1); index < qm.entityManager.slotCount(); index++ {
+		if entity, alive := qm.entityManager.entityAt(index); alive {
 			fn(entity)
 		}
 	}
@@ -30,61 +82,33 @@ func (qm *QueryManager) ForEach(fn func(EntityID)) {
 
 // ForEachWith вызывает функцию для каждой сущности с указанными компонентами
 func (qm *QueryManager) ForEachWith(mask ComponentMask, fn QueryFunc) {
-	for entity := EntityID(0); entity < EntityID(qm.entityManager.nextID); entity++ {
-		if qm.entityManager.IsAlive(entity) && qm.componentManager.HasComponents(entity, mask) {
-			fn(entity)
-		}
-	}
+	qm.Query(mask).ForEach(fn)
 }
 
 // GetEntitiesWith возвращает слайс сущностей с указанными компонентами
 func (qm *QueryManager) GetEntitiesWith(mask ComponentMask) []EntityID {
-	// Очищаем буфер для переиспользования
-	qm.queryBuffer = qm.queryBuffer[:0]
-
-	for entity := EntityID(0); entity < EntityID(qm.entityManager.nextID); entity++ {
-		if qm.entityManager.IsAlive(entity) && qm.componentManager.HasComponents(entity, mask) {
-			qm.queryBuffer = append(qm.queryBuffer, entity)
-		}
-	}
-
-	// Возвращаем копию чтобы избежать проблем при изменении буфера
-	result := make([]EntityID, len(qm.queryBuffer))
-	copy(result, qm.queryBuffer)
-	return result
+	return qm.Query(mask).Entities()
 }
 
 // CountEntitiesWith подсчитывает количество сущностей с указанными компонентами
 func (qm *QueryManager) CountEntitiesWith(mask ComponentMask) int {
-	count := 0
-	for entity := EntityID(0); entity < EntityID(qm.entityManager.nextID); entity++ {
-		if qm.entityManager.IsAlive(entity) && qm.componentManager.HasComponents(entity, mask) {
-			count++
-		}
-	}
-	return count
+	return qm.Query(mask).Count()
 }
 
 // FindFirst находит первую сущность с указанными компонентами
 func (qm *QueryManager) FindFirst(mask ComponentMask) (EntityID, bool) {
-	for entity := EntityID(0); entity < EntityID(qm.entityManager.nextID); entity++ {
-		if qm.entityManager.IsAlive(entity) && qm.componentManager.HasComponents(entity, mask) {
-			return entity, true
-		}
-	}
-	return EntityID(0), false
+	first := InvalidEntity
+	qm.Query(mask).ForEachWithBreak(func(entity EntityID) bool {
+		first = entity
+		return false
+	})
+	return first, first != InvalidEntity
 }
 
 // ForEachWithBreak вызывает функцию для каждой сущности с указанными компонентами
 // Функция может вернуть false для прерывания итерации
 func (qm *QueryManager) ForEachWithBreak(mask ComponentMask, fn func(EntityID) bool) {
-	for entity := EntityID(0); entity < EntityID(qm.entityManager.nextID); entity++ {
-		if qm.entityManager.IsAlive(entity) && qm.componentManager.HasComponents(entity, mask) {
-			if !fn(entity) {
-				break
-			}
-		}
-	}
+	qm.Query(mask).ForEachWithBreak(fn)
 }
 
 // FilterEntities фильтрует сущности по пользовательскому предикату
@@ -92,13 +116,11 @@ func (qm *QueryManager) FilterEntities(mask ComponentMask, predicate func(Entity
 	// Очищаем буфер для переиспользования
 	qm.queryBuffer = qm.queryBuffer[:0]
 
-	for entity := EntityID(0); entity < EntityID(qm.entityManager.nextID); entity++ {
-		if qm.entityManager.IsAlive(entity) && qm.componentManager.HasComponents(entity, mask) {
-			if predicate(entity) {
-				qm.queryBuffer = append(qm.queryBuffer, entity)
-			}
+	qm.Query(mask).ForEach(func(entity EntityID) {
+		if predicate(entity) {
+			qm.queryBuffer = append(qm.queryBuffer, entity)
 		}
-	}
+	})
 
 	// Возвращаем копию чтобы избежать проблем при изменении буфера
 	result := make([]EntityID, len(qm.queryBuffer))
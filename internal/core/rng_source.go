@@ -0,0 +1,51 @@
+package core
+
+import "math/rand"
+
+// countingSource источник случайности, запоминающий seed и число сделанных шагов
+// Стандартный rand.Source не позволяет экспортировать внутреннее состояние,
+// поэтому для снапшотов состояние RNG описывается парой (seed, draws):
+// восстановление = пересоздание источника с тем же seed и прокрутка на draws шагов
+type countingSource struct {
+	source rand.Source64
+	seed   int64
+	draws  uint64
+}
+
+// newCountingSource создаёт источник с заданным seed
+func newCountingSource(seed int64) *countingSource {
+	return &countingSource{
+		source: rand.NewSource(seed).(rand.Source64),
+		seed:   seed,
+	}
+}
+
+// Int63 возвращает следующее псевдослучайное число (реализует rand.Source)
+func (cs *countingSource) Int63() int64 {
+	cs.draws++
+	return cs.source.Int63()
+}
+
+// Uint64 возвращает следующее псевдослучайное число (реализует rand.Source64)
+func (cs *countingSource) Uint64() uint64 {
+	cs.draws++
+	return cs.source.Uint64()
+}
+
+// Seed сбрасывает источник на новый seed
+func (cs *countingSource) Seed(seed int64) {
+	cs.source.Seed(seed)
+	cs.seed = seed
+	cs.draws = 0
+}
+
+// restore восстанавливает состояние источника (seed + количество шагов)
+// ВАЖНО: Int63 и Uint64 встроенного источника продвигают его ровно на один шаг,
+// поэтому прокрутка через Uint64 даёт идентичное состояние
+func (cs *countingSource) restore(seed int64, draws uint64) {
+	cs.Seed(seed)
+	for i := uint64(0); i < draws; i++ {
+		cs.source.Uint64()
+	}
+	cs.draws = draws
+}
@@ -0,0 +1,88 @@
+package core
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// SectorCount количество секторов карты - горизонтальных полос равной высоты
+// Разбиение не зависит от числа потоков: результаты секторов сливаются
+// в одном и том же порядке при любом параллелизме, поэтому прогон детерминирован
+const SectorCount = 16
+
+// SectorFunc обрабатывает сущности одного сектора (по возрастанию слота)
+type SectorFunc func(sector int, entities []EntityID)
+
+// SetParallelism задаёт количество потоков для обработки секторов карты (1 - последовательно)
+func (w *World) SetParallelism(workers int) {
+	w.parallelism = max(workers, 1)
+}
+
+// Parallelism возвращает количество потоков обработки секторов
+func (w *World) Parallelism() int {
+	return max(w.parallelism, 1)
+}
+
+// ForEachSector раскладывает сущности с маской по секторам и вызывает work для каждого непустого сектора
+// Секторы - полосы равной высоты между крайними позициями сущностей (размеры мира бывают и в тайлах,
+// и в пикселях), сущности без позиции попадают в сектор 0. Вызовы не вкладываются: буферы секторов общие.
+// При параллелизме > 1 секторы обрабатываются одновременно: work только читает мир и копит
+// изменения в буфере своего сектора, а вызывающий применяет их после возврата по порядку секторов
+func (w *World) ForEachSector(mask ComponentMask, work SectorFunc) {
+	for sector := range w.sectors {
+		w.sectors[sector] = w.sectors[sector][:0]
+	}
+
+	// Первый проход - границы по Y, второй - раскладка в порядке слотов
+	minY, maxY, positioned := float32(0), float32(0), false
+	w.queryManager.ForEachWith(mask, func(entity EntityID) {
+		if pos, ok := w.componentManager.GetPosition(entity); ok {
+			if !positioned || pos.Y < minY {
+				minY = pos.Y
+			}
+			if !positioned || pos.Y > maxY {
+				maxY = pos.Y
+			}
+			positioned = true
+		}
+	})
+
+	w.queryManager.ForEachWith(mask, func(entity EntityID) {
+		sector := 0
+		if pos, ok := w.componentManager.GetPosition(entity); ok && maxY > minY {
+			sector = min(int((pos.Y-minY)/(maxY-minY)*SectorCount), SectorCount-1)
+		}
+		w.sectors[sector] = append(w.sectors[sector], entity)
+	})
+
+	w.RunSectors(SectorCount, func(sector int) {
+		if len(w.sectors[sector]) > 0 {
+			work(sector, w.sectors[sector])
+		}
+	})
+}
+
+// RunSectors вызывает work для частей 0..count-1 и ждёт завершения
+// При параллелизме > 1 части разбираются потоками по очереди, иначе выполняются по порядку
+func (w *World) RunSectors(count int, work func(sector int)) {
+	workers := min(w.Parallelism(), count)
+	if workers <= 1 {
+		for sector := 0; sector < count; sector++ {
+			work(sector)
+		}
+		return
+	}
+
+	var next atomic.Int64
+	var wg sync.WaitGroup
+	for worker := 0; worker < workers; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for sector := int(next.Add(1) - 1); sector < count; sector = int(next.Add(1) - 1) {
+				work(sector)
+			}
+		}()
+	}
+	wg.Wait()
+}
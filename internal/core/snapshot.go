@@ -0,0 +1,480 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/aiseeq/savanna/internal/physics"
+)
+
+// Ошибки восстановления мира из снапшота
+var (
+	ErrInvalidSnapshot   = errors.New("invalid world snapshot")
+	ErrUnknownAnimalType = errors.New("snapshot references unregistered animal type")
+)
+
+// WorldSnapshot полный слепок состояния мира
+// Содержит всё, что нужно для бит-в-бит продолжения симуляции после загрузки:
+// компоненты, free-list менеджера сущностей, время, состояние RNG и раскладку spatial grid
+type WorldSnapshot struct {
+	WorldWidth  float32 `json:"worldWidth"`
+	WorldHeight float32 `json:"worldHeight"`
+
+	// Время симуляции
+	Time      float32 `json:"time"`
+	DeltaTime float32 `json:"deltaTime"`
+	TimeScale float32 `json:"timeScale"`
+
+	// Состояние RNG: seed + количество сделанных шагов
+	RNGSeed  int64  `json:"rngSeed"`
+	RNGDraws uint64 `json:"rngDraws"`
+
+	// Состояние менеджера сущностей
+	NextID  EntityID   `json:"nextId"`
+	FreeIDs []EntityID `json:"freeIds"`
+
+	// Имена типов животных по номеру AnimalType
+	// Номера видов из файлов описаний зависят от загруженного набора файлов, поэтому при загрузке
+	// типы сущностей сопоставляются с текущим реестром по имени
+	AnimalTypes []string `json:"animalTypes"`
+
+	// Живые сущности в порядке возрастания ID
+	Entities []EntitySnapshot `json:"entities"`
+
+	// Пользовательские компоненты (RegisterComponent) в порядке имён
+	Custom []CustomComponentSnapshot `json:"custom,omitempty"`
+
+	// Записи пространственной сетки в порядке ячеек
+	// nil означает что сетку нужно перестроить по позициям
+	Spatial []SpatialEntrySnapshot `json:"spatial,omitempty"`
+}
+
+// EntitySnapshot компоненты одной сущности
+// Наличие компонента определяет Mask: бинарный кодек опускает нулевые значения,
+// поэтому nil-указатель при установленном бите означает нулевой компонент
+type EntitySnapshot struct {
+	ID   EntityID      `json:"id"`
+	Mask ComponentMask `json:"mask"`
+
+	Position      *Position             `json:"position,omitempty"`
+	Velocity      *Velocity             `json:"velocity,omitempty"`
+	Health        *Health               `json:"health,omitempty"`
+	Satiation     *Satiation            `json:"satiation,omitempty"`
+	AnimalType    *AnimalType           `json:"animalType,omitempty"`
+	Size          *Size                 `json:"size,omitempty"`
+	Speed         *Speed                `json:"speed,omitempty"`
+	Animation     *Animation            `json:"animation,omitempty"`
+	DamageFlash   *DamageFlash          `json:"damageFlash,omitempty"`
+	Corpse        *Corpse               `json:"corpse,omitempty"`
+	Carrion       *Carrion              `json:"carrion,omitempty"`
+	EatingState   *EatingState          `json:"eatingState,omitempty"`
+	AttackState   *AttackState          `json:"attackState,omitempty"`
+	Behavior      *Behavior             `json:"behavior,omitempty"`
+	AnimalConfig  *AnimalConfig         `json:"animalConfig,omitempty"`
+	Cooldown      *ReproductionCooldown `json:"reproductionCooldown,omitempty"`
+	Pregnancy     *Pregnancy            `json:"pregnancy,omitempty"`
+	Age           *Age                  `json:"age,omitempty"`
+	Hydration     *Hydration            `json:"hydration,omitempty"`
+	DrinkingState *DrinkingState        `json:"drinkingState,omitempty"`
+	Pack          *PackMembership       `json:"pack,omitempty"`
+	Concealment   *Concealment          `json:"concealment,omitempty"`
+}
+
+// CustomComponentSnapshot значения пользовательского компонента по имени регистрации
+// После загрузки значения получает компонент, зарегистрированный под тем же именем
+type CustomComponentSnapshot struct {
+	Name   string                `json:"name"`
+	Values []CustomValueSnapshot `json:"values"` // В порядке возрастания ID сущностей
+}
+
+// CustomValueSnapshot значение пользовательского компонента одной сущности (JSON)
+type CustomValueSnapshot struct {
+	ID    EntityID        `json:"id"`
+	Value json.RawMessage `json:"value"`
+}
+
+// SpatialEntrySnapshot запись пространственной сетки
+// Позиция хранится отдельно от Position: сетка обновляется явно и может отставать от компонента
+type SpatialEntrySnapshot struct {
+	ID     EntityID `json:"id"`
+	X      float32  `json:"x"`
+	Y      float32  `json:"y"`
+	Radius float32  `json:"radius"`
+}
+
+// CreateSnapshot создаёт полный слепок состояния мира
+// Ошибка - значение пользовательского компонента не кодируется в JSON
+func (w *World) CreateSnapshot() (*WorldSnapshot, error) {
+	width, height := w.worldState.GetWorldWidth(), w.worldState.GetWorldHeight()
+	seed, draws := w.worldState.GetRNGState()
+
+	snapshot := &WorldSnapshot{
+		WorldWidth:  width,
+		WorldHeight: height,
+		Time:        w.worldState.GetTime(),
+		DeltaTime:   w.worldState.GetDeltaTime(),
+		TimeScale:   w.worldState.GetTimeScale(),
+		RNGSeed:     seed,
+		RNGDraws:    draws,
+		NextID:      w.entityManager.GetNextID(),
+		FreeIDs:     w.entityManager.GetFreeIDs(),
+		AnimalTypes: animalTypeNames(),
+	}
+
+	alive := w.entityManager.GetAliveEntities(nil)
+	for _, entity := range alive {
+		snapshot.Entities = append(snapshot.Entities, w.componentManager.snapshotEntity(entity))
+	}
+
+	custom, err := w.snapshotCustomComponents(alive)
+	if err != nil {
+		return nil, err
+	}
+	snapshot.Custom = custom
+
+	if provider, ok := w.worldState.GetSpatialProvider().(SpatialSnapshotProvider); ok {
+		entries := provider.Entries()
+		snapshot.Spatial = make([]SpatialEntrySnapshot, len(entries))
+		for i, entry := range entries {
+			snapshot.Spatial[i] = SpatialEntrySnapshot{
+				ID:     EntityID(entry.ID),
+				X:      entry.Position.X,
+				Y:      entry.Position.Y,
+				Radius: entry.Radius,
+			}
+		}
+	}
+
+	return snapshot, nil
+}
+
+// snapshotCustomComponents собирает значения пользовательских компонентов живых сущностей
+// Незарегистрированные после загрузки компоненты переносятся как есть - повторное сохранение их не теряет
+func (w *World) snapshotCustomComponents(alive []EntityID) ([]CustomComponentSnapshot, error) {
+	var custom []CustomComponentSnapshot
+
+	for _, storage := range w.customComponents {
+		component := CustomComponentSnapshot{Name: storage.componentName()}
+		for _, entity := range alive {
+			value, ok, err := storage.encode(entity.Index())
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				component.Values = append(component.Values, CustomValueSnapshot{ID: entity, Value: value})
+			}
+		}
+		if len(component.Values) > 0 {
+			custom = append(custom, component)
+		}
+	}
+
+	for name, pending := range w.pendingCustom {
+		component := CustomComponentSnapshot{Name: name}
+		for _, entry := range pending.Values {
+			if w.entityManager.IsAlive(entry.ID) {
+				component.Values = append(component.Values, entry)
+			}
+		}
+		if len(component.Values) > 0 {
+			custom = append(custom, component)
+		}
+	}
+
+	// Порядок обхода карт случаен - сортируем для детерминированного снапшота
+	sort.Slice(custom, func(i, j int) bool { return custom[i].Name < custom[j].Name })
+	return custom, nil
+}
+
+// NewWorldFromSnapshot создаёт мир из снапшота
+// Вид, которого нет в текущем реестре типов, отклоняется с ErrUnknownAnimalType
+func NewWorldFromSnapshot(snapshot *WorldSnapshot) (*World, error) {
+	if snapshot == nil {
+		return nil, fmt.Errorf("%w: nil snapshot", ErrInvalidSnapshot)
+	}
+
+	animalTypes, err := resolveAnimalTypes(snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	world := NewWorld(snapshot.WorldWidth, snapshot.WorldHeight, snapshot.RNGSeed)
+	world.worldState.RestoreTime(snapshot.Time, snapshot.DeltaTime, snapshot.TimeScale)
+	world.worldState.RestoreRNGState(snapshot.RNGSeed, snapshot.RNGDraws)
+
+	aliveIDs := make([]EntityID, len(snapshot.Entities))
+	for i := range snapshot.Entities {
+		aliveIDs[i] = snapshot.Entities[i].ID
+	}
+	if !world.entityManager.Restore(snapshot.NextID, snapshot.FreeIDs, aliveIDs) {
+		return nil, fmt.Errorf("%w: inconsistent entity ids (next=%d, alive=%d, free=%d)",
+			ErrInvalidSnapshot, snapshot.NextID, len(aliveIDs), len(snapshot.FreeIDs))
+	}
+
+	// Компоненты восстанавливаются напрямую через ComponentManager,
+	// чтобы не затрагивать пространственную сетку (она восстанавливается отдельно)
+	for i := range snapshot.Entities {
+		world.componentManager.restoreEntity(&snapshot.Entities[i], animalTypes)
+	}
+
+	world.restoreSpatial(snapshot)
+
+	if err := world.restoreCustomComponents(snapshot); err != nil {
+		return nil, err
+	}
+
+	return world, nil
+}
+
+// restoreCustomComponents откладывает значения пользовательских компонентов до их регистрации
+// Компоненты регистрируются кодом, который их использует, уже после создания мира
+func (w *World) restoreCustomComponents(snapshot *WorldSnapshot) error {
+	if len(snapshot.Custom) == 0 {
+		return nil
+	}
+
+	w.pendingCustom = make(map[string]CustomComponentSnapshot, len(snapshot.Custom))
+	for _, component := range snapshot.Custom {
+		if _, exists := w.pendingCustom[component.Name]; exists || component.Name == "" {
+			return fmt.Errorf("%w: duplicate or empty custom component name %q", ErrInvalidSnapshot, component.Name)
+		}
+		for _, entry := range component.Values {
+			if !w.entityManager.IsAlive(entry.ID) {
+				return fmt.Errorf("%w: custom component %q of missing entity %d", ErrInvalidSnapshot, component.Name, entry.ID)
+			}
+		}
+		w.pendingCustom[component.Name] = component
+	}
+
+	return nil
+}
+
+// resolveAnimalTypes сопоставляет номера типов из снапшота с текущим реестром по имени
+// Незарегистрированный вид - ошибка, только если он есть у сохранённых сущностей
+func resolveAnimalTypes(snapshot *WorldSnapshot) ([]AnimalType, error) {
+	resolved := make([]AnimalType, len(snapshot.AnimalTypes))
+	known := make([]bool, len(snapshot.AnimalTypes))
+	for i, name := range snapshot.AnimalTypes {
+		resolved[i], known[i] = AnimalTypeByName(name)
+	}
+
+	for i := range snapshot.Entities {
+		entity := &snapshot.Entities[i]
+		if !entity.Mask.HasComponent(MaskAnimalType) {
+			continue
+		}
+
+		saved := valueOrZero(entity.AnimalType)
+		if int(saved) >= len(resolved) {
+			return nil, fmt.Errorf("%w: entity %d has animal type %d missing from the type table",
+				ErrInvalidSnapshot, entity.ID, saved)
+		}
+		if !known[saved] {
+			return nil, fmt.Errorf("%w: %q (entity %d)", ErrUnknownAnimalType, snapshot.AnimalTypes[saved], entity.ID)
+		}
+	}
+
+	return resolved, nil
+}
+
+// restoreSpatial восстанавливает пространственную сетку в сохранённом порядке
+func (w *World) restoreSpatial(snapshot *WorldSnapshot) {
+	provider := w.worldState.GetSpatialProvider()
+
+	if snapshot.Spatial == nil {
+		// Старый/внешний снапшот без сетки - перестраиваем по позициям
+		w.queryManager.ForEachWith(MaskPosition, func(entity EntityID) {
+			pos, _ := w.componentManager.GetPosition(entity)
+			w.updateSpatialEntity(entity, pos.X, pos.Y)
+		})
+		return
+	}
+
+	for _, entry := range snapshot.Spatial {
+		provider.UpdateEntity(uint64(entry.ID), physics.Vec2{X: entry.X, Y: entry.Y}, entry.Radius)
+	}
+}
+
+// snapshotEntity собирает все компоненты сущности
+//
+//nolint:gocyclo // Линейный перебор всех типов компонентов
+func (cm *ComponentManager) snapshotEntity(entity EntityID) EntitySnapshot {
+	snapshot := EntitySnapshot{ID: entity}
+
+	if v, ok := cm.GetPosition(entity); ok {
+		snapshot.Position = &v
+		snapshot.Mask |= MaskPosition
+	}
+	if v, ok := cm.GetVelocity(entity); ok {
+		snapshot.Velocity = &v
+		snapshot.Mask |= MaskVelocity
+	}
+	if v, ok := cm.GetHealth(entity); ok {
+		snapshot.Health = &v
+		snapshot.Mask |= MaskHealth
+	}
+	if v, ok := cm.GetSatiation(entity); ok {
+		snapshot.Satiation = &v
+		snapshot.Mask |= MaskSatiation
+	}
+	if v, ok := cm.GetAnimalType(entity); ok {
+		snapshot.AnimalType = &v
+		snapshot.Mask |= MaskAnimalType
+	}
+	if v, ok := cm.GetSize(entity); ok {
+		snapshot.Size = &v
+		snapshot.Mask |= MaskSize
+	}
+	if v, ok := cm.GetSpeed(entity); ok {
+		snapshot.Speed = &v
+		snapshot.Mask |= MaskSpeed
+	}
+	if v, ok := cm.GetAnimation(entity); ok {
+		snapshot.Animation = &v
+		snapshot.Mask |= MaskAnimation
+	}
+	if v, ok := cm.GetDamageFlash(entity); ok {
+		snapshot.DamageFlash = &v
+		snapshot.Mask |= MaskDamageFlash
+	}
+	if v, ok := cm.GetCorpse(entity); ok {
+		snapshot.Corpse = &v
+		snapshot.Mask |= MaskCorpse
+	}
+	if v, ok := cm.GetCarrion(entity); ok {
+		snapshot.Carrion = &v
+		snapshot.Mask |= MaskCarrion
+	}
+	if v, ok := cm.GetEatingState(entity); ok {
+		snapshot.EatingState = &v
+		snapshot.Mask |= MaskEatingState
+	}
+	if v, ok := cm.GetAttackState(entity); ok {
+		snapshot.AttackState = &v
+		snapshot.Mask |= MaskAttackState
+	}
+	if v, ok := cm.GetBehavior(entity); ok {
+		snapshot.Behavior = &v
+		snapshot.Mask |= MaskBehavior
+	}
+	if v, ok := cm.GetAnimalConfig(entity); ok {
+		snapshot.AnimalConfig = &v
+		snapshot.Mask |= MaskAnimalConfig
+	}
+	if v, ok := cm.GetReproductionCooldown(entity); ok {
+		snapshot.Cooldown = &v
+		snapshot.Mask |= MaskReproductionCooldown
+	}
+	if v, ok := cm.GetPregnancy(entity); ok {
+		snapshot.Pregnancy = &v
+		snapshot.Mask |= MaskPregnancy
+	}
+	if v, ok := cm.GetAge(entity); ok {
+		snapshot.Age = &v
+		snapshot.Mask |= MaskAge
+	}
+	if v, ok := cm.GetHydration(entity); ok {
+		snapshot.Hydration = &v
+		snapshot.Mask |= MaskHydration
+	}
+	if v, ok := cm.GetDrinkingState(entity); ok {
+		snapshot.DrinkingState = &v
+		snapshot.Mask |= MaskDrinkingState
+	}
+	if v, ok := cm.GetPackMembership(entity); ok {
+		snapshot.Pack = &v
+		snapshot.Mask |= MaskPackMembership
+	}
+	if v, ok := cm.GetConcealment(entity); ok {
+		snapshot.Concealment = &v
+		snapshot.Mask |= MaskConcealment
+	}
+
+	return snapshot
+}
+
+// restoreEntity добавляет сущности все сохранённые компоненты
+// animalTypes переводит номер типа из снапшота в тип текущего реестра (resolveAnimalTypes)
+//
+//nolint:gocyclo // Линейный перебор всех типов компонентов
+func (cm *ComponentManager) restoreEntity(snapshot *EntitySnapshot, animalTypes []AnimalType) {
+	entity := snapshot.ID
+
+	if snapshot.Mask.HasComponent(MaskPosition) {
+		cm.AddPosition(entity, valueOrZero(snapshot.Position))
+	}
+	if snapshot.Mask.HasComponent(MaskVelocity) {
+		cm.AddVelocity(entity, valueOrZero(snapshot.Velocity))
+	}
+	if snapshot.Mask.HasComponent(MaskHealth) {
+		cm.AddHealth(entity, valueOrZero(snapshot.Health))
+	}
+	if snapshot.Mask.HasComponent(MaskSatiation) {
+		cm.AddSatiation(entity, valueOrZero(snapshot.Satiation))
+	}
+	if snapshot.Mask.HasComponent(MaskAnimalType) {
+		cm.AddAnimalType(entity, animalTypes[valueOrZero(snapshot.AnimalType)])
+	}
+	if snapshot.Mask.HasComponent(MaskSize) {
+		cm.AddSize(entity, valueOrZero(snapshot.Size))
+	}
+	if snapshot.Mask.HasComponent(MaskSpeed) {
+		cm.AddSpeed(entity, valueOrZero(snapshot.Speed))
+	}
+	if snapshot.Mask.HasComponent(MaskAnimation) {
+		cm.AddAnimation(entity, valueOrZero(snapshot.Animation))
+	}
+	if snapshot.Mask.HasComponent(MaskDamageFlash) {
+		cm.AddDamageFlash(entity, valueOrZero(snapshot.DamageFlash))
+	}
+	if snapshot.Mask.HasComponent(MaskCorpse) {
+		cm.AddCorpse(entity, valueOrZero(snapshot.Corpse))
+	}
+	if snapshot.Mask.HasComponent(MaskCarrion) {
+		cm.AddCarrion(entity, valueOrZero(snapshot.Carrion))
+	}
+	if snapshot.Mask.HasComponent(MaskEatingState) {
+		cm.AddEatingState(entity, valueOrZero(snapshot.EatingState))
+	}
+	if snapshot.Mask.HasComponent(MaskAttackState) {
+		cm.AddAttackState(entity, valueOrZero(snapshot.AttackState))
+	}
+	if snapshot.Mask.HasComponent(MaskBehavior) {
+		cm.AddBehavior(entity, valueOrZero(snapshot.Behavior))
+	}
+	if snapshot.Mask.HasComponent(MaskAnimalConfig) {
+		cm.AddAnimalConfig(entity, valueOrZero(snapshot.AnimalConfig))
+	}
+	if snapshot.Mask.HasComponent(MaskReproductionCooldown) {
+		cm.AddReproductionCooldown(entity, valueOrZero(snapshot.Cooldown))
+	}
+	if snapshot.Mask.HasComponent(MaskPregnancy) {
+		cm.AddPregnancy(entity, valueOrZero(snapshot.Pregnancy))
+	}
+	if snapshot.Mask.HasComponent(MaskAge) {
+		cm.AddAge(entity, valueOrZero(snapshot.Age))
+	}
+	if snapshot.Mask.HasComponent(MaskHydration) {
+		cm.AddHydration(entity, valueOrZero(snapshot.Hydration))
+	}
+	if snapshot.Mask.HasComponent(MaskDrinkingState) {
+		cm.AddDrinkingState(entity, valueOrZero(snapshot.DrinkingState))
+	}
+	if snapshot.Mask.HasComponent(MaskPackMembership) {
+		cm.AddPackMembership(entity, valueOrZero(snapshot.Pack))
+	}
+	if snapshot.Mask.HasComponent(MaskConcealment) {
+		cm.AddConcealment(entity, valueOrZero(snapshot.Concealment))
+	}
+}
+
+// valueOrZero разыменовывает указатель или возвращает нулевое значение
+func valueOrZero[T any](value *T) T {
+	if value == nil {
+		var zero T
+		return zero
+	}
+	return *value
+}
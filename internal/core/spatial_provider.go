@@ -6,10 +6,10 @@ import "github.com/aiseeq/savanna/internal/physics"
 // Позволяет World работать с любыми системами пространственных запросов, а не только с SpatialGrid
 type SpatialQueryProvider interface {
 	// UpdateEntity обновляет позицию и радиус сущности в пространственной структуре
-	UpdateEntity(id uint32, position physics.Vec2, radius float32)
+	UpdateEntity(id uint64, position physics.Vec2, radius float32)
 
 	// RemoveEntity удаляет сущность из пространственной структуры
-	RemoveEntity(id uint32)
+	RemoveEntity(id uint64)
 
 	// QueryRadius возвращает все сущности в указанном радиусе
 	QueryRadius(center physics.Vec2, radius float32) []physics.SpatialEntry
@@ -21,6 +21,13 @@ type SpatialQueryProvider interface {
 	Clear()
 }
 
+// SpatialSnapshotProvider пространственная структура, умеющая выгрузить свои записи (для снапшотов)
+// Отдельный узкий интерфейс (ISP) - не все реализации обязаны поддерживать сохранение
+type SpatialSnapshotProvider interface {
+	// Entries возвращает все записи в детерминированном порядке
+	Entries() []physics.SpatialEntry
+}
+
 // SpatialGridAdapter адаптер для physics.SpatialGrid (реализует SpatialQueryProvider)
 type SpatialGridAdapter struct {
 	grid *physics.SpatialGrid
@@ -34,12 +41,12 @@ func NewSpatialGridAdapter(worldWidth, worldHeight float32) SpatialQueryProvider
 }
 
 // UpdateEntity обновляет позицию и радиус сущности в пространственной сетке
-func (sga *SpatialGridAdapter) UpdateEntity(id uint32, position physics.Vec2, radius float32) {
+func (sga *SpatialGridAdapter) UpdateEntity(id uint64, position physics.Vec2, radius float32) {
 	sga.grid.Update(physics.EntityID(id), position, radius)
 }
 
 // RemoveEntity удаляет сущность из пространственной сетки
-func (sga *SpatialGridAdapter) RemoveEntity(id uint32) {
+func (sga *SpatialGridAdapter) RemoveEntity(id uint64) {
 	sga.grid.Remove(physics.EntityID(id))
 }
 
@@ -57,3 +64,8 @@ func (sga *SpatialGridAdapter) QueryNearest(center physics.Vec2, maxRadius float
 func (sga *SpatialGridAdapter) Clear() {
 	sga.grid.Clear()
 }
+
+// Entries возвращает все записи пространственной сетки в порядке ячеек
+func (sga *SpatialGridAdapter) Entries() []physics.SpatialEntry {
+	return sga.grid.Entries()
+}
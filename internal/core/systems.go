@@ -1,32 +1,314 @@
 package core
 
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
 // System интерфейс для всех систем симуляции
 type System interface {
 	// Update выполняет один кадр обновления системы
 	Update(world *World, deltaTime float32)
 }
 
+// SystemState сериализуемое внутреннее состояние системы (для снапшотов)
+// Большинство систем хранят всё состояние в компонентах, но некоторые держат
+// собственные таймеры и память кадров - без них продолжение после загрузки разойдётся
+type SystemState struct {
+	Index        int                  `json:"index"`                  // Позиция системы в SystemManager
+	Name         string               `json:"name"`                   // Тип системы (проверка совместимости)
+	Timers       map[string]float32   `json:"timers,omitempty"`       // Скалярные таймеры системы
+	EntityTimers map[EntityID]float32 `json:"entityTimers,omitempty"` // Таймеры по сущностям (кулдауны)
+	EntityFrames map[EntityID]int     `json:"entityFrames,omitempty"` // Запомненные кадры анимации
+}
+
+// StatefulSystem система с внутренним состоянием, которое должно попадать в снапшот
+type StatefulSystem interface {
+	// SaveState возвращает копию внутреннего состояния
+	SaveState() SystemState
+	// LoadState заменяет внутреннее состояние сохранённым
+	LoadState(state SystemState)
+}
+
+// SystemPhase фаза тика: системы выполняются по фазам, внутри фазы - по ограничениям Before/After
+type SystemPhase int
+
+const (
+	PhaseInput   SystemPhase = iota // Окружение и потребности (растительность, сытость, жажда)
+	PhaseAI                         // Решения животных (поиск еды, поведение)
+	PhasePhysics                    // Движение и столкновения
+	PhaseCombat                     // Бой, урон, старение
+	PhaseCleanup                    // Структурные изменения конца тика (трупы, рождение, сброс бюджетов)
+)
+
+// String возвращает имя фазы (для профилирования)
+func (p SystemPhase) String() string {
+	switch p {
+	case PhaseInput:
+		return "input"
+	case PhaseAI:
+		return "ai"
+	case PhasePhysics:
+		return "physics"
+	case PhaseCombat:
+		return "combat"
+	case PhaseCleanup:
+		return "cleanup"
+	default:
+		return fmt.Sprintf("phase%d", int(p))
+	}
+}
+
+// ErrSystemOrder порядок систем не может быть построен (цикл, повтор имени, противоречие фазам)
+var ErrSystemOrder = errors.New("invalid system order")
+
+// SystemSpec объявление системы: имя, фаза и ограничения порядка
+// Ограничения на незарегистрированные имена игнорируются - необязательные системы можно не добавлять
+type SystemSpec struct {
+	Name   string      // Уникальное имя системы
+	Phase  SystemPhase // Фаза тика
+	After  []string    // Система выполняется после перечисленных
+	Before []string    // Система выполняется до перечисленных
+}
+
+// SystemStats профиль выполнения системы
+type SystemStats struct {
+	Name    string
+	Phase   SystemPhase
+	Enabled bool
+	Calls   int           // Количество вызовов Update
+	Last    time.Duration // Длительность последнего вызова
+	Max     time.Duration // Максимальная длительность
+	Total   time.Duration // Суммарное время
+}
+
+// Average средняя длительность вызова
+func (s SystemStats) Average() time.Duration {
+	if s.Calls == 0 {
+		return 0
+	}
+	return s.Total / time.Duration(s.Calls)
+}
+
+// systemEntry зарегистрированная система
+type systemEntry struct {
+	system  System
+	spec    SystemSpec
+	order   int // Порядок регистрации - разрешает равенство при сортировке (детерминизм)
+	enabled bool
+	stats   SystemStats
+}
+
 // SystemManager управляет набором систем и их выполнением
+// Порядок выполнения строится топологической сортировкой: фазы по возрастанию,
+// ограничения Before/After, при равенстве - порядок регистрации
 type SystemManager struct {
-	systems []System
+	registered []*systemEntry // В порядке регистрации
+	systems    []*systemEntry // В порядке выполнения
+	byName     map[string]*systemEntry
 }
 
 // NewSystemManager создаёт новый менеджер систем
 func NewSystemManager() *SystemManager {
 	return &SystemManager{
-		systems: make([]System, 0, 8), // Предварительно выделяем место для 8 систем
+		systems: make([]*systemEntry, 0, 8), // Предварительно выделяем место для 8 систем
+		byName:  make(map[string]*systemEntry),
 	}
 }
 
-// AddSystem добавляет систему в менеджер
+// Register добавляет систему с объявленными именем, фазой и ограничениями порядка
+// При цикле, повторе имени или противоречии фазам система не добавляется и возвращается ErrSystemOrder
+func (sm *SystemManager) Register(system System, spec SystemSpec) error {
+	if spec.Name == "" {
+		return fmt.Errorf("%w: system %T has no name", ErrSystemOrder, system)
+	}
+	if _, exists := sm.byName[spec.Name]; exists {
+		return fmt.Errorf("%w: duplicate system name %q", ErrSystemOrder, spec.Name)
+	}
+
+	entry := &systemEntry{
+		system:  system,
+		spec:    spec,
+		order:   len(sm.registered),
+		enabled: true,
+		stats:   SystemStats{Name: spec.Name, Phase: spec.Phase},
+	}
+	sm.registered = append(sm.registered, entry)
+	sm.byName[spec.Name] = entry
+
+	ordered, err := sm.sortSystems()
+	if err != nil {
+		sm.registered = sm.registered[:len(sm.registered)-1]
+		delete(sm.byName, spec.Name)
+		return err
+	}
+	sm.systems = ordered
+	return nil
+}
+
+// MustRegister регистрирует систему со статичным объявлением
+// Ошибка в таких объявлениях - ошибка программиста, поэтому паника при построении набора систем
+func (sm *SystemManager) MustRegister(system System, spec SystemSpec) {
+	if err := sm.Register(system, spec); err != nil {
+		panic(err)
+	}
+}
+
+// AddSystem добавляет систему сразу после последней зарегистрированной (в её фазе)
+// Имя берётся из типа системы; повторяющиеся типы получают суффикс #N
 func (sm *SystemManager) AddSystem(system System) {
-	sm.systems = append(sm.systems, system)
+	spec := SystemSpec{Name: fmt.Sprintf("%T", system), Phase: PhaseInput}
+	for n := 2; sm.byName[spec.Name] != nil; n++ {
+		spec.Name = fmt.Sprintf("%T#%d", system, n)
+	}
+	if count := len(sm.registered); count > 0 {
+		last := sm.registered[count-1]
+		spec.Phase = last.spec.Phase
+		spec.After = []string{last.spec.Name}
+	}
+
+	// Ограничение только на предыдущую систему той же фазы - цикл невозможен
+	_ = sm.Register(system, spec)
 }
 
-// Update обновляет все системы в порядке их добавления
+// sortSystems строит порядок выполнения (алгоритм Кана с выбором готовой системы с наименьшими фазой и порядком)
+func (sm *SystemManager) sortSystems() ([]*systemEntry, error) {
+	successors := make(map[*systemEntry][]*systemEntry, len(sm.registered))
+	inDegree := make(map[*systemEntry]int, len(sm.registered))
+	addEdge := func(from, to *systemEntry) {
+		successors[from] = append(successors[from], to)
+		inDegree[to]++
+	}
+	for _, entry := range sm.registered {
+		for _, name := range entry.spec.After {
+			if dependency, ok := sm.byName[name]; ok {
+				addEdge(dependency, entry)
+			}
+		}
+		for _, name := range entry.spec.Before {
+			if dependent, ok := sm.byName[name]; ok {
+				addEdge(entry, dependent)
+			}
+		}
+	}
+
+	var ready []*systemEntry
+	for _, entry := range sm.registered {
+		if inDegree[entry] == 0 {
+			ready = append(ready, entry)
+		}
+	}
+
+	ordered := make([]*systemEntry, 0, len(sm.registered))
+	for len(ready) > 0 {
+		next := 0
+		for i, entry := range ready {
+			if systemPrecedes(entry, ready[next]) {
+				next = i
+			}
+		}
+		entry := ready[next]
+		ready = append(ready[:next], ready[next+1:]...)
+
+		if count := len(ordered); count > 0 && ordered[count-1].spec.Phase > entry.spec.Phase {
+			return nil, fmt.Errorf("%w: constraints put %q (%s) after %q (%s)", ErrSystemOrder,
+				entry.spec.Name, entry.spec.Phase, ordered[count-1].spec.Name, ordered[count-1].spec.Phase)
+		}
+		ordered = append(ordered, entry)
+
+		for _, successor := range successors[entry] {
+			if inDegree[successor]--; inDegree[successor] == 0 {
+				ready = append(ready, successor)
+			}
+		}
+	}
+
+	if len(ordered) < len(sm.registered) {
+		var cycle []string
+		for _, entry := range sm.registered {
+			if inDegree[entry] > 0 {
+				cycle = append(cycle, entry.spec.Name)
+			}
+		}
+		return nil, fmt.Errorf("%w: dependency cycle between %v", ErrSystemOrder, cycle)
+	}
+
+	return ordered, nil
+}
+
+// systemPrecedes сравнивает готовые к выполнению системы: сначала фаза, затем порядок регистрации
+func systemPrecedes(a, b *systemEntry) bool {
+	if a.spec.Phase != b.spec.Phase {
+		return a.spec.Phase < b.spec.Phase
+	}
+	return a.order < b.order
+}
+
+// Update обновляет все включённые системы в порядке выполнения
+// После каждой системы доставляются её события и применяются её отложенные команды (world.Sync) -
+// следующая система видит изменения
 func (sm *SystemManager) Update(world *World, deltaTime float32) {
-	for _, system := range sm.systems {
-		system.Update(world, deltaTime)
+	for _, entry := range sm.systems {
+		if !entry.enabled {
+			continue
+		}
+
+		start := time.Now()
+		entry.system.Update(world, deltaTime)
+		world.Sync()
+		entry.stats.record(time.Since(start))
+	}
+}
+
+// record учитывает длительность вызова
+func (s *SystemStats) record(elapsed time.Duration) {
+	s.Calls++
+	s.Last = elapsed
+	s.Total += elapsed
+	s.Max = max(s.Max, elapsed)
+}
+
+// SetEnabled включает или выключает систему по имени; false - системы с таким именем нет
+// Выключенная система сохраняет своё место в порядке и состояние для снапшотов
+func (sm *SystemManager) SetEnabled(name string, enabled bool) bool {
+	entry, ok := sm.byName[name]
+	if !ok {
+		return false
+	}
+	entry.enabled = enabled
+	return true
+}
+
+// IsEnabled проверяет включена ли система
+func (sm *SystemManager) IsEnabled(name string) bool {
+	entry, ok := sm.byName[name]
+	return ok && entry.enabled
+}
+
+// SystemNames возвращает имена систем в порядке выполнения
+func (sm *SystemManager) SystemNames() []string {
+	names := make([]string, len(sm.systems))
+	for i, entry := range sm.systems {
+		names[i] = entry.spec.Name
+	}
+	return names
+}
+
+// Stats возвращает профили систем в порядке выполнения
+func (sm *SystemManager) Stats() []SystemStats {
+	stats := make([]SystemStats, len(sm.systems))
+	for i, entry := range sm.systems {
+		stats[i] = entry.stats
+		stats[i].Enabled = entry.enabled
+	}
+	return stats
+}
+
+// ResetStats обнуляет профили систем
+func (sm *SystemManager) ResetStats() {
+	for _, entry := range sm.systems {
+		entry.stats = SystemStats{Name: entry.spec.Name, Phase: entry.spec.Phase}
 	}
 }
 
@@ -35,7 +317,52 @@ func (sm *SystemManager) GetSystemCount() int {
 	return len(sm.systems)
 }
 
+// SaveStates собирает состояния всех систем, реализующих StatefulSystem
+// Индекс - позиция в порядке выполнения, он детерминирован для одинакового набора регистраций
+func (sm *SystemManager) SaveStates() []SystemState {
+	var states []SystemState
+
+	for i, entry := range sm.systems {
+		stateful, ok := entry.system.(StatefulSystem)
+		if !ok {
+			continue
+		}
+
+		state := stateful.SaveState()
+		state.Index = i
+		state.Name = fmt.Sprintf("%T", entry.system)
+		states = append(states, state)
+	}
+
+	return states
+}
+
+// LoadStates восстанавливает состояния систем
+// Набор систем должен совпадать с тем, из которого состояния были сохранены
+func (sm *SystemManager) LoadStates(states []SystemState) error {
+	for _, state := range states {
+		if state.Index < 0 || state.Index >= len(sm.systems) {
+			return fmt.Errorf("system state index %d out of range (have %d systems)", state.Index, len(sm.systems))
+		}
+
+		system := sm.systems[state.Index].system
+		if name := fmt.Sprintf("%T", system); name != state.Name {
+			return fmt.Errorf("system %d mismatch: snapshot has %s, manager has %s", state.Index, state.Name, name)
+		}
+
+		stateful, ok := system.(StatefulSystem)
+		if !ok {
+			return fmt.Errorf("system %d (%s) does not support state loading", state.Index, state.Name)
+		}
+		stateful.LoadState(state)
+	}
+
+	return nil
+}
+
 // Clear очищает все системы (для тестов)
 func (sm *SystemManager) Clear() {
+	sm.registered = sm.registered[:0]
 	sm.systems = sm.systems[:0]
+	clear(sm.byName)
 }
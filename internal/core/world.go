@@ -2,6 +2,7 @@ package core
 
 import (
 	"math/rand"
+	"reflect"
 
 	"github.com/aiseeq/savanna/internal/physics"
 )
@@ -14,13 +15,21 @@ type World struct {
 	componentManager *ComponentManager // Управление компонентами
 	queryManager     *QueryManager     // Запросы и итерации
 	worldState       *WorldState       // Состояние мира (время, размеры, RNG)
+
+	customComponents map[reflect.Type]customStorage     // Пользовательские компоненты по типу (RegisterComponent)
+	pendingCustom    map[string]CustomComponentSnapshot // Значения из снапшота до регистрации компонента (по имени)
+	commands         CommandBuffer                      // Отложенные структурные изменения (применяет SystemManager)
+	events           EventBus                           // События симуляции (доставляет SystemManager)
+
+	parallelism int                     // Потоки обработки секторов карты (SetParallelism)
+	sectors     [SectorCount][]EntityID // Буферы раскладки сущностей по секторам (ForEachSector)
 }
 
 // NewWorld создаёт новый мир симуляции
 func NewWorld(worldWidth, worldHeight float32, seed int64) *World {
 	// Создаём специализированные менеджеры (применяем Composition Pattern)
 	entityManager := NewEntityManager()
-	componentManager := NewComponentManager()
+	componentManager := NewComponentManager(entityManager)
 	worldState := NewWorldState(worldWidth, worldHeight, seed)
 	queryManager := NewQueryManager(componentManager, entityManager)
 
@@ -29,6 +38,7 @@ func NewWorld(worldWidth, worldHeight float32, seed int64) *World {
 		componentManager: componentManager,
 		queryManager:     queryManager,
 		worldState:       worldState,
+		customComponents: make(map[reflect.Type]customStorage),
 	}
 }
 
@@ -59,7 +69,9 @@ func (w *World) Update(realDeltaTime float32) {
 
 // CreateEntity создаёт новую сущность (делегирование к EntityManager)
 func (w *World) CreateEntity() EntityID {
-	return w.entityManager.CreateEntity()
+	entity := w.entityManager.CreateEntity()
+	w.queryManager.componentsChanged(entity) // Запросы без компонентов (маска 0) включают новую сущность
+	return entity
 }
 
 // DestroyEntity уничтожает сущность и все её компоненты
@@ -73,11 +85,20 @@ func (w *World) DestroyEntity(entity EntityID) bool {
 		w.removeSpatialEntity(entity)
 	}
 
+	// Погибшее во время еды животное заканчивает есть
+	if eatingState, eating := w.componentManager.GetEatingState(entity); eating {
+		w.emitEatingFinished(entity, eatingState)
+	}
+
 	// Очищаем все компоненты (делегирование к ComponentManager)
 	w.componentManager.ClearAllComponents(entity)
 
 	// Уничтожаем сущность (делегирование к EntityManager)
-	return w.entityManager.DestroyEntity(entity)
+	if !w.entityManager.DestroyEntity(entity) {
+		return false
+	}
+	w.queryManager.componentsChanged(entity)
+	return true
 }
 
 // IsAlive проверяет, существует ли сущность (делегирование к EntityManager)
@@ -114,7 +135,39 @@ func (w *World) Clear() {
 	w.worldState = NewWorldState(width, height, 0)
 
 	// Очищаем компоненты через ComponentManager
-	w.componentManager = NewComponentManager()
+	// QueryManager пересоздаётся вместе с ним, иначе запросы читали бы старые компоненты
+	// Регистрации пользовательских компонентов переживают очистку - их дескрипторы остаются валидными
+	custom := w.componentManager.custom
+	w.componentManager = NewComponentManager(w.entityManager)
+	for _, storage := range custom {
+		storage.reset()
+		w.componentManager.addCustomStorage(storage)
+	}
+	w.queryManager = NewQueryManager(w.componentManager, w.entityManager)
+	w.pendingCustom = nil
+	w.commands = CommandBuffer{}
+	w.events.pending = w.events.pending[:0] // Подписки переживают очистку, недоставленные события - нет
+}
+
+// Commands возвращает очередь отложенных изменений мира
+// Команды применяются после текущей системы (SystemManager) или явным FlushCommands
+func (w *World) Commands() *CommandBuffer {
+	return &w.commands
+}
+
+// FlushCommands применяет отложенные изменения, возвращает количество применённых команд
+func (w *World) FlushCommands() int {
+	return w.commands.Playback(w)
+}
+
+// Sync точка синхронизации после системы: события доставляются подписчикам, пока сущности событий
+// ещё не удалены отложенными командами, затем применяются команды системы и подписчиков.
+// События, опубликованные при применении команд, доставляются в этом же вызове
+func (w *World) Sync() {
+	for w.events.Pending() > 0 || w.commands.Len() > 0 {
+		w.DispatchEvents()
+		w.FlushCommands()
+	}
 }
 
 // ===== МЕТОДЫ-ФАСАДЫ ДЛЯ СОБЛЮДЕНИЯ LAW OF DEMETER =====
@@ -135,13 +188,13 @@ func (w *World) updateSpatialEntity(entity EntityID, x, y float32) {
 	}
 	radiusInTiles := radius
 
-	w.worldState.GetSpatialProvider().UpdateEntity(uint32(entity), posInTiles, radiusInTiles)
+	w.worldState.GetSpatialProvider().UpdateEntity(uint64(entity), posInTiles, radiusInTiles)
 }
 
 // removeSpatialEntity удаляет сущность из пространственной системы
 // Скрывает сложность доступа к SpatialProvider через WorldState (LoD)
 func (w *World) removeSpatialEntity(entity EntityID) {
-	w.worldState.GetSpatialProvider().RemoveEntity(uint32(entity))
+	w.worldState.GetSpatialProvider().RemoveEntity(uint64(entity))
 }
 
 // querySpatialRadius возвращает сущности в радиусе
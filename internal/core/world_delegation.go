@@ -17,7 +17,9 @@ func (w *World) HasComponents(entity EntityID, mask ComponentMask) bool {
 
 // Position component delegation
 func (w *World) AddPosition(entity EntityID, position Position) bool {
-	w.componentManager.AddPosition(entity, position)
+	if !w.componentManager.AddPosition(entity, position) {
+		return false // Устаревший дескриптор: не трогаем пространственную систему
+	}
 	// При создании новой сущности автоматически добавляем в пространственную систему
 	// (это логично, так как новая позиция должна быть известна пространственной системе)
 	w.updateSpatialEntity(entity, position.X, position.Y)
@@ -37,6 +39,9 @@ func (w *World) SetPosition(entity EntityID, position Position) bool {
 // UpdateSpatialPosition обновляет позицию сущности в пространственной системе
 // Должен вызываться системами после изменения Position компонента
 func (w *World) UpdateSpatialPosition(entity EntityID, position Position) {
+	if !w.entityManager.IsAlive(entity) {
+		return // Устаревший дескриптор не должен сдвигать запись новой сущности
+	}
 	w.updateSpatialEntity(entity, position.X, position.Y)
 }
 
@@ -51,8 +56,7 @@ func (w *World) RemovePosition(entity EntityID) bool {
 
 // Velocity component delegation
 func (w *World) AddVelocity(entity EntityID, velocity Velocity) bool {
-	w.componentManager.AddVelocity(entity, velocity)
-	return true
+	return w.componentManager.AddVelocity(entity, velocity)
 }
 
 func (w *World) GetVelocity(entity EntityID) (Velocity, bool) {
@@ -69,8 +73,7 @@ func (w *World) RemoveVelocity(entity EntityID) bool {
 
 // Health component delegation
 func (w *World) AddHealth(entity EntityID, health Health) bool {
-	w.componentManager.AddHealth(entity, health)
-	return true
+	return w.componentManager.AddHealth(entity, health)
 }
 
 func (w *World) GetHealth(entity EntityID) (Health, bool) {
@@ -87,8 +90,7 @@ func (w *World) RemoveHealth(entity EntityID) bool {
 
 // Satiation component delegation
 func (w *World) AddSatiation(entity EntityID, satiation Satiation) bool {
-	w.componentManager.AddSatiation(entity, satiation)
-	return true
+	return w.componentManager.AddSatiation(entity, satiation)
 }
 
 func (w *World) GetSatiation(entity EntityID) (Satiation, bool) {
@@ -105,8 +107,7 @@ func (w *World) RemoveSatiation(entity EntityID) bool {
 
 // AnimalType component delegation
 func (w *World) AddAnimalType(entity EntityID, animalType AnimalType) bool {
-	w.componentManager.AddAnimalType(entity, animalType)
-	return true
+	return w.componentManager.AddAnimalType(entity, animalType)
 }
 
 func (w *World) GetAnimalType(entity EntityID) (AnimalType, bool) {
@@ -123,8 +124,7 @@ func (w *World) RemoveAnimalType(entity EntityID) bool {
 
 // Size component delegation
 func (w *World) AddSize(entity EntityID, size Size) bool {
-	w.componentManager.AddSize(entity, size)
-	return true
+	return w.componentManager.AddSize(entity, size)
 }
 
 func (w *World) GetSize(entity EntityID) (Size, bool) {
@@ -141,8 +141,7 @@ func (w *World) RemoveSize(entity EntityID) bool {
 
 // Speed component delegation
 func (w *World) AddSpeed(entity EntityID, speed Speed) bool {
-	w.componentManager.AddSpeed(entity, speed)
-	return true
+	return w.componentManager.AddSpeed(entity, speed)
 }
 
 func (w *World) GetSpeed(entity EntityID) (Speed, bool) {
@@ -159,8 +158,7 @@ func (w *World) RemoveSpeed(entity EntityID) bool {
 
 // Animation component delegation
 func (w *World) AddAnimation(entity EntityID, animation Animation) bool {
-	w.componentManager.AddAnimation(entity, animation)
-	return true
+	return w.componentManager.AddAnimation(entity, animation)
 }
 
 func (w *World) GetAnimation(entity EntityID) (Animation, bool) {
@@ -177,8 +175,7 @@ func (w *World) RemoveAnimation(entity EntityID) bool {
 
 // DamageFlash component delegation
 func (w *World) AddDamageFlash(entity EntityID, damageFlash DamageFlash) bool {
-	w.componentManager.AddDamageFlash(entity, damageFlash)
-	return true // Add методы в ComponentManager всегда успешны
+	return w.componentManager.AddDamageFlash(entity, damageFlash)
 }
 
 func (w *World) GetDamageFlash(entity EntityID) (DamageFlash, bool) {
@@ -195,8 +192,7 @@ func (w *World) RemoveDamageFlash(entity EntityID) bool {
 
 // Corpse component delegation
 func (w *World) AddCorpse(entity EntityID, corpse Corpse) bool {
-	w.componentManager.AddCorpse(entity, corpse)
-	return true
+	return w.componentManager.AddCorpse(entity, corpse)
 }
 
 func (w *World) GetCorpse(entity EntityID) (Corpse, bool) {
@@ -213,8 +209,7 @@ func (w *World) RemoveCorpse(entity EntityID) bool {
 
 // Carrion component delegation
 func (w *World) AddCarrion(entity EntityID, carrion Carrion) bool {
-	w.componentManager.AddCarrion(entity, carrion)
-	return true
+	return w.componentManager.AddCarrion(entity, carrion)
 }
 
 func (w *World) GetCarrion(entity EntityID) (Carrion, bool) {
@@ -230,8 +225,17 @@ func (w *World) RemoveCarrion(entity EntityID) bool {
 }
 
 // EatingState component delegation
+// Начало и конец еды публикуются событиями EatingStarted/EatingFinished (замена цели - конец и начало)
 func (w *World) AddEatingState(entity EntityID, eatingState EatingState) bool {
-	w.componentManager.AddEatingState(entity, eatingState)
+	previous, wasEating := w.componentManager.GetEatingState(entity)
+	if !w.componentManager.AddEatingState(entity, eatingState) {
+		return false
+	}
+
+	if wasEating {
+		w.emitEatingFinished(entity, previous)
+	}
+	w.events.Emit(EatingStarted{Eater: entity, Target: eatingState.Target, TargetType: eatingState.TargetType})
 	return true
 }
 
@@ -244,13 +248,30 @@ func (w *World) SetEatingState(entity EntityID, eatingState EatingState) bool {
 }
 
 func (w *World) RemoveEatingState(entity EntityID) bool {
-	return w.componentManager.RemoveEatingState(entity)
+	eatingState, eating := w.componentManager.GetEatingState(entity)
+	if !w.componentManager.RemoveEatingState(entity) {
+		return false
+	}
+
+	if eating {
+		w.emitEatingFinished(entity, eatingState)
+	}
+	return true
+}
+
+// emitEatingFinished публикует конец еды по снятому состоянию поедания
+func (w *World) emitEatingFinished(entity EntityID, eatingState EatingState) {
+	w.events.Emit(EatingFinished{
+		Eater:           entity,
+		Target:          eatingState.Target,
+		TargetType:      eatingState.TargetType,
+		NutritionGained: eatingState.NutritionGained,
+	})
 }
 
 // AttackState component delegation
 func (w *World) AddAttackState(entity EntityID, attackState AttackState) bool {
-	w.componentManager.AddAttackState(entity, attackState)
-	return true
+	return w.componentManager.AddAttackState(entity, attackState)
 }
 
 func (w *World) GetAttackState(entity EntityID) (AttackState, bool) {
@@ -267,8 +288,7 @@ func (w *World) RemoveAttackState(entity EntityID) bool {
 
 // Behavior component delegation
 func (w *World) AddBehavior(entity EntityID, behavior Behavior) bool {
-	w.componentManager.AddBehavior(entity, behavior)
-	return true
+	return w.componentManager.AddBehavior(entity, behavior)
 }
 
 func (w *World) GetBehavior(entity EntityID) (Behavior, bool) {
@@ -285,8 +305,7 @@ func (w *World) RemoveBehavior(entity EntityID) bool {
 
 // AnimalConfig component delegation
 func (w *World) AddAnimalConfig(entity EntityID, config AnimalConfig) bool {
-	w.componentManager.AddAnimalConfig(entity, config)
-	return true
+	return w.componentManager.AddAnimalConfig(entity, config)
 }
 
 func (w *World) GetAnimalConfig(entity EntityID) (AnimalConfig, bool) {
@@ -301,6 +320,125 @@ func (w *World) RemoveAnimalConfig(entity EntityID) bool {
 	return w.componentManager.RemoveAnimalConfig(entity)
 }
 
+// ReproductionCooldown component delegation
+func (w *World) AddReproductionCooldown(entity EntityID, cooldown ReproductionCooldown) bool {
+	return w.componentManager.AddReproductionCooldown(entity, cooldown)
+}
+
+func (w *World) GetReproductionCooldown(entity EntityID) (ReproductionCooldown, bool) {
+	return w.componentManager.GetReproductionCooldown(entity)
+}
+
+func (w *World) SetReproductionCooldown(entity EntityID, cooldown ReproductionCooldown) bool {
+	return w.componentManager.SetReproductionCooldown(entity, cooldown)
+}
+
+func (w *World) RemoveReproductionCooldown(entity EntityID) bool {
+	return w.componentManager.RemoveReproductionCooldown(entity)
+}
+
+// Pregnancy component delegation
+func (w *World) AddPregnancy(entity EntityID, pregnancy Pregnancy) bool {
+	return w.componentManager.AddPregnancy(entity, pregnancy)
+}
+
+func (w *World) GetPregnancy(entity EntityID) (Pregnancy, bool) {
+	return w.componentManager.GetPregnancy(entity)
+}
+
+func (w *World) SetPregnancy(entity EntityID, pregnancy Pregnancy) bool {
+	return w.componentManager.SetPregnancy(entity, pregnancy)
+}
+
+func (w *World) RemovePregnancy(entity EntityID) bool {
+	return w.componentManager.RemovePregnancy(entity)
+}
+
+// Age component delegation
+func (w *World) AddAge(entity EntityID, age Age) bool {
+	return w.componentManager.AddAge(entity, age)
+}
+
+func (w *World) GetAge(entity EntityID) (Age, bool) {
+	return w.componentManager.GetAge(entity)
+}
+
+func (w *World) SetAge(entity EntityID, age Age) bool {
+	return w.componentManager.SetAge(entity, age)
+}
+
+func (w *World) RemoveAge(entity EntityID) bool {
+	return w.componentManager.RemoveAge(entity)
+}
+
+// Hydration component delegation
+func (w *World) AddHydration(entity EntityID, hydration Hydration) bool {
+	return w.componentManager.AddHydration(entity, hydration)
+}
+
+func (w *World) GetHydration(entity EntityID) (Hydration, bool) {
+	return w.componentManager.GetHydration(entity)
+}
+
+func (w *World) SetHydration(entity EntityID, hydration Hydration) bool {
+	return w.componentManager.SetHydration(entity, hydration)
+}
+
+func (w *World) RemoveHydration(entity EntityID) bool {
+	return w.componentManager.RemoveHydration(entity)
+}
+
+// DrinkingState component delegation
+func (w *World) AddDrinkingState(entity EntityID, drinkingState DrinkingState) bool {
+	return w.componentManager.AddDrinkingState(entity, drinkingState)
+}
+
+func (w *World) GetDrinkingState(entity EntityID) (DrinkingState, bool) {
+	return w.componentManager.GetDrinkingState(entity)
+}
+
+func (w *World) SetDrinkingState(entity EntityID, drinkingState DrinkingState) bool {
+	return w.componentManager.SetDrinkingState(entity, drinkingState)
+}
+
+func (w *World) RemoveDrinkingState(entity EntityID) bool {
+	return w.componentManager.RemoveDrinkingState(entity)
+}
+
+// PackMembership component delegation
+func (w *World) AddPackMembership(entity EntityID, pack PackMembership) bool {
+	return w.componentManager.AddPackMembership(entity, pack)
+}
+
+func (w *World) GetPackMembership(entity EntityID) (PackMembership, bool) {
+	return w.componentManager.GetPackMembership(entity)
+}
+
+func (w *World) SetPackMembership(entity EntityID, pack PackMembership) bool {
+	return w.componentManager.SetPackMembership(entity, pack)
+}
+
+func (w *World) RemovePackMembership(entity EntityID) bool {
+	return w.componentManager.RemovePackMembership(entity)
+}
+
+// Concealment component delegation
+func (w *World) AddConcealment(entity EntityID, concealment Concealment) bool {
+	return w.componentManager.AddConcealment(entity, concealment)
+}
+
+func (w *World) GetConcealment(entity EntityID) (Concealment, bool) {
+	return w.componentManager.GetConcealment(entity)
+}
+
+func (w *World) SetConcealment(entity EntityID, concealment Concealment) bool {
+	return w.componentManager.SetConcealment(entity, concealment)
+}
+
+func (w *World) RemoveConcealment(entity EntityID) bool {
+	return w.componentManager.RemoveConcealment(entity)
+}
+
 // ===== ДЕЛЕГИРОВАНИЕ К QUERY MANAGER =====
 
 // ForEach вызывает функцию для каждой активной сущности
@@ -308,6 +446,11 @@ func (w *World) ForEach(fn func(EntityID)) {
 	w.queryManager.ForEach(fn)
 }
 
+// Query возвращает зарегистрированный запрос для маски (системы могут хранить его между тиками)
+func (w *World) Query(mask ComponentMask) *Query {
+	return w.queryManager.Query(mask)
+}
+
 // ForEachWith вызывает функцию для каждой сущности с указанными компонентами
 func (w *World) ForEachWith(mask ComponentMask, fn QueryFunc) {
 	w.queryManager.ForEachWith(mask, fn)
@@ -11,7 +11,9 @@ type WorldState struct {
 	timeScale float32 // Масштаб времени (1.0 = нормальная скорость)
 
 	// Детерминированный генератор случайных чисел
-	rng *rand.Rand
+	// rngSource запоминает seed и число шагов для сохранения/загрузки снапшотов
+	rng       *rand.Rand
+	rngSource *countingSource
 
 	// Размеры мира
 	worldWidth  float32
@@ -26,15 +28,18 @@ type WorldState struct {
 
 // NewWorldState создаёт новое состояние мира
 func NewWorldState(worldWidth, worldHeight float32, seed int64) *WorldState {
+	rngSource := newCountingSource(seed)
+
 	return &WorldState{
 		time:            0,
 		deltaTime:       0,
 		timeScale:       1.0,
-		rng:             rand.New(rand.NewSource(seed)),
+		rng:             rand.New(rngSource),
+		rngSource:       rngSource,
 		worldWidth:      worldWidth,
 		worldHeight:     worldHeight,
 		spatialProvider: NewSpatialGridAdapter(worldWidth, worldHeight),
-		entitiesBuffer:  make([]EntityID, 0, MaxEntities),
+		entitiesBuffer:  make([]EntityID, 0, EntityChunkSize),
 	}
 }
 
@@ -69,6 +74,23 @@ func (ws *WorldState) GetRNG() *rand.Rand {
 	return ws.rng
 }
 
+// GetRNGState возвращает состояние RNG (seed и количество сделанных шагов)
+func (ws *WorldState) GetRNGState() (seed int64, draws uint64) {
+	return ws.rngSource.seed, ws.rngSource.draws
+}
+
+// RestoreTime восстанавливает время симуляции (для загрузки снапшотов)
+func (ws *WorldState) RestoreTime(time, deltaTime, timeScale float32) {
+	ws.time = time
+	ws.deltaTime = deltaTime
+	ws.timeScale = timeScale
+}
+
+// RestoreRNGState восстанавливает состояние RNG (для загрузки снапшотов)
+func (ws *WorldState) RestoreRNGState(seed int64, draws uint64) {
+	ws.rngSource.restore(seed, draws)
+}
+
 // GetWorldWidth возвращает ширину мира
 func (ws *WorldState) GetWorldWidth() float32 {
 	return ws.worldWidth
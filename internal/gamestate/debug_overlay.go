@@ -19,6 +19,7 @@ type DebugOverlay struct {
 	showCameraInfo   bool
 	showPerformance  bool
 	showSystemInfo   bool
+	showSystemTiming bool
 }
 
 // NewDebugOverlay создает новый отладочный оверлей
@@ -30,6 +31,7 @@ func NewDebugOverlay() *DebugOverlay {
 		showCameraInfo:   true,
 		showPerformance:  true,
 		showSystemInfo:   true,
+		showSystemTiming: true,
 	}
 }
 
@@ -144,10 +146,37 @@ func (d *DebugOverlay) GenerateDebugInstructions(gs *GameState) []DebugTextInstr
 		// Информация о первом волке
 		firstWolf := d.getFirstEntityOfType(gs.GetWorld(), core.TypeWolf)
 		if firstWolf != 0 {
-			instructions, _ = d.addEntityDebugInfo(instructions, gs.GetWorld(), firstWolf, "First Wolf", y)
+			instructions, y = d.addEntityDebugInfo(instructions, gs.GetWorld(), firstWolf, "First Wolf", y)
 		}
 	}
 
+	if d.showSystemTiming {
+		instructions = d.addSystemTiming(instructions, gs.GetSystemStats(), y)
+	}
+
+	return instructions
+}
+
+// addSystemTiming добавляет время выполнения систем: последний тик и среднее (мс)
+func (d *DebugOverlay) addSystemTiming(
+	instructions []DebugTextInstruction,
+	stats []core.SystemStats,
+	startY float64,
+) []DebugTextInstruction {
+	y := startY
+	for _, system := range stats {
+		status := ""
+		if !system.Enabled {
+			status = " (off)"
+		}
+		instructions = append(instructions, DebugTextInstruction{
+			Text: fmt.Sprintf("[%s] %s: %.3f / %.3f ms%s", system.Phase, system.Name,
+				float64(system.Last.Microseconds())/1000, float64(system.Average().Microseconds())/1000, status),
+			X: 10,
+			Y: y,
+		})
+		y += 15
+	}
 	return instructions
 }
 
@@ -32,6 +32,7 @@ type InputEvent struct {
 	Button MouseButton // для мыши
 	Key    int         // для клавиатуры
 	X, Y   float64     // координаты мыши
+	Tick   int         `json:",omitempty"` // тик симуляции, на котором событие произошло (для реплеев)
 }
 
 // InputProvider интерфейс для получения входных событий
@@ -56,6 +57,12 @@ func (r *EventRecorder) Record(event InputEvent) {
 	r.events = append(r.events, event)
 }
 
+// RecordAt записывает событие с привязкой к тику симуляции
+func (r *EventRecorder) RecordAt(tick int, event InputEvent) {
+	event.Tick = tick
+	r.Record(event)
+}
+
 // GetEvents возвращает записанные события
 func (r *EventRecorder) GetEvents() []InputEvent {
 	return r.events
@@ -168,7 +168,7 @@ func (gs *GameState) generateSpriteInstructions(instructions *RenderInstructionS
 			AnimType:    animType,
 			Tint:        tint,
 			FacingRight: facingRight,
-			Scale:       1.0,
+			Scale:       gs.getEntityScale(entity),
 		}
 
 		instructions.Sprites = append(instructions.Sprites, instruction)
@@ -309,6 +309,14 @@ func (gs *GameState) getEntityTint(entity core.EntityID) color.RGBA {
 	return color.RGBA{255, 255, 255, 255} // Обычный цвет
 }
 
+// getEntityScale возвращает масштаб спрайта по размеру тела (детёныши меньше взрослых)
+func (gs *GameState) getEntityScale(entity core.EntityID) float64 {
+	if age, hasAge := gs.world.GetAge(entity); hasAge {
+		return float64(age.SizeMultiplier)
+	}
+	return 1.0
+}
+
 func (gs *GameState) countAnimalsByType(animalType core.AnimalType) int {
 	count := 0
 	gs.world.ForEachWith(core.MaskAnimalType, func(entity core.EntityID) {
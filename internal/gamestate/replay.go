@@ -0,0 +1,300 @@
+package gamestate
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/aiseeq/savanna/internal/core"
+)
+
+// ReplayFormatVersion версия формата файла реплея
+const ReplayFormatVersion = 1
+
+// Категории расхождения (какая часть состояния разошлась)
+const (
+	DivergenceEntitySet = "entity set" // Набор сущностей различается (кто-то лишний/пропал)
+	DivergencePosition  = "position"
+	DivergenceHealth    = "health"
+	DivergenceSatiation = "satiation"
+	DivergenceGrass     = "grass"
+	DivergenceCamera    = "camera"
+)
+
+// ErrReplayVersion неподдерживаемая версия реплея
+var ErrReplayVersion = errors.New("unsupported replay version")
+
+// Replay запись детерминированного прогона: параметры запуска, ввод и хэш каждого тика
+// seed и размеры мира хранятся в Config (GameConfig.RandomSeed)
+type Replay struct {
+	Version int          `json:"version"`
+	Config  GameConfig   `json:"config"`
+	Events  []InputEvent `json:"events,omitempty"` // Ввод с привязкой к тикам (InputEvent.Tick)
+	Hashes  []StateHash  `json:"hashes"`           // Хэш состояния после каждого тика
+}
+
+// ReplayDivergence описание первого расхождения при воспроизведении
+type ReplayDivergence struct {
+	Tick      int           // Тик, на котором хэши впервые разошлись
+	Component string        // Категория расхождения (Divergence*)
+	Entity    core.EntityID // Сущность (InvalidEntity если не определена или не применимо)
+	Expected  uint64        // Записанный хэш
+	Actual    uint64        // Хэш при воспроизведении
+}
+
+// String возвращает человекочитаемое описание расхождения
+func (d *ReplayDivergence) String() string {
+	if d.Entity != core.InvalidEntity {
+		return fmt.Sprintf("tick %d: %s of entity %d differs (expected %#x, got %#x)",
+			d.Tick, d.Component, d.Entity, d.Expected, d.Actual)
+	}
+	return fmt.Sprintf("tick %d: %s differs (expected %#x, got %#x)", d.Tick, d.Component, d.Expected, d.Actual)
+}
+
+// ReplayRecorder записывает реплей поверх GameState
+type ReplayRecorder struct {
+	state          *GameState
+	events         *EventRecorder
+	hashes         []StateHash
+	config         GameConfig
+	entityDetailed bool // Сохранять покомпонентные хэши сущностей
+}
+
+// NewReplayRecorder создаёт новое состояние игры и начинает запись
+// entityDetailed=true позволяет плееру указать конкретную сущность при расхождении
+func NewReplayRecorder(config *GameConfig, entityDetailed bool) *ReplayRecorder {
+	return &ReplayRecorder{
+		state:          NewGameState(config),
+		events:         NewEventRecorder(),
+		config:         *config,
+		entityDetailed: entityDetailed,
+	}
+}
+
+// GetState возвращает записываемое состояние игры
+func (rr *ReplayRecorder) GetState() *GameState {
+	return rr.state
+}
+
+// Step обрабатывает ввод и выполняет один фиксированный шаг, записывая ввод и хэш
+func (rr *ReplayRecorder) Step(events []InputEvent) {
+	tick := rr.state.GetTick()
+	for _, event := range events {
+		rr.events.RecordAt(tick, event)
+	}
+
+	stepGameState(rr.state, events)
+	rr.hashes = append(rr.hashes, rr.state.ComputeStateHash(rr.entityDetailed))
+}
+
+// Replay возвращает записанный реплей
+func (rr *ReplayRecorder) Replay() *Replay {
+	return &Replay{
+		Version: ReplayFormatVersion,
+		Config:  rr.config,
+		Events:  rr.events.GetEvents(),
+		Hashes:  rr.hashes,
+	}
+}
+
+// ReplayPlayer воспроизводит реплей и сверяет хэши
+type ReplayPlayer struct {
+	replay *Replay
+	state  *GameState
+	events map[int][]InputEvent
+	next   int // Индекс следующего хэша для проверки
+}
+
+// NewReplayPlayer создаёт плеер: состояние игры пересоздаётся из сохранённой конфигурации
+func NewReplayPlayer(replay *Replay) (*ReplayPlayer, error) {
+	if replay.Version != ReplayFormatVersion {
+		return nil, fmt.Errorf("%w: got %d, expected %d", ErrReplayVersion, replay.Version, ReplayFormatVersion)
+	}
+
+	events := make(map[int][]InputEvent)
+	for _, event := range replay.Events {
+		events[event.Tick] = append(events[event.Tick], event)
+	}
+
+	config := replay.Config
+	return &ReplayPlayer{
+		replay: replay,
+		state:  NewGameState(&config),
+		events: events,
+	}, nil
+}
+
+// GetState возвращает воспроизводимое состояние игры
+func (rp *ReplayPlayer) GetState() *GameState {
+	return rp.state
+}
+
+// Done возвращает true если все записанные тики воспроизведены
+func (rp *ReplayPlayer) Done() bool {
+	return rp.next >= len(rp.replay.Hashes)
+}
+
+// Step воспроизводит один тик и возвращает расхождение (nil если хэши совпали)
+func (rp *ReplayPlayer) Step() *ReplayDivergence {
+	if rp.Done() {
+		return nil
+	}
+
+	expected := rp.replay.Hashes[rp.next]
+	rp.next++
+
+	stepGameState(rp.state, rp.events[rp.state.GetTick()])
+
+	actual := rp.state.ComputeStateHash(expected.Entities != nil)
+	if actual.Hash == expected.Hash {
+		return nil
+	}
+
+	return describeDivergence(&expected, &actual)
+}
+
+// Run воспроизводит реплей до конца или до первого расхождения
+func (rp *ReplayPlayer) Run() *ReplayDivergence {
+	for !rp.Done() {
+		if divergence := rp.Step(); divergence != nil {
+			return divergence
+		}
+	}
+	return nil
+}
+
+// VerifyReplay воспроизводит реплей целиком (nil, nil - симуляция детерминирована)
+func VerifyReplay(replay *Replay) (*ReplayDivergence, error) {
+	player, err := NewReplayPlayer(replay)
+	if err != nil {
+		return nil, err
+	}
+	return player.Run(), nil
+}
+
+// SaveToFile сохраняет реплей в JSON файл
+func (r *Replay) SaveToFile(filename string) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	defer file.Close()
+
+	if err := json.NewEncoder(file).Encode(r); err != nil {
+		return fmt.Errorf("failed to encode replay: %w", err)
+	}
+
+	return nil
+}
+
+// LoadReplayFromFile загружает реплей из JSON файла
+func LoadReplayFromFile(filename string) (*Replay, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer file.Close()
+
+	var replay Replay
+	if err := json.NewDecoder(file).Decode(&replay); err != nil {
+		return nil, fmt.Errorf("failed to decode replay: %w", err)
+	}
+
+	return &replay, nil
+}
+
+// stepGameState общий шаг для записи и воспроизведения: сначала ввод, потом симуляция
+func stepGameState(state *GameState, events []InputEvent) {
+	if len(events) > 0 {
+		state.ProcessInput(events)
+	}
+	state.Update()
+}
+
+// describeDivergence определяет категорию (и по возможности сущность) расхождения
+func describeDivergence(expected, actual *StateHash) *ReplayDivergence {
+	divergence := &ReplayDivergence{Tick: expected.Tick, Expected: expected.Hash, Actual: actual.Hash}
+
+	if entityDivergence := findEntityDivergence(expected, actual); entityDivergence != nil {
+		return entityDivergence
+	}
+
+	categories := []struct {
+		name             string
+		expected, actual uint64
+	}{
+		{DivergencePosition, expected.Positions, actual.Positions},
+		{DivergenceHealth, expected.Health, actual.Health},
+		{DivergenceSatiation, expected.Satiation, actual.Satiation},
+		{DivergenceGrass, expected.Grass, actual.Grass},
+		{DivergenceCamera, expected.Camera, actual.Camera},
+	}
+	for _, category := range categories {
+		if category.expected != category.actual {
+			divergence.Component = category.name
+			divergence.Expected = category.expected
+			divergence.Actual = category.actual
+			return divergence
+		}
+	}
+
+	return divergence
+}
+
+// findEntityDivergence ищет первую (по ID) сущность с различающимися компонентами
+func findEntityDivergence(expected, actual *StateHash) *ReplayDivergence {
+	if expected.Entities == nil {
+		return nil
+	}
+
+	for i := 0; i < len(expected.Entities) || i < len(actual.Entities); i++ {
+		if i >= len(expected.Entities) || i >= len(actual.Entities) ||
+			expected.Entities[i].ID != actual.Entities[i].ID {
+			return &ReplayDivergence{
+				Tick:      expected.Tick,
+				Component: DivergenceEntitySet,
+				Entity:    firstEntityID(expected.Entities, actual.Entities, i),
+				Expected:  uint64(len(expected.Entities)),
+				Actual:    uint64(len(actual.Entities)),
+			}
+		}
+
+		want, got := expected.Entities[i], actual.Entities[i]
+		fields := []struct {
+			name             string
+			expected, actual uint32
+		}{
+			{DivergencePosition, want.Position, got.Position},
+			{DivergenceHealth, want.Health, got.Health},
+			{DivergenceSatiation, want.Satiation, got.Satiation},
+		}
+		for _, field := range fields {
+			if field.expected != field.actual {
+				return &ReplayDivergence{
+					Tick:      expected.Tick,
+					Component: field.name,
+					Entity:    want.ID,
+					Expected:  uint64(field.expected),
+					Actual:    uint64(field.actual),
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// firstEntityID возвращает меньший из ID на позиции index (сущность, появившаяся или пропавшая первой)
+func firstEntityID(expected, actual []EntityHash, index int) core.EntityID {
+	switch {
+	case index >= len(expected):
+		return actual[index].ID
+	case index >= len(actual):
+		return expected[index].ID
+	case expected[index].ID < actual[index].ID:
+		return expected[index].ID
+	default:
+		return actual[index].ID
+	}
+}
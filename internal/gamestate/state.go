@@ -4,20 +4,23 @@ package gamestate
 import (
 	"math/rand"
 
-	"github.com/aiseeq/savanna/internal/adapters"
 	"github.com/aiseeq/savanna/internal/core"
 	"github.com/aiseeq/savanna/internal/generator"
+	"github.com/aiseeq/savanna/internal/pipeline"
 	"github.com/aiseeq/savanna/internal/simulation"
+	"github.com/aiseeq/savanna/internal/snapshot"
 )
 
 // GameState представляет чистое состояние игры без зависимостей от рендеринга
 type GameState struct {
-	world         *core.World
-	systemManager *core.SystemManager
+	world    *core.World
+	pipeline *pipeline.Pipeline // Те же системы и анимации, что в GUI и headless прогонах
+	terrain  *generator.Terrain
 
 	// Управление временем
 	accumulator   float64
 	fixedTimeStep float64
+	tick          int // Количество выполненных фиксированных шагов
 
 	// Состояние камеры (логическое, не визуальное)
 	camera CameraState
@@ -40,6 +43,10 @@ type GameConfig struct {
 	WorldHeight   float32
 	FixedTimeStep float64
 	RandomSeed    int64
+
+	// Balance профиль баланса (nil - значения по умолчанию)
+	// Записывается в заголовок реплея - воспроизведение идёт с тем же балансом
+	Balance *simulation.BalanceProfile `json:",omitempty"`
 }
 
 // NewGameState создает новое состояние игры
@@ -47,15 +54,46 @@ func NewGameState(config *GameConfig) *GameState {
 	// Создаем мир с фиксированным размером
 	world := core.NewWorld(config.WorldWidth, config.WorldHeight, config.RandomSeed)
 
-	// Создаем менеджер систем
-	systemManager := core.NewSystemManager()
+	// Создаем terrain (используем простой terrain для демонстрации)
+	terrain := createSimpleTerrain(int(config.WorldWidth/32), int(config.WorldHeight/32))
+
+	gs := newGameState(world, terrain, config)
+
+	// Генерируем начальную популяцию (упрощенная версия для демонстрации)
+	createInitialPopulation(world, terrain, world.GetRNG(), config.Balance)
+
+	return gs
+}
+
+// NewGameStateFromSnapshot восстанавливает состояние игры из снапшота
+// Размеры мира и профиль баланса берутся из снапшота, шаг времени - из config
+func NewGameStateFromSnapshot(config *GameConfig, snap *snapshot.Snapshot) (*GameState, error) {
+	world, terrain, err := snap.Restore()
+	if err != nil {
+		return nil, err
+	}
 
-	// Инициализируем системы (в правильном порядке)
-	initializeSystems(systemManager, world, config)
+	restoredConfig := *config
+	restoredConfig.WorldWidth, restoredConfig.WorldHeight = world.GetWorldDimensions()
+	restoredConfig.Balance = snap.Balance
+
+	gs := newGameState(world, terrain, &restoredConfig)
+	if err := snap.RestoreSystems(gs.pipeline.SystemManager()); err != nil {
+		return nil, err
+	}
+
+	return gs, nil
+}
+
+// newGameState собирает GameState вокруг готового мира и ландшафта
+func newGameState(world *core.World, terrain *generator.Terrain, config *GameConfig) *GameState {
+	simPipeline := pipeline.New(terrain, config.WorldWidth, config.WorldHeight)
+	simPipeline.SetBalanceProfile(config.Balance)
 
 	return &GameState{
 		world:         world,
-		systemManager: systemManager,
+		pipeline:      simPipeline,
+		terrain:       terrain,
 		accumulator:   0,
 		fixedTimeStep: config.FixedTimeStep,
 		config:        config,
@@ -80,13 +118,10 @@ func (gs *GameState) Update() {
 
 	// Фиксированный шаг времени для детерминизма
 	for gs.accumulator >= gs.fixedTimeStep {
-		// Обновляем время в мире
-		gs.world.Update(float32(gs.fixedTimeStep))
-
-		// TODO: Анимации будут добавлены после решения ebiten зависимостей
-
-		gs.systemManager.Update(gs.world, float32(gs.fixedTimeStep))
+		// Время мира, анимации и системы обновляет конвейер
+		gs.pipeline.Update(gs.world, float32(gs.fixedTimeStep))
 		gs.accumulator -= gs.fixedTimeStep
+		gs.tick++
 	}
 }
 
@@ -127,52 +162,29 @@ func (gs *GameState) GetWorld() *core.World {
 	return gs.world
 }
 
-// initializeSystems инициализирует все игровые системы в правильном порядке
-func initializeSystems(systemManager *core.SystemManager, world *core.World, config *GameConfig) {
-	// Создаем terrain и vegetation (используем простой terrain для демонстрации)
-	terrain := createSimpleTerrain(int(config.WorldWidth/32), int(config.WorldHeight/32))
-	vegetationSystem := simulation.NewVegetationSystem(terrain)
-
-	// Добавляем системы в КРИТИЧЕСКОМ порядке (из CLAUDE.md)
-	systemManager.AddSystem(vegetationSystem)
-
-	satiationSystem := simulation.NewSatiationSystem()
-	systemManager.AddSystem(&adapters.SatiationSystemAdapter{System: satiationSystem})
-
-	grassSearchSystem := simulation.NewGrassSearchSystem(vegetationSystem)
-	systemManager.AddSystem(&adapters.GrassSearchSystemAdapter{System: grassSearchSystem})
-
-	grassEatingSystem := simulation.NewGrassEatingSystem(vegetationSystem)
-	systemManager.AddSystem(grassEatingSystem)
-
-	// ИСПРАВЛЕНИЕ: EatingSystem должна быть ПЕРЕД BehaviorSystem для поиска трупов
-	eatingSystem := simulation.NewEatingSystem()
-	systemManager.AddSystem(eatingSystem)
-
-	behaviorSystem := simulation.NewAnimalBehaviorSystem(vegetationSystem)
-	behaviorAdapter := &adapters.BehaviorSystemAdapter{System: behaviorSystem}
-	systemManager.AddSystem(behaviorAdapter)
-
-	satiationSpeedModifier := simulation.NewSatiationSpeedModifierSystem()
-	systemManager.AddSystem(&adapters.SatiationSpeedModifierSystemAdapter{System: satiationSpeedModifier})
-
-	movementSystem := simulation.NewMovementSystem(config.WorldWidth, config.WorldHeight)
-	systemManager.AddSystem(&adapters.MovementSystemAdapter{System: movementSystem})
-
-	combatSystem := simulation.NewCombatSystem()
-	systemManager.AddSystem(combatSystem)
+// GetSystemStats возвращает профили систем в порядке выполнения (для отладочного оверлея)
+func (gs *GameState) GetSystemStats() []core.SystemStats {
+	return gs.pipeline.SystemManager().Stats()
+}
 
-	damageSystem := simulation.NewDamageSystem()
-	systemManager.AddSystem(damageSystem)
+// SetSystemEnabled включает или выключает систему по имени (simulation.System*); false - системы нет
+func (gs *GameState) SetSystemEnabled(name string, enabled bool) bool {
+	return gs.pipeline.SystemManager().SetEnabled(name, enabled)
+}
 
-	starvationDamage := simulation.NewStarvationDamageSystem()
-	systemManager.AddSystem(&adapters.StarvationDamageSystemAdapter{System: starvationDamage})
+// GetTick возвращает количество выполненных фиксированных шагов
+func (gs *GameState) GetTick() int {
+	return gs.tick
+}
 
-	corpseSystem := simulation.NewCorpseSystem()
-	systemManager.AddSystem(corpseSystem)
+// GetTerrain возвращает ландшафт (трава изменяется системами)
+func (gs *GameState) GetTerrain() *generator.Terrain {
+	return gs.terrain
+}
 
-	// Генерируем начальную популяцию (упрощенная версия для демонстрации)
-	createInitialPopulation(world, terrain, world.GetRNG())
+// CreateSnapshot снимает полный снапшот симуляции
+func (gs *GameState) CreateSnapshot() (*snapshot.Snapshot, error) {
+	return snapshot.Capture(gs.world, gs.terrain, gs.pipeline.SystemManager(), gs.config.Balance)
 }
 
 // createSimpleTerrain создает простой terrain для демонстрации
@@ -196,6 +208,15 @@ func createSimpleTerrain(width, height int) *generator.Terrain {
 		}
 	}
 
+	// Водопой в центре карты - без воды животные погибнут от жажды
+	centerX, centerY := width/2, height/2
+	for y := centerY - 1; y <= centerY; y++ {
+		for x := centerX - 1; x <= centerX; x++ {
+			terrain.SetTileType(x, y, generator.TileWater)
+			terrain.SetGrassAmount(x, y, 0)
+		}
+	}
+
 	return terrain
 }
 
@@ -208,18 +229,25 @@ func max(a, b int) int {
 }
 
 // createInitialPopulation создает начальную популяцию животных
-func createInitialPopulation(world *core.World, terrain *generator.Terrain, rng *rand.Rand) {
+func createInitialPopulation(
+	world *core.World,
+	terrain *generator.Terrain,
+	rng *rand.Rand,
+	balance *simulation.BalanceProfile,
+) {
 	// Создаем нескольких зайцев
 	for i := 0; i < 5; i++ {
 		x := rng.Float32() * float32(terrain.Width*32)
 		y := rng.Float32() * float32(terrain.Height*32)
-		simulation.CreateAnimal(world, core.TypeRabbit, x, y)
+		rabbit := simulation.CreateAnimalWithBalance(world, balance, core.TypeRabbit, x, y)
+		simulation.RandomizeAdultAge(world, rabbit)
 	}
 
 	// Создаем нескольких волков
 	for i := 0; i < 2; i++ {
 		x := rng.Float32() * float32(terrain.Width*32)
 		y := rng.Float32() * float32(terrain.Height*32)
-		simulation.CreateAnimal(world, core.TypeWolf, x, y)
+		wolf := simulation.CreateAnimalWithBalance(world, balance, core.TypeWolf, x, y)
+		simulation.RandomizeAdultAge(world, wolf)
 	}
 }
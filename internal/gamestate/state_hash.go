@@ -0,0 +1,151 @@
+package gamestate
+
+import (
+	"hash"
+	"hash/fnv"
+	"math"
+
+	"github.com/aiseeq/savanna/internal/core"
+)
+
+// StateHash хэш состояния симуляции на одном тике
+// Общий хэш складывается из хэшей по категориям - по ним реплей определяет, ЧТО разошлось
+type StateHash struct {
+	Tick      int          `json:"tick"`
+	Hash      uint64       `json:"hash"`      // Общий хэш (по всем категориям)
+	Positions uint64       `json:"positions"` // Позиции всех сущностей
+	Health    uint64       `json:"health"`    // Здоровье всех сущностей
+	Satiation uint64       `json:"satiation"` // Сытость всех сущностей
+	Grass     uint64       `json:"grass"`     // Количество травы на всех тайлах
+	Camera    uint64       `json:"camera"`    // Состояние камеры (результат воспроизведения ввода)
+	Entities  []EntityHash `json:"entities,omitempty"`
+}
+
+// EntityHash покомпонентные хэши одной сущности
+// Позволяет указать конкретную сущность и компонент при расхождении
+type EntityHash struct {
+	ID        core.EntityID `json:"id"`
+	Position  uint32        `json:"position"`
+	Health    uint32        `json:"health"`
+	Satiation uint32        `json:"satiation"`
+}
+
+// hashWriter накапливает FNV-1a хэш из примитивных значений
+type hashWriter struct {
+	buffer [8]byte
+	sum64  hash.Hash64
+}
+
+func newHashWriter() *hashWriter {
+	return &hashWriter{sum64: fnv.New64a()}
+}
+
+func (hw *hashWriter) writeUint64(value uint64) {
+	for i := range hw.buffer {
+		hw.buffer[i] = byte(value >> (8 * i))
+	}
+	_, _ = hw.sum64.Write(hw.buffer[:])
+}
+
+func (hw *hashWriter) writeFloat32(value float32) {
+	hw.writeUint64(uint64(math.Float32bits(value)))
+}
+
+func (hw *hashWriter) writeFloat64(value float64) {
+	hw.writeUint64(math.Float64bits(value))
+}
+
+// hash32 сворачивает 64-битный хэш до 32 бит (для компактных покомпонентных хэшей)
+func (hw *hashWriter) hash32() uint32 {
+	sum := hw.sum64.Sum64()
+	return uint32(sum) ^ uint32(sum>>32)
+}
+
+// ComputeStateHash вычисляет хэш текущего состояния
+// withEntities добавляет покомпонентные хэши каждой сущности (дороже по памяти)
+func (gs *GameState) ComputeStateHash(withEntities bool) StateHash {
+	positions, health, satiation := newHashWriter(), newHashWriter(), newHashWriter()
+	var entities []EntityHash
+
+	// ВАЖНО: ForEachWith обходит сущности по возрастанию ID - порядок детерминирован
+	gs.world.ForEachWith(core.MaskPosition, func(entity core.EntityID) {
+		pos, _ := gs.world.GetPosition(entity)
+		positions.writeUint64(uint64(entity))
+		positions.writeFloat32(pos.X)
+		positions.writeFloat32(pos.Y)
+	})
+	gs.world.ForEachWith(core.MaskHealth, func(entity core.EntityID) {
+		value, _ := gs.world.GetHealth(entity)
+		health.writeUint64(uint64(entity))
+		health.writeUint64(uint64(uint16(value.Current))<<16 | uint64(uint16(value.Max)))
+	})
+	gs.world.ForEachWith(core.MaskSatiation, func(entity core.EntityID) {
+		value, _ := gs.world.GetSatiation(entity)
+		satiation.writeUint64(uint64(entity))
+		satiation.writeFloat32(value.Value)
+	})
+
+	if withEntities {
+		entities = gs.computeEntityHashes()
+	}
+
+	grass := newHashWriter()
+	for _, row := range gs.terrain.Grass {
+		for _, amount := range row {
+			grass.writeFloat32(amount)
+		}
+	}
+
+	camera := newHashWriter()
+	camera.writeFloat64(gs.camera.X)
+	camera.writeFloat64(gs.camera.Y)
+
+	result := StateHash{
+		Tick:      gs.tick,
+		Positions: positions.sum64.Sum64(),
+		Health:    health.sum64.Sum64(),
+		Satiation: satiation.sum64.Sum64(),
+		Grass:     grass.sum64.Sum64(),
+		Camera:    camera.sum64.Sum64(),
+		Entities:  entities,
+	}
+
+	total := newHashWriter()
+	for _, part := range []uint64{result.Positions, result.Health, result.Satiation, result.Grass, result.Camera} {
+		total.writeUint64(part)
+	}
+	result.Hash = total.sum64.Sum64()
+
+	return result
+}
+
+// computeEntityHashes вычисляет покомпонентные хэши всех живых сущностей
+func (gs *GameState) computeEntityHashes() []EntityHash {
+	var entities []EntityHash
+
+	// Трупы теряют часть компонентов - отсутствующий компонент хэшируется как нулевой
+	gs.world.ForEachWith(core.MaskPosition, func(entity core.EntityID) {
+		pos, _ := gs.world.GetPosition(entity)
+		health, _ := gs.world.GetHealth(entity)
+		satiation, _ := gs.world.GetSatiation(entity)
+
+		positionHash := newHashWriter()
+		positionHash.writeFloat32(pos.X)
+		positionHash.writeFloat32(pos.Y)
+
+		healthHash := newHashWriter()
+		healthHash.writeUint64(uint64(uint16(health.Current))<<16 | uint64(uint16(health.Max)))
+
+		satiationHash := newHashWriter()
+		satiationHash.writeFloat32(satiation.Value)
+
+		entities = append(entities, EntityHash{
+			ID:        entity,
+			Position:  positionHash.hash32(),
+			Health:    healthHash.hash32(),
+			Satiation: satiationHash.hash32(),
+		})
+	})
+
+	return entities
+}
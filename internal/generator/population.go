@@ -1,6 +1,7 @@
 package generator
 
 import (
+	"errors"
 	"fmt"
 	"math"
 	"math/rand"
@@ -23,6 +24,9 @@ const (
 	MaxGroupRadiusPixels = 2 * TileSizePixels // Радиус группы зайцев (2 тайла)
 )
 
+// ErrUnknownSpecies вид популяции не зарегистрирован (нет файла описания вида)
+var ErrUnknownSpecies = errors.New("unknown species")
+
 // PopulationGenerator генерирует размещение животных на карте
 type PopulationGenerator struct {
 	config  *config.Config
@@ -51,34 +55,51 @@ func NewPopulationGenerator(cfg *config.Config, terrain *Terrain) *PopulationGen
 }
 
 // Generate генерирует позиции для размещения животных согласно конфигурации
-func (pg *PopulationGenerator) Generate() []AnimalPlacement {
+// Все виды популяции должны быть зарегистрированы, иначе ErrUnknownSpecies без размещений
+func (pg *PopulationGenerator) Generate() ([]AnimalPlacement, error) {
+	population := pg.config.Population
+	speciesTypes := make([]core.AnimalType, len(population.Species))
+	for i, species := range population.Species {
+		animalType, known := core.AnimalTypeByName(species.Name)
+		if !known {
+			return nil, fmt.Errorf("%w %q in population", ErrUnknownSpecies, species.Name)
+		}
+		speciesTypes[i] = animalType
+	}
+
 	var placements []AnimalPlacement
 
 	// Размещаем зайцев группами
-	rabbitPlacements := pg.placeRabbits()
+	rabbitPlacements := pg.placeGroups(core.TypeRabbit, population.Rabbits, population.RabbitGroupSize)
 	placements = append(placements, rabbitPlacements...)
 
 	// Размещаем волков поодиночке
-	wolfPlacements := pg.placeWolves()
+	wolfPlacements := pg.placeSolitary(core.TypeWolf, population.Wolves, population.MinWolfDistance)
 	placements = append(placements, wolfPlacements...)
 
-	return placements
+	// Виды из файлов описаний: стадные группами, остальные поодиночке
+	for i, species := range population.Species {
+		if species.GroupSize > 1 {
+			placements = append(placements, pg.placeGroups(speciesTypes[i], species.Count, species.GroupSize)...)
+		} else {
+			placements = append(placements, pg.placeSolitary(speciesTypes[i], species.Count, species.MinDistance)...)
+		}
+	}
+
+	return placements, nil
 }
 
-// placeRabbits размещает зайцев группами по 2-4 особи
-func (pg *PopulationGenerator) placeRabbits() []AnimalPlacement {
+// placeGroups размещает животных группами (зайцы - по 2-4 особи)
+func (pg *PopulationGenerator) placeGroups(animalType core.AnimalType, total, groupSize int) []AnimalPlacement {
 	var placements []AnimalPlacement
+	placed := 0
 
-	totalRabbits := pg.config.Population.Rabbits
-	groupSize := pg.config.Population.RabbitGroupSize
-	placedRabbits := 0
-
-	for placedRabbits < totalRabbits {
+	for placed < total {
 		// Определяем размер текущей группы
-		remainingRabbits := totalRabbits - placedRabbits
+		remaining := total - placed
 		currentGroupSize := groupSize
-		if remainingRabbits < groupSize {
-			currentGroupSize = remainingRabbits
+		if remaining < groupSize {
+			currentGroupSize = remaining
 		}
 
 		// Находим место для группы
@@ -87,7 +108,7 @@ func (pg *PopulationGenerator) placeRabbits() []AnimalPlacement {
 			break // Не удалось найти место
 		}
 
-		// Размещаем зайцев в группе
+		// Размещаем животных в группе
 		for i := 0; i < currentGroupSize; i++ {
 			// Случайное смещение в радиусе группы от центра
 			angle := pg.rng.Float64() * 2 * math.Pi
@@ -106,43 +127,44 @@ func (pg *PopulationGenerator) placeRabbits() []AnimalPlacement {
 				y = groupCenterY
 			}
 
-			// Добавляем позицию зайца
+			// Добавляем позицию животного
 			placements = append(placements, AnimalPlacement{
-				Type: core.TypeRabbit,
+				Type: animalType,
 				X:    x,
 				Y:    y,
 			})
-			placedRabbits++
+			placed++
 		}
 	}
 
 	return placements
 }
 
-// placeWolves размещает волков поодиночке с минимальной дистанцией
-func (pg *PopulationGenerator) placeWolves() []AnimalPlacement {
+// placeSolitary размещает животных поодиночке с минимальной дистанцией (волки)
+func (pg *PopulationGenerator) placeSolitary(
+	animalType core.AnimalType, total, minDistanceTiles int,
+) []AnimalPlacement {
 	var placements []AnimalPlacement
-	var wolfPositions []struct{ x, y float32 }
+	var positions []struct{ x, y float32 }
 
-	totalWolves := pg.config.Population.Wolves
-	minDistance := float32(pg.config.Population.MinWolfDistance) * TileSizePixels // Конвертируем в пиксели
+	minDistance := float32(minDistanceTiles) * TileSizePixels // Конвертируем в пиксели
 
-	for placedWolves := 0; placedWolves < totalWolves; placedWolves++ {
-		// Находим место для волка с учётом минимальной дистанции
-		x, y, found := pg.findSuitableLocation(wolfPositions, minDistance)
+	for placed := 0; placed < total; placed++ {
+		// Находим место с учётом минимальной дистанции
+		x, y, found := pg.findSuitableLocation(positions, minDistance)
 		if !found {
 			break // Не удалось найти подходящее место
 		}
 
-		// Добавляем позицию волка
+		// Добавляем позицию животного
 		placements = append(placements, AnimalPlacement{
-			Type: core.TypeWolf,
+			Type: animalType,
 			X:    x,
 			Y:    y,
 		})
 
-		// Запоминаем позицию волка
-		wolfPositions = append(wolfPositions, struct{ x, y float32 }{x, y})
+		// Запоминаем позицию
+		positions = append(positions, struct{ x, y float32 }{x, y})
 	}
 
 	return placements
@@ -162,14 +184,6 @@ func (pg *PopulationGenerator) findSuitableLocation(
 		x := margin + pg.rng.Float32()*(worldWidthPixels-2*margin)
 		y := margin + pg.rng.Float32()*(worldHeightPixels-2*margin)
 
-		// DEBUG: Проверяем границы
-		if attempts == 0 {
-			fmt.Printf("DEBUG PopulationGenerator: terrain %dx%d, worldPixels %.1fx%.1f, margin %.1f\n",
-				pg.terrain.Width, pg.terrain.Height, worldWidthPixels, worldHeightPixels, margin)
-			fmt.Printf("DEBUG PopulationGenerator: x range [%.1f, %.1f], y range [%.1f, %.1f]\n",
-				margin, worldWidthPixels-margin, margin, worldHeightPixels-margin)
-		}
-
 		// Проверяем что тайл проходим
 		tileX := int(x / TileSizePixels)
 		tileY := int(y / TileSizePixels)
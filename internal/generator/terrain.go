@@ -44,6 +44,8 @@ type Terrain struct {
 	Size   int          // Размер мира в тайлах (для обратной совместимости - max(Width, Height))
 	Tiles  [][]TileType // Типы тайлов [y][x]
 	Grass  [][]float32  // Количество травы [y][x] (0-100)
+
+	revision uint64 // Счётчик изменений тайлов (кэши маршрутов сверяются с ним)
 }
 
 // TerrainGenerator генерирует детерминированные карты
@@ -297,12 +299,22 @@ func (t *Terrain) GetTileType(x, y int) TileType {
 	return t.Tiles[y][x]
 }
 
-// SetTileType устанавливает тип тайла в указанной позиции (для тестов)
+// SetTileType устанавливает тип тайла в указанной позиции
+// Каждое изменение увеличивает ревизию - навигация сбрасывает по ней кэш маршрутов
 func (t *Terrain) SetTileType(x, y int, tileType TileType) {
 	if x < 0 || x >= t.Width || y < 0 || y >= t.Height {
 		return // Игнорируем попытки изменить тайлы за границами
 	}
+	if t.Tiles[y][x] == tileType {
+		return
+	}
 	t.Tiles[y][x] = tileType
+	t.revision++
+}
+
+// Revision возвращает номер ревизии тайлов (растёт при каждом изменении типа тайла)
+func (t *Terrain) Revision() uint64 {
+	return t.revision
 }
 
 // GetGrassAmount возвращает количество травы в тайле
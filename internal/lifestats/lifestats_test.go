@@ -0,0 +1,129 @@
+package lifestats
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+
+	"github.com/aiseeq/savanna/internal/core"
+)
+
+// newAnimal создаёт животное с позицией и возрастом
+func newAnimal(world *core.World, animalType core.AnimalType, x float32) core.EntityID {
+	entity := world.CreateEntity()
+	world.AddAnimalType(entity, animalType)
+	world.AddPosition(entity, core.Position{X: x, Y: 0})
+	world.AddAge(entity, core.Age{Seconds: 10})
+	return entity
+}
+
+func TestTracker_RecordsLifeHistory(t *testing.T) {
+	world := core.NewWorld(640, 640, 1)
+	rabbit := newAnimal(world, core.TypeRabbit, 0)
+	wolf := newAnimal(world, core.TypeWolf, 100)
+	tracker := NewTracker(world)
+
+	// Тик: заяц пробежал 30 пикселей, убегая от волка, и получил удар
+	world.Update(0.5)
+	world.SetPosition(rabbit, core.Position{X: 30, Y: 0})
+	world.EmitEvent(core.Fleeing{Entity: rabbit, Predator: wolf})
+	world.EmitEvent(core.AttackLanded{Attacker: wolf, Target: rabbit, Damage: 25})
+	world.EmitEvent(core.EatingFinished{Eater: wolf, NutritionGained: 40})
+	world.DispatchEvents()
+	tracker.Observe()
+
+	// Детёныш и смерть зайца от волка
+	world.Update(0.5)
+	cub := newAnimal(world, core.TypeRabbit, 0)
+	world.EmitEvent(core.Birth{Child: cub, Mother: rabbit, Type: core.TypeRabbit})
+	world.EmitEvent(core.AnimalDied{Entity: rabbit, Type: core.TypeRabbit, Cause: core.DeathKilled, Killer: wolf})
+	world.DispatchEvents()
+	tracker.Observe()
+
+	lives := tracker.Lives()
+	if len(lives) != 3 {
+		t.Fatalf("Expected 3 lives, got %d", len(lives))
+	}
+
+	rabbitLife := lives[0]
+	if rabbitLife.Distance != 30 || rabbitLife.FleeTime != 0.5 || rabbitLife.DamageTaken != 25 {
+		t.Errorf("Unexpected rabbit history %+v", rabbitLife)
+	}
+	if !rabbitLife.Dead || rabbitLife.Cause != "killed" || rabbitLife.Killer != wolf || rabbitLife.KillerSpecies != "wolf" {
+		t.Errorf("Rabbit should be recorded as killed by the wolf, got %+v", rabbitLife)
+	}
+	if rabbitLife.AgeAtDeath != 10 || rabbitLife.DiedAt != world.GetTime() {
+		t.Errorf("Unexpected death time %+v", rabbitLife)
+	}
+	if wolfLife := lives[1]; wolfLife.Kills != 1 || wolfLife.FoodEaten != 40 || wolfLife.Dead {
+		t.Errorf("Unexpected wolf history %+v", wolfLife)
+	}
+	if cubLife := lives[2]; !cubLife.Newborn || cubLife.Mother != rabbit || cubLife.BornAt != world.GetTime() {
+		t.Errorf("Unexpected cub history %+v", cubLife)
+	}
+
+	// После отписки события не учитываются
+	tracker.Close()
+	world.EmitEvent(core.AnimalDied{Entity: wolf, Cause: core.DeathStarvation})
+	world.DispatchEvents()
+	if tracker.Lives()[1].Dead {
+		t.Error("Closed tracker should ignore events")
+	}
+}
+
+func TestSummarize_AggregatesBySpecies(t *testing.T) {
+	lives := []LifeHistory{
+		{Type: core.TypeRabbit, Dead: true, Cause: "killed", KillerSpecies: "wolf", AgeAtDeath: 20, Distance: 100},
+		{Type: core.TypeRabbit, Dead: true, Cause: "starvation", AgeAtDeath: 40, Distance: 300, Newborn: true},
+		{Type: core.TypeRabbit, FleeTime: 3},
+		{Type: core.TypeWolf, Kills: 1, FoodEaten: 50},
+	}
+
+	stats := Summarize(lives, []core.AnimalType{core.TypeRabbit, core.TypeWolf})
+	rabbits, wolves := stats[0], stats[1]
+
+	if rabbits.Species != "rabbit" || rabbits.Lives != 3 || rabbits.Births != 1 || rabbits.Alive != 1 {
+		t.Errorf("Unexpected rabbit counts %+v", rabbits)
+	}
+	if rabbits.Deaths["killed"] != 1 || rabbits.Deaths["starvation"] != 1 || rabbits.Deaths["old_age"] != 0 {
+		t.Errorf("Unexpected death causes %v", rabbits.Deaths)
+	}
+	if rabbits.KilledBy["wolf"] != 1 || rabbits.MeanAgeAtDeath != 30 || rabbits.MeanDistance != 400.0/3 || rabbits.MeanFleeTime != 1 {
+		t.Errorf("Unexpected rabbit averages %+v", rabbits)
+	}
+	if wolves.Kills != 1 || wolves.MeanFoodEaten != 50 || wolves.MeanAgeAtDeath != 0 {
+		t.Errorf("Unexpected wolf stats %+v", wolves)
+	}
+
+	// Без списка видов - порядок первого появления
+	if appeared := Summarize(lives[3:], nil); len(appeared) != 1 || appeared[0].Species != "wolf" {
+		t.Errorf("Expected only wolves, got %+v", appeared)
+	}
+}
+
+func TestWriteCSV_RowPerSpecies(t *testing.T) {
+	reports := []Report{
+		{Seed: 1, Species: Summarize([]LifeHistory{{Type: core.TypeRabbit, Dead: true, Cause: "old_age"}}, nil)},
+		{Seed: 2, Species: Summarize([]LifeHistory{{Type: core.TypeWolf}}, nil)},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteReports(&buf, FormatCSV, reports); err != nil {
+		t.Fatalf("WriteReports failed: %v", err)
+	}
+
+	rows, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("Invalid CSV: %v", err)
+	}
+	if len(rows) != 3 || rows[1][0] != "1" || rows[1][1] != "rabbit" || rows[2][1] != "wolf" {
+		t.Fatalf("Unexpected rows %v", rows)
+	}
+	if rows[0][8] != "deaths_old_age" || rows[1][8] != "1" {
+		t.Errorf("Old age death should be in its column, got %v / %v", rows[0], rows[1])
+	}
+
+	if err := WriteReports(&buf, "xml", reports); err == nil {
+		t.Error("Unknown format should be rejected")
+	}
+}
@@ -0,0 +1,200 @@
+package lifestats
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/aiseeq/savanna/internal/core"
+)
+
+// Форматы экспорта статистики жизней
+const (
+	FormatCSV  = "csv"
+	FormatJSON = "json"
+)
+
+// DeathCauses причины смерти в порядке колонок отчёта
+var DeathCauses = []core.DeathCause{core.DeathKilled, core.DeathStarvation, core.DeathDehydration, core.DeathOldAge}
+
+// SpeciesStats статистика жизней одного вида
+// Средние по пути, еде, урону и бегству - по всем жизням, средний возраст смерти - по умершим
+type SpeciesStats struct {
+	Species         string         `json:"species"`
+	Lives           int            `json:"lives"` // Начальная популяция и рождённые
+	Births          int            `json:"births"`
+	Alive           int            `json:"alive"`
+	Deaths          map[string]int `json:"deaths"`    // Смерти по причинам
+	KilledBy        map[string]int `json:"killed_by"` // Убитые по видам хищников
+	Kills           int            `json:"kills"`
+	MeanAgeAtDeath  float32        `json:"mean_age_at_death"`
+	MeanDistance    float32        `json:"mean_distance"`
+	MeanFoodEaten   float32        `json:"mean_food_eaten"`
+	MeanDamageTaken float32        `json:"mean_damage_taken"`
+	MeanFleeTime    float32        `json:"mean_flee_time"`
+}
+
+// Report статистика жизней одного прогона
+type Report struct {
+	Seed    int64          `json:"seed"`
+	Time    float32        `json:"time"` // Время симуляции на момент отчёта (секунды)
+	Species []SpeciesStats `json:"species"`
+	Lives   []LifeHistory  `json:"lives"`
+}
+
+// Report собирает отчёт по текущим историям
+func (t *Tracker) Report(seed int64, species []core.AnimalType) Report {
+	lives := t.Lives()
+	return Report{
+		Seed:    seed,
+		Time:    t.world.GetTime(),
+		Species: Summarize(lives, species),
+		Lives:   lives,
+	}
+}
+
+// Summarize сводит истории жизней в статистику по видам
+// species задаёт виды и их порядок (nil - виды в порядке первого появления)
+func Summarize(lives []LifeHistory, species []core.AnimalType) []SpeciesStats {
+	if species == nil {
+		seen := make(map[core.AnimalType]bool)
+		for _, life := range lives {
+			if !seen[life.Type] {
+				seen[life.Type] = true
+				species = append(species, life.Type)
+			}
+		}
+	}
+
+	stats := make([]SpeciesStats, len(species))
+	index := make(map[core.AnimalType]int, len(species))
+	for i, animalType := range species {
+		index[animalType] = i
+		stats[i] = SpeciesStats{
+			Species:  SpeciesName(animalType),
+			Deaths:   make(map[string]int, len(DeathCauses)),
+			KilledBy: make(map[string]int),
+		}
+		for _, cause := range DeathCauses {
+			stats[i].Deaths[cause.String()] = 0
+		}
+	}
+
+	deaths := make([]int, len(species))
+	for _, life := range lives {
+		i, reported := index[life.Type]
+		if !reported {
+			continue
+		}
+		s := &stats[i]
+
+		s.Lives++
+		s.Kills += life.Kills
+		s.MeanDistance += life.Distance
+		s.MeanFoodEaten += life.FoodEaten
+		s.MeanDamageTaken += float32(life.DamageTaken)
+		s.MeanFleeTime += life.FleeTime
+		if life.Newborn {
+			s.Births++
+		}
+
+		if !life.Dead {
+			s.Alive++
+			continue
+		}
+		deaths[i]++
+		s.Deaths[life.Cause]++
+		s.MeanAgeAtDeath += life.AgeAtDeath
+		if life.KillerSpecies != "" {
+			s.KilledBy[life.KillerSpecies]++
+		}
+	}
+
+	// Суммы превращаются в средние
+	for i := range stats {
+		s := &stats[i]
+		if s.Lives > 0 {
+			n := float32(s.Lives)
+			s.MeanDistance /= n
+			s.MeanFoodEaten /= n
+			s.MeanDamageTaken /= n
+			s.MeanFleeTime /= n
+		}
+		if deaths[i] > 0 {
+			s.MeanAgeAtDeath /= float32(deaths[i])
+		}
+	}
+
+	return stats
+}
+
+// WriteReports записывает отчёты прогонов в выбранном формате
+func WriteReports(w io.Writer, format string, reports []Report) error {
+	switch format {
+	case FormatCSV:
+		return WriteCSV(w, reports)
+	case FormatJSON:
+		return WriteJSON(w, reports)
+	default:
+		return fmt.Errorf("unknown format %q (expected %s or %s)", format, FormatCSV, FormatJSON)
+	}
+}
+
+// WriteCSV записывает статистику видов таблицей: строка на вид в каждом прогоне
+// Истории отдельных животных есть только в JSON
+func WriteCSV(w io.Writer, reports []Report) error {
+	writer := csv.NewWriter(w)
+
+	header := []string{"seed", "species", "lives", "births", "alive"}
+	for _, cause := range DeathCauses {
+		header = append(header, "deaths_"+cause.String())
+	}
+	header = append(header, "kills", "mean_age_at_death", "mean_distance", "mean_food_eaten",
+		"mean_damage_taken", "mean_flee_time")
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	for _, report := range reports {
+		for _, s := range report.Species {
+			row := []string{
+				strconv.FormatInt(report.Seed, 10),
+				s.Species,
+				strconv.Itoa(s.Lives),
+				strconv.Itoa(s.Births),
+				strconv.Itoa(s.Alive),
+			}
+			for _, cause := range DeathCauses {
+				row = append(row, strconv.Itoa(s.Deaths[cause.String()]))
+			}
+			row = append(row,
+				strconv.Itoa(s.Kills),
+				formatFloat(s.MeanAgeAtDeath),
+				formatFloat(s.MeanDistance),
+				formatFloat(s.MeanFoodEaten),
+				formatFloat(s.MeanDamageTaken),
+				formatFloat(s.MeanFleeTime),
+			)
+			if err := writer.Write(row); err != nil {
+				return err
+			}
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteJSON записывает отчёты прогонов массивом JSON (со всеми историями жизней)
+func WriteJSON(w io.Writer, reports []Report) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(reports)
+}
+
+// formatFloat форматирует среднее для CSV
+func formatFloat(value float32) string {
+	return strconv.FormatFloat(float64(value), 'f', 2, 32)
+}
@@ -0,0 +1,194 @@
+package lifestats
+
+import (
+	"strings"
+
+	"github.com/aiseeq/savanna/internal/core"
+)
+
+// LifeHistory история жизни одного животного
+// Смерть и её причина приходят событием AnimalDied, дистанция - сравнением позиций между тиками
+type LifeHistory struct {
+	Entity        core.EntityID   `json:"entity"`
+	Type          core.AnimalType `json:"-"`
+	Species       string          `json:"species"`
+	Newborn       bool            `json:"newborn"` // Родилось в ходе прогона (не начальная популяция)
+	Mother        core.EntityID   `json:"mother,omitempty"`
+	BornAt        float32         `json:"born_at"` // Время симуляции появления (секунды)
+	Dead          bool            `json:"dead"`
+	DiedAt        float32         `json:"died_at,omitempty"`
+	AgeAtDeath    float32         `json:"age_at_death,omitempty"` // Возраст на момент смерти (секунды)
+	Cause         string          `json:"cause,omitempty"`
+	Killer        core.EntityID   `json:"killer,omitempty"`
+	KillerSpecies string          `json:"killer_species,omitempty"`
+	Distance      float32         `json:"distance"`   // Пройденный путь (пиксели)
+	FoodEaten     float32         `json:"food_eaten"` // Съеденная питательность (трава и трупы)
+	Kills         int             `json:"kills"`
+	DamageTaken   int             `json:"damage_taken"` // Урон от атак хищников
+	FleeTime      float32         `json:"flee_time"`    // Время бегства от хищников (секунды)
+}
+
+// Tracker ведёт истории жизни животных мира по событиям симуляции (SRP: только учёт)
+// Observe вызывается после каждого тика: учитывает пройденный путь и время бегства
+type Tracker struct {
+	world *core.World
+
+	lives     map[core.EntityID]*LifeHistory
+	order     []core.EntityID // Порядок появления животных (стабильный вывод)
+	positions map[core.EntityID]core.Position
+	fleeing   map[core.EntityID]bool // Убегавшие в текущем тике
+	lastTime  float32
+
+	unsubscribe []func()
+}
+
+// NewTracker создаёт учёт жизней и подписывается на события мира
+// Уже живущие животные считаются начальной популяцией
+func NewTracker(world *core.World) *Tracker {
+	t := &Tracker{
+		world:     world,
+		lives:     make(map[core.EntityID]*LifeHistory),
+		positions: make(map[core.EntityID]core.Position),
+		fleeing:   make(map[core.EntityID]bool),
+		lastTime:  world.GetTime(),
+	}
+
+	bus := world.Events()
+	t.unsubscribe = []func(){
+		core.Subscribe(bus, t.onBirth),
+		core.Subscribe(bus, t.onAttack),
+		core.Subscribe(bus, t.onDeath),
+		core.Subscribe(bus, t.onEatingFinished),
+		core.Subscribe(bus, t.onFleeing),
+	}
+
+	t.Observe()
+	return t
+}
+
+// Close отписывается от событий мира (накопленные истории сохраняются)
+func (t *Tracker) Close() {
+	for _, unsubscribe := range t.unsubscribe {
+		unsubscribe()
+	}
+	t.unsubscribe = nil
+}
+
+// Observe учитывает тик симуляции: время бегства и пройденный путь живых животных
+func (t *Tracker) Observe() {
+	now := t.world.GetTime()
+	elapsed := now - t.lastTime
+	t.lastTime = now
+
+	for entity := range t.fleeing {
+		if life := t.lives[entity]; life != nil {
+			life.FleeTime += elapsed
+		}
+		delete(t.fleeing, entity)
+	}
+
+	t.world.ForEachWith(core.MaskAnimalType|core.MaskPosition, func(entity core.EntityID) {
+		if t.world.HasComponent(entity, core.MaskCorpse) {
+			return
+		}
+		life := t.life(entity)
+		if life == nil || life.Dead {
+			return
+		}
+
+		pos, _ := t.world.GetPosition(entity)
+		if previous, known := t.positions[entity]; known {
+			life.Distance += pos.DistanceTo(previous)
+		}
+		t.positions[entity] = pos
+	})
+}
+
+// Lives возвращает копии историй в порядке появления животных
+func (t *Tracker) Lives() []LifeHistory {
+	lives := make([]LifeHistory, 0, len(t.order))
+	for _, entity := range t.order {
+		lives = append(lives, *t.lives[entity])
+	}
+	return lives
+}
+
+// life возвращает историю животного, заводя её при первом появлении (nil - не животное)
+func (t *Tracker) life(entity core.EntityID) *LifeHistory {
+	if life, exists := t.lives[entity]; exists {
+		return life
+	}
+
+	animalType, isAnimal := t.world.GetAnimalType(entity)
+	if !isAnimal {
+		return nil
+	}
+
+	life := &LifeHistory{
+		Entity:  entity,
+		Type:    animalType,
+		Species: SpeciesName(animalType),
+		BornAt:  t.world.GetTime(),
+	}
+	t.lives[entity] = life
+	t.order = append(t.order, entity)
+	return life
+}
+
+// onBirth отмечает рождённого детёныша
+func (t *Tracker) onBirth(event core.Birth) {
+	if life := t.life(event.Child); life != nil {
+		life.Newborn = true
+		life.Mother = event.Mother
+	}
+}
+
+// onAttack учитывает урон от атаки
+func (t *Tracker) onAttack(event core.AttackLanded) {
+	if life := t.life(event.Target); life != nil {
+		life.DamageTaken += int(event.Damage)
+	}
+}
+
+// onDeath записывает причину смерти и засчитывает убийство хищнику
+// Событие доставляется до удаления животного - возраст ещё доступен
+func (t *Tracker) onDeath(event core.AnimalDied) {
+	life := t.life(event.Entity)
+	if life == nil {
+		return
+	}
+
+	life.Dead = true
+	life.DiedAt = t.world.GetTime()
+	life.Cause = event.Cause.String()
+	if age, hasAge := t.world.GetAge(event.Entity); hasAge {
+		life.AgeAtDeath = age.Seconds
+	}
+	delete(t.positions, event.Entity)
+
+	if event.Cause != core.DeathKilled {
+		return
+	}
+	life.Killer = event.Killer
+	if killer := t.life(event.Killer); killer != nil {
+		killer.Kills++
+		life.KillerSpecies = killer.Species
+	}
+}
+
+// onEatingFinished добавляет питательность завершённой трапезы
+func (t *Tracker) onEatingFinished(event core.EatingFinished) {
+	if life := t.life(event.Eater); life != nil {
+		life.FoodEaten += event.NutritionGained
+	}
+}
+
+// onFleeing запоминает бегство в текущем тике (время добавляет Observe)
+func (t *Tracker) onFleeing(event core.Fleeing) {
+	t.fleeing[event.Entity] = true
+}
+
+// SpeciesName имя вида в отчётах (нижний регистр, как в файлах видов)
+func SpeciesName(animalType core.AnimalType) string {
+	return strings.ToLower(animalType.String())
+}
@@ -0,0 +1,137 @@
+package navigation
+
+import (
+	"math"
+
+	"github.com/aiseeq/savanna/internal/constants"
+	"github.com/aiseeq/savanna/internal/vec2"
+)
+
+// Стоимость шагов по сетке тайлов
+const (
+	straightStepCost = 1.0
+	diagonalStepCost = math.Sqrt2
+)
+
+// neighbourOffsets соседи тайла в фиксированном порядке (детерминированный выбор при равной стоимости)
+var neighbourOffsets = [8][2]int{
+	{1, 0}, {0, 1}, {-1, 0}, {0, -1},
+	{1, 1}, {-1, 1}, {-1, -1}, {1, -1},
+}
+
+// grid снимок проходимости тайлов (строится заново при изменении ревизии ландшафта)
+type grid struct {
+	size     int
+	passable []bool // [y*size+x]
+}
+
+// newGrid снимает проходимость всех тайлов ландшафта
+func newGrid(terrain Terrain) *grid {
+	size := terrain.GetSize()
+	g := &grid{size: size, passable: make([]bool, size*size)}
+
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			g.passable[y*size+x] = terrain.IsPassable(x, y)
+		}
+	}
+
+	return g
+}
+
+// index индекс тайла в плоском массиве
+func (g *grid) index(x, y int) int {
+	return y*g.size + x
+}
+
+// coords координаты тайла по индексу
+func (g *grid) coords(index int) (x, y int) {
+	return index % g.size, index / g.size
+}
+
+// isPassable проверяет проходимость тайла (за границами - непроходимо)
+func (g *grid) isPassable(x, y int) bool {
+	if x < 0 || x >= g.size || y < 0 || y >= g.size {
+		return false
+	}
+	return g.passable[g.index(x, y)]
+}
+
+// canStep проверяет шаг на соседний тайл
+// По диагонали нельзя срезать угол непроходимого тайла - круг животного упрётся в него
+func (g *grid) canStep(x, y, dx, dy int) bool {
+	if !g.isPassable(x+dx, y+dy) {
+		return false
+	}
+	if dx != 0 && dy != 0 {
+		return g.isPassable(x+dx, y) && g.isPassable(x, y+dy)
+	}
+	return true
+}
+
+// stepCost стоимость шага на соседний тайл
+func stepCost(dx, dy int) float32 {
+	if dx != 0 && dy != 0 {
+		return diagonalStepCost
+	}
+	return straightStepCost
+}
+
+// lineOfSight проверяет что отрезок (в тайлах) проходит только по проходимым тайлам
+// Обход тайлов вдоль отрезка (Amanatides-Woo); в точном углу проверяются оба соседа
+func (g *grid) lineOfSight(from, to vec2.Vec2) bool {
+	x, y := constants.TileIndex(from.X), constants.TileIndex(from.Y)
+	endX, endY := constants.TileIndex(to.X), constants.TileIndex(to.Y)
+
+	stepX, tMaxX, tDeltaX := traversalAxis(float64(from.X), float64(to.X))
+	stepY, tMaxY, tDeltaY := traversalAxis(float64(from.Y), float64(to.Y))
+
+	// Число пересекаемых границ известно заранее - цикл всегда конечен
+	for steps := abs(endX-x) + abs(endY-y); ; steps-- {
+		if !g.isPassable(x, y) {
+			return false
+		}
+		if steps <= 0 {
+			return true
+		}
+
+		switch {
+		case tMaxX < tMaxY:
+			x += stepX
+			tMaxX += tDeltaX
+		case tMaxY < tMaxX:
+			y += stepY
+			tMaxY += tDeltaY
+		default:
+			if !g.isPassable(x+stepX, y) || !g.isPassable(x, y+stepY) {
+				return false
+			}
+			x += stepX
+			y += stepY
+			tMaxX += tDeltaX
+			tMaxY += tDeltaY
+			steps--
+		}
+	}
+}
+
+// traversalAxis параметры обхода по одной оси: шаг, доля отрезка до первой границы и между границами
+func traversalAxis(from, to float64) (step int, tMax, tDelta float64) {
+	delta := to - from
+	switch {
+	case delta > 0:
+		return 1, (math.Floor(from) + 1 - from) / delta, 1 / delta
+	case delta < 0:
+		return -1, (from - math.Floor(from)) / -delta, 1 / -delta
+	default:
+		return 0, math.Inf(1), math.Inf(1)
+	}
+}
+
+// abs возвращает абсолютное значение integer
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,233 @@
+package navigation
+
+import (
+	"math"
+
+	"github.com/aiseeq/savanna/internal/constants"
+	"github.com/aiseeq/savanna/internal/core"
+	"github.com/aiseeq/savanna/internal/vec2"
+)
+
+// Параметры навигации
+const (
+	DefaultPathBudget = 64   // Поисков пути за тик по умолчанию
+	MaxSearchNodes    = 4096 // Предел раскрытых узлов A* - дальше цель считается недостижимой
+	MaxCachedPaths    = 4096 // При переполнении кэш путей сбрасывается целиком
+	WaypointLookahead = 8    // На сколько тайлов пути вперёд ищется прямая видимость (сглаживание)
+)
+
+// Terrain ландшафт для навигации (generator.Terrain)
+type Terrain interface {
+	IsPassable(x, y int) bool
+	GetSize() int
+	Revision() uint64 // Растёт при изменении тайлов - по ней сбрасываются кэши
+}
+
+// Stats счётчики запросов навигации (для профилирования и тестов)
+type Stats struct {
+	Searches  int // Выполнено поисков A*
+	CacheHits int // Пути взяты из кэша
+	Deferred  int // Запросы сверх бюджета тика (животное шло напрямую)
+}
+
+// pathKey ключ кэша путей: тайл старта и тайл цели
+type pathKey struct {
+	start, goal int32
+}
+
+// flowField поле потока к общей цели (расстояния строятся лениво после каждой смены ревизии)
+type flowField struct {
+	isGoal   func(tileX, tileY int) bool
+	distance []float32
+}
+
+// Navigator прокладывает маршруты по тайлам в обход воды и кустов (реализует core.NavigationProvider)
+// Поиск A* ограничен бюджетом на тик: при сотнях животных тик остаётся дешёвым.
+// Бюджет расходуют и попадания в кэш, поэтому результат запроса не зависит от содержимого кэша -
+// прогоны с одним seed совпадают и после загрузки снапшота, где кэш пуст
+type Navigator struct {
+	terrain    Terrain
+	grid       *grid
+	revision   uint64
+	search     searchState
+	paths      map[pathKey][]int
+	flowFields map[string]*flowField
+	pathBudget int
+	pathsUsed  int
+	stats      Stats
+}
+
+// NewNavigator создаёт навигацию по ландшафту
+func NewNavigator(terrain Terrain) *Navigator {
+	return &Navigator{
+		terrain:    terrain,
+		grid:       newGrid(terrain),
+		revision:   terrain.Revision(),
+		paths:      make(map[pathKey][]int),
+		flowFields: make(map[string]*flowField),
+		pathBudget: DefaultPathBudget,
+	}
+}
+
+// SetPathBudget устанавливает число поисков пути за тик (0 - только прямая видимость и поля потока)
+func (n *Navigator) SetPathBudget(budget int) {
+	n.pathBudget = max(budget, 0)
+}
+
+// AddFlowField регистрирует поле потока к тайлам, для которых isGoal возвращает true
+// Цели пересчитываются вместе с полем после изменения тайлов
+func (n *Navigator) AddFlowField(name string, isGoal func(tileX, tileY int) bool) {
+	n.flowFields[name] = &flowField{isGoal: isGoal}
+}
+
+// Stats возвращает накопленные счётчики запросов
+func (n *Navigator) Stats() Stats {
+	return n.stats
+}
+
+// Update восстанавливает бюджет поиска к следующему тику (реализует core.System)
+func (n *Navigator) Update(_ *core.World, _ float32) {
+	n.pathsUsed = 0
+}
+
+// Invalidate сбрасывает кэш путей и поля потока (вызывается автоматически при смене ревизии ландшафта)
+// Сбрасываются все пути: открывшийся проход может сократить и те, что не проходят через изменённый тайл
+func (n *Navigator) Invalidate() {
+	n.grid = newGrid(n.terrain)
+	n.revision = n.terrain.Revision()
+	n.paths = make(map[pathKey][]int)
+	for _, field := range n.flowFields {
+		field.distance = nil
+	}
+}
+
+// NextWaypoint возвращает точку, к которой двигаться по пути от from к to (пиксели)
+// При прямой видимости возвращает саму цель без поиска
+func (n *Navigator) NextWaypoint(fromX, fromY, toX, toY float32) (x, y float32, found bool) {
+	n.refresh()
+
+	from := tilePoint(fromX, fromY)
+	to := tilePoint(toX, toY)
+	if n.grid.lineOfSight(from, to) {
+		return toX, toY, true
+	}
+
+	start, okStart := n.tileIndex(from)
+	goal, okGoal := n.tileIndex(to)
+	if !okStart || !okGoal {
+		return 0, 0, false
+	}
+
+	if n.pathsUsed >= n.pathBudget {
+		n.stats.Deferred++
+		return 0, 0, false
+	}
+	n.pathsUsed++
+
+	path := n.cachedPath(start, goal)
+	if path == nil {
+		return 0, 0, false
+	}
+
+	next := path[n.farthestVisible(from, path)]
+	if next == goal {
+		return toX, toY, true
+	}
+	x, y = n.tileCenter(next)
+	return x, y, true
+}
+
+// FlowWaypoint возвращает следующую точку по полю потока к ближайшей цели поля (пиксели)
+// found=false: поле не зарегистрировано, цель недостижима или животное уже на цели
+func (n *Navigator) FlowWaypoint(field string, fromX, fromY float32) (x, y float32, found bool) {
+	n.refresh()
+
+	flow, ok := n.flowFields[field]
+	if !ok {
+		return 0, 0, false
+	}
+	if flow.distance == nil {
+		flow.distance = n.grid.buildFlowField(flow.isGoal)
+	}
+
+	from := tilePoint(fromX, fromY)
+	start, ok := n.tileIndex(from)
+	if !ok || flow.distance[start] == 0 || math.IsInf(float64(flow.distance[start]), 1) {
+		return 0, 0, false
+	}
+
+	// Спуск по полю на WaypointLookahead тайлов, затем сглаживание как у путей A*
+	path := []int{start}
+	for current := start; len(path) <= WaypointLookahead && flow.distance[current] > 0; {
+		current = n.grid.descend(flow.distance, current)
+		path = append(path, current)
+	}
+
+	x, y = n.tileCenter(path[n.farthestVisible(from, path)])
+	return x, y, true
+}
+
+// refresh сбрасывает кэши если ландшафт изменился с момента последнего запроса
+func (n *Navigator) refresh() {
+	if n.terrain.Revision() != n.revision {
+		n.Invalidate()
+	}
+}
+
+// cachedPath возвращает путь из кэша или ищет его (ненайденные пути тоже кэшируются)
+func (n *Navigator) cachedPath(start, goal int) []int {
+	key := pathKey{start: int32(start), goal: int32(goal)}
+	if path, ok := n.paths[key]; ok {
+		n.stats.CacheHits++
+		return path
+	}
+
+	n.stats.Searches++
+	path := n.grid.findPath(&n.search, start, goal)
+
+	if len(n.paths) >= MaxCachedPaths {
+		n.paths = make(map[pathKey][]int)
+	}
+	n.paths[key] = path
+	return path
+}
+
+// farthestVisible находит самый дальний тайл пути в пределах WaypointLookahead, видимый напрямую
+// Так животное срезает ступеньки пути по сетке, но не угол воды
+func (n *Navigator) farthestVisible(from vec2.Vec2, path []int) int {
+	last := min(len(path)-1, WaypointLookahead)
+	for i := last; i > 1; i-- {
+		x, y := n.grid.coords(path[i])
+		if n.grid.lineOfSight(from, vec2.New(float32(x)+0.5, float32(y)+0.5)) {
+			return i
+		}
+	}
+	return min(1, last)
+}
+
+// tileIndex индекс проходимого тайла, содержащего точку (тайлы)
+func (n *Navigator) tileIndex(point vec2.Vec2) (int, bool) {
+	x, y := constants.TileIndex(point.X), constants.TileIndex(point.Y)
+	if !n.grid.isPassable(x, y) {
+		return 0, false
+	}
+	return n.grid.index(x, y), true
+}
+
+// tileCenter центр тайла в пикселях
+func (n *Navigator) tileCenter(index int) (x, y float32) {
+	tileX, tileY := n.grid.coords(index)
+	return constants.TilesToPixels(float32(tileX) + 0.5), constants.TilesToPixels(float32(tileY) + 0.5)
+}
+
+// tilePoint переводит пиксели в тайлы
+func tilePoint(x, y float32) vec2.Vec2 {
+	tileX, tileY := constants.PositionToTiles(x, y)
+	return vec2.New(tileX, tileY)
+}
+
+// Проверяем что Navigator реализует интерфейсы
+var (
+	_ core.NavigationProvider = (*Navigator)(nil)
+	_ core.System             = (*Navigator)(nil)
+)
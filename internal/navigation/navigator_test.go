@@ -0,0 +1,127 @@
+package navigation
+
+import (
+	"testing"
+
+	"github.com/aiseeq/savanna/internal/constants"
+	"github.com/aiseeq/savanna/internal/generator"
+)
+
+// newWallTerrain создаёт травяную карту со стеной воды в столбце wallX с проходом в строке gapY
+func newWallTerrain(size, wallX, gapY int) *generator.Terrain {
+	terrain := &generator.Terrain{
+		Width:  size,
+		Height: size,
+		Size:   size,
+		Tiles:  make([][]generator.TileType, size),
+		Grass:  make([][]float32, size),
+	}
+	for y := 0; y < size; y++ {
+		terrain.Tiles[y] = make([]generator.TileType, size)
+		terrain.Grass[y] = make([]float32, size)
+		if y != gapY {
+			terrain.Tiles[y][wallX] = generator.TileWater
+		}
+	}
+	return terrain
+}
+
+// pixels центр тайла в пикселях
+func pixels(tileX, tileY int) (x, y float32) {
+	return constants.TilesToPixels(float32(tileX) + 0.5), constants.TilesToPixels(float32(tileY) + 0.5)
+}
+
+func TestNavigator_PathAroundWall(t *testing.T) {
+	terrain := newWallTerrain(20, 10, 15)
+	navigator := NewNavigator(terrain)
+
+	fromX, fromY := pixels(5, 5)
+	toX, toY := pixels(15, 5)
+
+	path := navigator.grid.findPath(&navigator.search, navigator.grid.index(5, 5), navigator.grid.index(15, 5))
+	if path == nil {
+		t.Fatal("Path through the gap should exist")
+	}
+	for _, index := range path {
+		if x, y := navigator.grid.coords(index); !terrain.IsPassable(x, y) {
+			t.Fatalf("Path goes through impassable tile (%d, %d)", x, y)
+		}
+	}
+
+	x, y, found := navigator.NextWaypoint(fromX, fromY, toX, toY)
+	if !found {
+		t.Fatal("Waypoint should be found")
+	}
+	if y <= fromY || x >= constants.TilesToPixels(10) {
+		t.Errorf("Waypoint (%.0f, %.0f) should lead down towards the gap, not into the wall", x, y)
+	}
+
+	// Прямая видимость не тратит бюджет и возвращает саму цель
+	clearX, clearY := pixels(5, 15)
+	if x, y, _ := navigator.NextWaypoint(fromX, fromY, clearX, clearY); x != clearX || y != clearY {
+		t.Errorf("Visible target should be returned directly, got (%.0f, %.0f)", x, y)
+	}
+	if stats := navigator.Stats(); stats.Searches != 1 {
+		t.Errorf("Expected one A* search, got %+v", stats)
+	}
+}
+
+func TestNavigator_BudgetAndCache(t *testing.T) {
+	navigator := NewNavigator(newWallTerrain(20, 10, 15))
+	navigator.SetPathBudget(2)
+
+	fromX, fromY := pixels(5, 5)
+	toX, toY := pixels(15, 5)
+
+	for i := 0; i < 3; i++ {
+		navigator.NextWaypoint(fromX, fromY, toX, toY)
+	}
+	stats := navigator.Stats()
+	if stats.Searches != 1 || stats.CacheHits != 1 || stats.Deferred != 1 {
+		t.Errorf("Expected 1 search, 1 cache hit and 1 deferred query, got %+v", stats)
+	}
+
+	// Новый тик восстанавливает бюджет
+	navigator.Update(nil, 0)
+	if _, _, found := navigator.NextWaypoint(fromX, fromY, toX, toY); !found {
+		t.Error("Budget should be restored on the next tick")
+	}
+}
+
+func TestNavigator_InvalidatedOnTileChange(t *testing.T) {
+	terrain := newWallTerrain(20, 10, 15)
+	navigator := NewNavigator(terrain)
+
+	fromX, fromY := pixels(5, 5)
+	toX, toY := pixels(15, 5)
+	navigator.NextWaypoint(fromX, fromY, toX, toY)
+
+	// Закрываем единственный проход - кэшированный путь больше не годится
+	terrain.SetTileType(10, 15, generator.TileBush)
+	if _, _, found := navigator.NextWaypoint(fromX, fromY, toX, toY); found {
+		t.Error("Target behind a closed wall should be unreachable")
+	}
+	if stats := navigator.Stats(); stats.Searches != 2 {
+		t.Errorf("Path should be searched again after the tile change, got %+v", stats)
+	}
+}
+
+func TestNavigator_FlowField(t *testing.T) {
+	terrain := newWallTerrain(20, 10, 15)
+	navigator := NewNavigator(terrain)
+	navigator.AddFlowField("east", func(tileX, _ int) bool { return tileX == 19 })
+
+	fromX, fromY := pixels(5, 5)
+	x, y, found := navigator.FlowWaypoint("east", fromX, fromY)
+	if !found || y <= fromY {
+		t.Errorf("Flow should lead towards the gap, got (%.0f, %.0f) found=%v", x, y, found)
+	}
+
+	goalX, goalY := pixels(19, 5)
+	if _, _, found := navigator.FlowWaypoint("east", goalX, goalY); found {
+		t.Error("Animal standing on the goal should get no waypoint")
+	}
+	if _, _, found := navigator.FlowWaypoint("missing", fromX, fromY); found {
+		t.Error("Unknown flow field should return nothing")
+	}
+}
@@ -0,0 +1,206 @@
+package navigation
+
+import (
+	"container/heap"
+	"math"
+)
+
+// openNode узел открытого списка A* и Дейкстры
+type openNode struct {
+	index int
+	cost  float32 // f = g + h для A*, расстояние для поля потока
+	g     float32
+}
+
+// openSet очередь с приоритетом по стоимости
+// При равной стоимости раньше раскрывается узел ближе к цели (больший g), затем меньший индекс -
+// порядок не зависит от карты памяти, поэтому пути детерминированы
+type openSet []openNode
+
+func (s openSet) Len() int { return len(s) }
+
+func (s openSet) Less(i, j int) bool {
+	if s[i].cost != s[j].cost {
+		return s[i].cost < s[j].cost
+	}
+	if s[i].g != s[j].g {
+		return s[i].g > s[j].g
+	}
+	return s[i].index < s[j].index
+}
+
+func (s openSet) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+
+func (s *openSet) Push(x any) { *s = append(*s, x.(openNode)) }
+
+func (s *openSet) Pop() any {
+	old := *s
+	last := old[len(old)-1]
+	*s = old[:len(old)-1]
+	return last
+}
+
+// searchState рабочие массивы A*, переиспользуемые между поисками
+// Вместо очистки массивов каждый поиск получает новый номер: устаревшие значения отличаются отметкой
+type searchState struct {
+	generation uint32
+	visited    []uint32 // Номер поиска, в котором узел получил g
+	closed     []uint32 // Номер поиска, в котором узел раскрыт
+	g          []float32
+	parent     []int32
+	open       openSet
+}
+
+// reset готовит состояние к новому поиску на сетке заданного размера
+func (s *searchState) reset(cells int) {
+	if len(s.visited) != cells {
+		s.visited = make([]uint32, cells)
+		s.closed = make([]uint32, cells)
+		s.g = make([]float32, cells)
+		s.parent = make([]int32, cells)
+		s.generation = 0
+	}
+
+	s.generation++
+	if s.generation == 0 { // Переполнение счётчика: сбрасываем отметки
+		clear(s.visited)
+		clear(s.closed)
+		s.generation = 1
+	}
+	s.open = s.open[:0]
+}
+
+// findPath ищет путь A* между тайлами (8 направлений, без срезания углов)
+// Возвращает индексы тайлов от start до goal включительно или nil, если пути нет
+// или поиск раскрыл больше MaxSearchNodes узлов
+func (g *grid) findPath(state *searchState, start, goal int) []int {
+	state.reset(len(g.passable))
+	generation := state.generation
+	goalX, goalY := g.coords(goal)
+
+	state.visited[start] = generation
+	state.g[start] = 0
+	state.parent[start] = -1
+	heap.Push(&state.open, openNode{index: start, cost: g.heuristic(start, goalX, goalY)})
+
+	for expanded := 0; state.open.Len() > 0 && expanded < MaxSearchNodes; {
+		current := heap.Pop(&state.open).(openNode)
+		if state.closed[current.index] == generation {
+			continue // Устаревшая запись: узел уже раскрыт с меньшей стоимостью
+		}
+		if current.index == goal {
+			return state.reconstruct(goal)
+		}
+
+		state.closed[current.index] = generation
+		expanded++
+
+		x, y := g.coords(current.index)
+		for _, offset := range neighbourOffsets {
+			if !g.canStep(x, y, offset[0], offset[1]) {
+				continue
+			}
+
+			next := g.index(x+offset[0], y+offset[1])
+			if state.closed[next] == generation {
+				continue
+			}
+
+			tentative := state.g[current.index] + stepCost(offset[0], offset[1])
+			if state.visited[next] == generation && tentative >= state.g[next] {
+				continue
+			}
+
+			state.visited[next] = generation
+			state.g[next] = tentative
+			state.parent[next] = int32(current.index)
+			heap.Push(&state.open, openNode{index: next, cost: tentative + g.heuristic(next, goalX, goalY), g: tentative})
+		}
+	}
+
+	return nil
+}
+
+// heuristic октильное расстояние до цели (допустимо для 8 направлений)
+func (g *grid) heuristic(index, goalX, goalY int) float32 {
+	x, y := g.coords(index)
+	dx, dy := abs(x-goalX), abs(y-goalY)
+	return float32(straightStepCost*float64(max(dx, dy)) + (diagonalStepCost-straightStepCost)*float64(min(dx, dy)))
+}
+
+// reconstruct восстанавливает путь по ссылкам на родителей
+func (s *searchState) reconstruct(goal int) []int {
+	length := 0
+	for node := int32(goal); node >= 0; node = s.parent[node] {
+		length++
+	}
+
+	path := make([]int, length)
+	for node, i := int32(goal), length-1; node >= 0; node, i = s.parent[node], i-1 {
+		path[i] = int(node)
+	}
+	return path
+}
+
+// buildFlowField считает расстояние по проходимым тайлам до ближайшей цели (Дейкстра от всех целей)
+// Недостижимые тайлы получают +Inf
+func (g *grid) buildFlowField(isGoal func(tileX, tileY int) bool) []float32 {
+	distance := make([]float32, len(g.passable))
+	var open openSet
+
+	for index := range distance {
+		distance[index] = float32(math.Inf(1))
+		x, y := g.coords(index)
+		if g.passable[index] && isGoal(x, y) {
+			distance[index] = 0
+			open = append(open, openNode{index: index})
+		}
+	}
+	heap.Init(&open)
+
+	for open.Len() > 0 {
+		current := heap.Pop(&open).(openNode)
+		if current.cost > distance[current.index] {
+			continue
+		}
+
+		x, y := g.coords(current.index)
+		for _, offset := range neighbourOffsets {
+			// Шаг к цели из соседа симметричен шагу от цели - проверка углов та же
+			if !g.canStep(x, y, offset[0], offset[1]) {
+				continue
+			}
+
+			next := g.index(x+offset[0], y+offset[1])
+			candidate := current.cost + stepCost(offset[0], offset[1])
+			if candidate < distance[next] {
+				distance[next] = candidate
+				heap.Push(&open, openNode{index: next, cost: candidate})
+			}
+		}
+	}
+
+	return distance
+}
+
+// descend выбирает соседний тайл, ведущий к цели поля по кратчайшему пути
+func (g *grid) descend(distance []float32, current int) int {
+	x, y := g.coords(current)
+	best, bestCost := current, float32(math.Inf(1))
+
+	for _, offset := range neighbourOffsets {
+		if !g.canStep(x, y, offset[0], offset[1]) {
+			continue
+		}
+
+		next := g.index(x+offset[0], y+offset[1])
+		if distance[next] >= distance[current] {
+			continue
+		}
+		if cost := distance[next] + stepCost(offset[0], offset[1]); cost < bestCost {
+			best, bestCost = next, cost
+		}
+	}
+
+	return best
+}
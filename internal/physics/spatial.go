@@ -13,7 +13,7 @@ const (
 )
 
 // EntityID представляет уникальный идентификатор сущности
-type EntityID uint16
+type EntityID uint64
 
 // SpatialEntry представляет запись в пространственной сетке
 type SpatialEntry struct {
@@ -259,6 +259,17 @@ func (sg *SpatialGrid) Clear() {
 	}
 }
 
+// Entries возвращает все записи сетки в порядке ячеек (и порядке вставки внутри ячейки)
+// Используется для снапшотов: повторная вставка в этом порядке воспроизводит
+// ту же раскладку ячеек, а значит и тот же порядок результатов запросов
+func (sg *SpatialGrid) Entries() []SpatialEntry {
+	result := make([]SpatialEntry, 0, len(sg.entities))
+	for _, cell := range sg.cells {
+		result = append(result, cell...)
+	}
+	return result
+}
+
 // GetEntityCount возвращает общее количество сущностей в сетке
 func (sg *SpatialGrid) GetEntityCount() int {
 	return len(sg.entities)
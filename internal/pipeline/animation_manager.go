@@ -1,11 +1,52 @@
-package main
+package pipeline
 
 import (
 	"github.com/aiseeq/savanna/internal/animation"
 	"github.com/aiseeq/savanna/internal/constants"
 	"github.com/aiseeq/savanna/internal/core"
+	"github.com/aiseeq/savanna/internal/simulation"
 )
 
+// SpeciesAnimation анимация вида из файла описания и её значения по умолчанию
+type SpeciesAnimation struct {
+	AnimType animation.AnimationType
+	Name     string // Имя анимации в файле вида
+	Sprite   string // Имя файлов спрайтов анимации
+	Frames   int
+	FPS      float32
+	Loop     bool
+}
+
+// SpeciesAnimationDefaults анимации видов из файлов описаний и значения по умолчанию
+// Файл вида переопределяет frames и fps в разделе sprite.animations по имени анимации
+var SpeciesAnimationDefaults = []SpeciesAnimation{
+	{animation.AnimIdle, "idle", "idle", 2, 2.0, true},
+	{animation.AnimWalk, "walk", "walk", 2, 4.0, true},
+	{animation.AnimRun, "run", "run", 2, 8.0, true},
+	{animation.AnimAttack, "attack", "attack", 2, 8.0, false},
+	{animation.AnimEat, "eat", "eat", 2, 4.0, true},
+	{animation.AnimDeathDying, "dead", "dead", 2, 3.0, false},
+	{animation.AnimBreed, "breed", "idle", 2, 4.0, true}, // ВРЕМЕННО: отдельных спрайтов размножения пока нет
+	{animation.AnimDrink, "drink", "eat", 2, 2.0, true},  // ВРЕМЕННО: пьют с той же позой что и едят
+}
+
+// SpeciesAnimationFrames возвращает кадры и скорость анимации вида с учётом значений по умолчанию
+func SpeciesAnimationFrames(species simulation.SpeciesDefinition, anim SpeciesAnimation) (int, float32) {
+	frames, fps := anim.Frames, anim.FPS
+
+	override, exists := species.Sprite.Animations[anim.Name]
+	if !exists {
+		return frames, fps
+	}
+	if override.Frames > 0 {
+		frames = override.Frames
+	}
+	if override.FPS > 0 {
+		fps = override.FPS
+	}
+	return frames, fps
+}
+
 // AnimationManager управляет всеми анимационными системами
 // Соблюдает SRP - единственная ответственность: управление анимациями
 // Соблюдает OCP - легко расширяется новыми типами животных без модификации кода
@@ -169,6 +210,8 @@ func (am *AnimationManager) LoadAnimationsFromConfig() error {
 		{"hare_attack", 2, 5.0, false, animation.AnimAttack},
 		{"hare_eat", 2, 4.0, true, animation.AnimEat},
 		{"hare_dead", 2, 3.0, false, animation.AnimDeathDying},
+		{"hare_breed", 2, 4.0, true, animation.AnimBreed},
+		{"hare_drink", 2, 2.0, true, animation.AnimDrink},
 	}
 
 	for _, config := range rabbitAnimations {
@@ -191,6 +234,8 @@ func (am *AnimationManager) LoadAnimationsFromConfig() error {
 		{"wolf_attack", 4, 8.0, false, animation.AnimAttack},
 		{"wolf_eat", 2, 4.0, true, animation.AnimEat},
 		{"wolf_dead", 2, 3.0, false, animation.AnimDeathDying},
+		{"wolf_breed", 2, 4.0, true, animation.AnimBreed},
+		{"wolf_drink", 2, 2.0, true, animation.AnimDrink},
 	}
 
 	for _, config := range wolfAnimations {
@@ -198,5 +243,16 @@ func (am *AnimationManager) LoadAnimationsFromConfig() error {
 	}
 	am.RegisterAnimalSystem(core.TypeWolf, wolfSystem)
 
+	// Виды из файлов описаний (в том числе переопределённые заяц и волк)
+	for _, animalType := range simulation.RegisteredSpecies() {
+		species, _ := simulation.GetSpecies(animalType)
+		speciesSystem := animation.NewAnimationSystem()
+		for _, config := range SpeciesAnimationDefaults {
+			frames, fps := SpeciesAnimationFrames(species, config)
+			speciesSystem.RegisterAnimation(config.AnimType, frames, fps, config.Loop, nil)
+		}
+		am.RegisterAnimalSystem(animalType, speciesSystem)
+	}
+
 	return nil
 }
@@ -0,0 +1,146 @@
+package pipeline
+
+import (
+	"github.com/aiseeq/savanna/config"
+	"github.com/aiseeq/savanna/internal/adapters"
+	"github.com/aiseeq/savanna/internal/core"
+	"github.com/aiseeq/savanna/internal/generator"
+	"github.com/aiseeq/savanna/internal/navigation"
+	"github.com/aiseeq/savanna/internal/simulation"
+)
+
+// Pipeline конвейер симуляции: анимации и системы в порядке, критичном для питания и боя
+// Используется GUI (GameWorld), headless прогонами (savanna-sim) и GameState (реплеи, balance_ab) -
+// поведение симуляции одинаково
+// Не зависит от ebiten ввода и отрисовки (SRP: только шаг симуляции)
+type Pipeline struct {
+	systemManager    *core.SystemManager
+	animationManager *AnimationManager
+	balanceSystems   []simulation.BalanceConfigurable // Системы с настраиваемым балансом
+}
+
+// New создаёт конвейер систем для ландшафта и мира указанного размера
+func New(terrain *generator.Terrain, worldWidth, worldHeight float32) *Pipeline {
+	p := &Pipeline{
+		systemManager:    core.NewSystemManager(),
+		animationManager: NewAnimationManager(),
+	}
+
+	p.initializeSystems(terrain, worldWidth, worldHeight)
+
+	// Загружаем анимации для всех типов животных (кадры нужны поеданию и атакам)
+	_ = p.animationManager.LoadAnimationsFromConfig()
+
+	return p
+}
+
+// initializeSystems создаёт системы симуляции и добавляет их в правильном порядке
+func (p *Pipeline) initializeSystems(terrain *generator.Terrain, worldWidth, worldHeight float32) {
+	// Создаём системы (SRP рефакторинг: разделённые специализированные системы)
+	vegetationSystem := simulation.NewVegetationSystem(terrain)
+
+	// НОВЫЕ СИСТЕМЫ (следуют принципу SRP):
+	satiationSystem := simulation.NewSatiationSystem() // 1. Только управление сытостью
+	// 2. Только поиск травы и создание EatingState (DIP: использует интерфейс)
+	grassSearchSystem := simulation.NewGrassSearchSystem(vegetationSystem)
+	satiationSpeedModifier := simulation.NewSatiationSpeedModifierSystem() // 3. Только влияние сытости на скорость
+	starvationDamage := simulation.NewStarvationDamageSystem()             // 4. Только урон от истощения
+
+	grassEatingSystem := simulation.NewGrassEatingSystem(vegetationSystem) // DIP: использует интерфейс VegetationProvider
+	coverSystem := simulation.NewCoverSystem(vegetationSystem)             // Мелкие травоядные прячутся в кустах
+	packSystem := simulation.NewPackSystem()                               // Стаи хищников и их общая цель
+	animalBehaviorSystem := simulation.NewAnimalBehaviorSystem(vegetationSystem)
+	// Маршруты в обход воды и кустов: погоня, поиск травы и поле потока к водопоям
+	navigator := navigation.NewNavigator(terrain)
+	navigator.AddFlowField(simulation.WaterFlowField, vegetationSystem.IsDrinkableTile)
+	animalBehaviorSystem.SetNavigation(navigator)
+	// Используем реальные размеры мира
+	movementSystem := simulation.NewMovementSystem(worldWidth, worldHeight)
+	movementSystem.SetTerrain(vegetationSystem) // Вода и кусты непроходимы
+	// Уже включает DamageSystem внутри
+	combatSystem := simulation.NewCombatSystem()
+	reproductionSystem := simulation.NewReproductionSystem()
+	agingSystem := simulation.NewAgingSystem()
+	thirstSystem := simulation.NewThirstSystem(vegetationSystem) // DIP: использует интерфейс WaterProvider
+
+	// Порядок выполнения строит SystemManager по фазам и зависимостям из объявлений систем
+	// Объявления статичны и проверяются тестом TestPipelineSystemOrder: ошибка регистрации - паника при создании
+	registrations := []struct {
+		system core.System
+		spec   core.SystemSpec
+	}{
+		{vegetationSystem, simulation.VegetationSystemSpec},
+		{&adapters.SatiationSystemAdapter{System: satiationSystem}, simulation.SatiationSystemSpec},
+		{&adapters.ThirstSystemAdapter{System: thirstSystem}, simulation.ThirstSystemSpec},
+		{&adapters.GrassSearchSystemAdapter{System: grassSearchSystem}, simulation.GrassSearchSystemSpec},
+		{grassEatingSystem, simulation.GrassEatingSystemSpec},
+		{coverSystem, simulation.CoverSystemSpec},
+		{packSystem, simulation.PackSystemSpec},
+		{&adapters.BehaviorSystemAdapter{System: animalBehaviorSystem}, simulation.BehaviorSystemSpec},
+		{&adapters.SatiationSpeedModifierSystemAdapter{System: satiationSpeedModifier}, simulation.SatiationSpeedSystemSpec},
+		{&adapters.MovementSystemAdapter{System: movementSystem}, simulation.MovementSystemSpec},
+		{agingSystem, simulation.AgingSystemSpec},
+		{combatSystem, simulation.CombatSystemSpec}, // Включает атаки, урон, трупы и поедание
+		{&adapters.StarvationDamageSystemAdapter{System: starvationDamage}, simulation.StarvationSystemSpec},
+		{reproductionSystem, simulation.ReproductionSystemSpec},
+		{navigator, simulation.NavigationSystemSpec},
+	}
+	for _, registration := range registrations {
+		p.systemManager.MustRegister(registration.system, registration.spec)
+	}
+
+	p.balanceSystems = []simulation.BalanceConfigurable{
+		vegetationSystem, satiationSystem, thirstSystem, grassSearchSystem, grassEatingSystem,
+		packSystem, animalBehaviorSystem, satiationSpeedModifier, combatSystem, starvationDamage, reproductionSystem,
+	}
+}
+
+// Update выполняет один шаг симуляции
+func (p *Pipeline) Update(world *core.World, deltaTime float32) {
+	world.Update(deltaTime)
+
+	// КРИТИЧЕСКИЙ ИСПРАВЛЕНИЕ: Анимации должны обновляться ПЕРЕД системами
+	// чтобы GrassEatingSystem видел актуальные значения анимационных таймеров
+	p.animationManager.UpdateAnimalAnimations(world, deltaTime)
+
+	p.systemManager.Update(world, deltaTime)
+}
+
+// SystemManager возвращает менеджер систем (снапшоты сохраняют и восстанавливают их состояние)
+func (p *Pipeline) SystemManager() *core.SystemManager {
+	return p.systemManager
+}
+
+// AnimationManager возвращает менеджер анимаций
+func (p *Pipeline) AnimationManager() *AnimationManager {
+	return p.animationManager
+}
+
+// SetBalanceProfile передаёт профиль баланса системам (nil - значения по умолчанию)
+func (p *Pipeline) SetBalanceProfile(profile *simulation.BalanceProfile) {
+	simulation.ApplyBalanceProfile(profile, p.balanceSystems...)
+}
+
+// Populate размещает начальную популяцию из конфигурации с параметрами видов из профиля баланса
+// Возвращает размещения для проверки и статистики; незарегистрированный вид популяции - ошибка
+func Populate(
+	world *core.World,
+	terrain *generator.Terrain,
+	cfg *config.Config,
+	balance *simulation.BalanceProfile,
+) (*generator.PopulationGenerator, []generator.AnimalPlacement, error) {
+	// ИСПРАВЛЕНИЕ: Используем PopulationGenerator вместо случайного размещения
+	popGen := generator.NewPopulationGenerator(cfg, terrain)
+	placements, err := popGen.Generate()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, placement := range placements {
+		// PopulationGenerator возвращает координаты в пикселях, CreateAnimal ожидает пиксели
+		animal := simulation.CreateAnimalWithBalance(world, balance, placement.Type, placement.X, placement.Y)
+		simulation.RandomizeAdultAge(world, animal)
+	}
+
+	return popGen, placements, nil
+}
@@ -0,0 +1,143 @@
+package simulation
+
+import (
+	"math"
+
+	"github.com/aiseeq/savanna/internal/core"
+)
+
+// AgingSystem отвечает ТОЛЬКО за возраст животных (SRP)
+// Увеличивает возраст, пересчитывает стадию жизни и её влияние на размер и здоровье.
+// Скорость и урон читают множители стадии сами (SatiationSpeedModifierSystem, AttackSystem)
+type AgingSystem struct{}
+
+// NewAgingSystem создаёт новую систему старения
+func NewAgingSystem() *AgingSystem {
+	return &AgingSystem{}
+}
+
+// Update увеличивает возраст и применяет эффекты стадии жизни
+// Естественная смерть: здоровье обнуляется, удаление выполняет CorpseSystem
+func (as *AgingSystem) Update(world *core.World, deltaTime float32) {
+	world.ForEachWith(core.MaskAge|core.MaskAnimalConfig|core.MaskHealth, func(entity core.EntityID) {
+		// Трупы не стареют
+		if !isAliveAnimal(world, entity) {
+			return
+		}
+
+		age, _ := world.GetAge(entity)
+		config, _ := world.GetAnimalConfig(entity)
+
+		age = ComputeAge(age.Seconds+deltaTime, config)
+		world.SetAge(entity, age)
+		applyLifeStage(world, entity, config, age)
+
+		if config.MaxAge > 0 && age.Seconds >= config.MaxAge {
+			health, _ := world.GetHealth(entity)
+			previous := health.Current
+			health.Current = 0
+			world.SetHealth(entity, health)
+			reportDeath(world, entity, previous, health.Current, core.DeathOldAge, 0)
+		}
+	})
+}
+
+// ComputeAge вычисляет стадию жизни и её множители для заданного возраста
+// Детёныш растёт линейно до MaturityAge, старое животное слабеет линейно от ElderAge до MaxAge
+func ComputeAge(seconds float32, config core.AnimalConfig) core.Age {
+	age := core.Age{
+		Seconds:          seconds,
+		Stage:            core.LifeStageAdult,
+		SizeMultiplier:   1,
+		SpeedMultiplier:  1,
+		AttackMultiplier: 1,
+		HealthMultiplier: 1,
+	}
+
+	switch {
+	case seconds < config.MaturityAge:
+		growth := seconds / config.MaturityAge
+		age.Stage = core.LifeStageJuvenile
+		age.SizeMultiplier = lerpFloat32(JuvenileSizeMultiplier, 1, growth)
+		age.SpeedMultiplier = lerpFloat32(JuvenileSpeedMultiplier, 1, growth)
+		age.AttackMultiplier = lerpFloat32(JuvenileAttackMultiplier, 1, growth)
+
+	case config.MaxAge > 0 && seconds >= config.ElderAge:
+		decline := float32(1)
+		if config.MaxAge > config.ElderAge {
+			decline = clampFloat32((seconds-config.ElderAge)/(config.MaxAge-config.ElderAge), 0, 1)
+		}
+		age.Stage = core.LifeStageElder
+		age.SpeedMultiplier = lerpFloat32(1, ElderMinSpeedMultiplier, decline)
+		age.HealthMultiplier = lerpFloat32(1, ElderMinHealthMultiplier, decline)
+	}
+
+	return age
+}
+
+// NewbornAge добавляет новорождённому возраст 0 и сразу применяет эффекты стадии детёныша
+func NewbornAge(world *core.World, entity core.EntityID) {
+	setAge(world, entity, 0)
+}
+
+// RandomizeAdultAge назначает животному начальной популяции случайный взрослый возраст
+// Без разброса все животные начальной популяции состарились бы и умерли одновременно
+func RandomizeAdultAge(world *core.World, entity core.EntityID) {
+	config, hasConfig := world.GetAnimalConfig(entity)
+	if !hasConfig {
+		return
+	}
+
+	setAge(world, entity, lerpFloat32(config.MaturityAge, config.ElderAge, world.GetRNG().Float32()))
+}
+
+// setAge устанавливает возраст животного и применяет эффекты его стадии жизни
+func setAge(world *core.World, entity core.EntityID, seconds float32) {
+	config, hasConfig := world.GetAnimalConfig(entity)
+	if !hasConfig {
+		return
+	}
+
+	age := ComputeAge(seconds, config)
+	if !world.SetAge(entity, age) {
+		world.AddAge(entity, age)
+	}
+	applyLifeStage(world, entity, config, age)
+}
+
+// applyLifeStage применяет размер тела и максимальное здоровье стадии жизни
+func applyLifeStage(world *core.World, entity core.EntityID, config core.AnimalConfig, age core.Age) {
+	if size, hasSize := world.GetSize(entity); hasSize {
+		radius := config.CollisionRadius * age.SizeMultiplier
+		if size.Radius != radius {
+			size.Radius = radius
+			world.SetSize(entity, size)
+
+			// Радиус в пространственной сетке берётся из Size - обновляем запись
+			pos, _ := world.GetPosition(entity)
+			world.UpdateSpatialPosition(entity, pos)
+		}
+	}
+
+	health, _ := world.GetHealth(entity)
+	maxHealth := int16(max(math.Round(float64(float32(config.MaxHealth)*age.HealthMultiplier)), 1))
+	if health.Max != maxHealth {
+		health.Max = maxHealth
+		health.Current = min(health.Current, maxHealth)
+		world.SetHealth(entity, health)
+	}
+}
+
+// lifeStageAttackDamage возвращает урон атаки с учётом стадии жизни атакующего
+func lifeStageAttackDamage(world core.ComponentReader, attacker core.EntityID, baseDamage int16) int16 {
+	age, hasAge := world.GetAge(attacker)
+	if !hasAge {
+		return baseDamage
+	}
+	return int16(math.Round(float64(float32(baseDamage) * age.AttackMultiplier)))
+}
+
+// lerpFloat32 линейная интерполяция между from и to (t в диапазоне 0..1)
+func lerpFloat32(from, to, t float32) float32 {
+	return from + (to-from)*t
+}
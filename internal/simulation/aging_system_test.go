@@ -0,0 +1,128 @@
+package simulation
+
+import (
+	"math"
+	"testing"
+
+	"github.com/aiseeq/savanna/internal/core"
+)
+
+const agingTestDeltaTime = float32(1.0 / 60.0)
+
+func TestComputeAge_LifeStages(t *testing.T) {
+	config := CreateAnimalConfig(core.TypeWolf)
+
+	tests := []struct {
+		name    string
+		seconds float32
+		stage   core.LifeStage
+		size    float32
+		speed   float32
+		attack  float32
+		health  float32
+	}{
+		{"newborn", 0, core.LifeStageJuvenile, JuvenileSizeMultiplier, JuvenileSpeedMultiplier,
+			JuvenileAttackMultiplier, 1},
+		{"half grown", WolfMaturityAge / 2, core.LifeStageJuvenile, (JuvenileSizeMultiplier + 1) / 2,
+			(JuvenileSpeedMultiplier + 1) / 2, (JuvenileAttackMultiplier + 1) / 2, 1},
+		{"adult", WolfMaturityAge, core.LifeStageAdult, 1, 1, 1, 1},
+		{"old", (WolfElderAge + WolfMaxAge) / 2, core.LifeStageElder, 1, (1 + ElderMinSpeedMultiplier) / 2, 1,
+			(1 + ElderMinHealthMultiplier) / 2},
+		{"max age", WolfMaxAge, core.LifeStageElder, 1, ElderMinSpeedMultiplier, 1, ElderMinHealthMultiplier},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			age := ComputeAge(tt.seconds, config)
+			if age.Stage != tt.stage {
+				t.Errorf("Stage: expected %s, got %s", tt.stage, age.Stage)
+			}
+			assertMultiplier(t, "Size", tt.size, age.SizeMultiplier)
+			assertMultiplier(t, "Speed", tt.speed, age.SpeedMultiplier)
+			assertMultiplier(t, "Attack", tt.attack, age.AttackMultiplier)
+			assertMultiplier(t, "Health", tt.health, age.HealthMultiplier)
+		})
+	}
+}
+
+func TestAging_JuvenileGrowsUp(t *testing.T) {
+	world := core.NewWorld(640, 640, 12345)
+	system := NewAgingSystem()
+
+	rabbit := CreateAnimal(world, core.TypeRabbit, 300, 300)
+	NewbornAge(world, rabbit)
+
+	config, _ := world.GetAnimalConfig(rabbit)
+	size, _ := world.GetSize(rabbit)
+	assertMultiplier(t, "Newborn radius", config.CollisionRadius*JuvenileSizeMultiplier, size.Radius)
+
+	for elapsed := float32(0); elapsed < RabbitMaturityAge+1; elapsed += agingTestDeltaTime {
+		system.Update(world, agingTestDeltaTime)
+	}
+
+	age, _ := world.GetAge(rabbit)
+	if age.Stage != core.LifeStageAdult {
+		t.Fatalf("Rabbit should be adult after %v seconds, got %s", RabbitMaturityAge, age.Stage)
+	}
+	size, _ = world.GetSize(rabbit)
+	assertMultiplier(t, "Adult radius", config.CollisionRadius, size.Radius)
+}
+
+func TestAging_JuvenileSpeedAndDamageReduced(t *testing.T) {
+	world := core.NewWorld(640, 640, 12345)
+	speedSystem := NewSatiationSpeedModifierSystem()
+
+	adult := CreateAnimal(world, core.TypeWolf, 100, 100)
+	cub := CreateAnimal(world, core.TypeWolf, 400, 400)
+	NewbornAge(world, cub)
+
+	speedSystem.Update(world, agingTestDeltaTime)
+
+	adultSpeed, _ := world.GetSpeed(adult)
+	cubSpeed, _ := world.GetSpeed(cub)
+	assertMultiplier(t, "Cub speed ratio", JuvenileSpeedMultiplier, cubSpeed.Current/adultSpeed.Current)
+
+	config, _ := world.GetAnimalConfig(cub)
+	if damage := lifeStageAttackDamage(world, cub, config.AttackDamage); damage >= config.AttackDamage {
+		t.Errorf("Cub damage %d should be lower than adult damage %d", damage, config.AttackDamage)
+	}
+	if damage := lifeStageAttackDamage(world, adult, config.AttackDamage); damage != config.AttackDamage {
+		t.Errorf("Adult damage should be %d, got %d", config.AttackDamage, damage)
+	}
+}
+
+func TestAging_ElderDiesOfOldAge(t *testing.T) {
+	world := core.NewWorld(640, 640, 12345)
+	aging := NewAgingSystem()
+	corpses := NewCorpseSystem()
+
+	rabbit := CreateAnimal(world, core.TypeRabbit, 300, 300)
+	world.SetAge(rabbit, ComputeAge(RabbitElderAge, CreateAnimalConfig(core.TypeRabbit)))
+
+	aging.Update(world, (RabbitMaxAge-RabbitElderAge)/2)
+	health, _ := world.GetHealth(rabbit)
+	elderHealth := float64(RabbitMaxHealth) * (1 + ElderMinHealthMultiplier) / 2
+	if expected := int16(math.Round(elderHealth)); health.Max != expected {
+		t.Errorf("Elder max health: expected %d, got %d", expected, health.Max)
+	}
+	if health.Current > health.Max {
+		t.Errorf("Current health %d should not exceed max %d", health.Current, health.Max)
+	}
+
+	aging.Update(world, RabbitMaxAge)
+	corpses.Update(world, agingTestDeltaTime)
+	world.FlushCommands() // Удаление отложено до точки синхронизации
+
+	if world.IsAlive(rabbit) {
+		t.Error("Rabbit should die of old age and be removed by CorpseSystem")
+	}
+}
+
+// assertMultiplier сравнивает вещественные значения с допуском на погрешность float32
+func assertMultiplier(t *testing.T, name string, expected, actual float32) {
+	t.Helper()
+
+	if actual < expected-1e-3 || actual > expected+1e-3 {
+		t.Errorf("%s: expected %f, got %f", name, expected, actual)
+	}
+}
@@ -13,3 +13,8 @@ func CreateAnimalConfig(animalType core.AnimalType) core.AnimalConfig {
 func RegisterAnimalConfigFactory(animalType core.AnimalType, factory AnimalConfigFactory) {
 	defaultRegistry.RegisterFactory(animalType, factory)
 }
+
+// AnimalTypesWithDiet возвращает типы животных с указанным типом питания
+func AnimalTypesWithDiet(diet core.BehaviorType) []core.AnimalType {
+	return defaultRegistry.TypesWithDiet(diet)
+}
@@ -1,6 +1,10 @@
 package simulation
 
-import "github.com/aiseeq/savanna/internal/core"
+import (
+	"sort"
+
+	"github.com/aiseeq/savanna/internal/core"
+)
 
 // AnimalConfigFactory интерфейс для создания конфигурации животных (Factory Pattern)
 // Соблюдает принципы OCP и SRP
@@ -12,12 +16,14 @@ type AnimalConfigFactory interface {
 // Соблюдает принципы OCP - новые типы животных добавляются без изменения существующего кода
 type AnimalConfigRegistry struct {
 	factories map[core.AnimalType]AnimalConfigFactory
+	dietTypes map[core.BehaviorType][]core.AnimalType // Типы по питанию (по возрастанию) - кто на кого охотится
 }
 
 // NewAnimalConfigRegistry создаёт новый реестр конфигураций
 func NewAnimalConfigRegistry() *AnimalConfigRegistry {
 	registry := &AnimalConfigRegistry{
 		factories: make(map[core.AnimalType]AnimalConfigFactory),
+		dietTypes: make(map[core.BehaviorType][]core.AnimalType),
 	}
 
 	// Регистрируем стандартные factory
@@ -31,6 +37,27 @@ func NewAnimalConfigRegistry() *AnimalConfigRegistry {
 // Соблюдает принцип OCP - новые типы добавляются без изменения кода
 func (r *AnimalConfigRegistry) RegisterFactory(animalType core.AnimalType, factory AnimalConfigFactory) {
 	r.factories[animalType] = factory
+	r.rebuildDietTypes()
+}
+
+// TypesWithDiet возвращает зарегистрированные типы животных с указанным типом питания (по возрастанию)
+// Хищники охотятся на всех травоядных, травоядные убегают от всех хищников - без привязки к зайцам и волкам
+func (r *AnimalConfigRegistry) TypesWithDiet(diet core.BehaviorType) []core.AnimalType {
+	return r.dietTypes[diet]
+}
+
+// rebuildDietTypes пересчитывает списки типов по питанию после регистрации factory
+// ВАЖНО: списки отсортированы - порядок поиска ближайшей цели детерминирован
+func (r *AnimalConfigRegistry) rebuildDietTypes() {
+	r.dietTypes = make(map[core.BehaviorType][]core.AnimalType)
+	for animalType, factory := range r.factories {
+		diet := getBehaviorTypeFromConfig(factory.CreateConfig())
+		r.dietTypes[diet] = append(r.dietTypes[diet], animalType)
+	}
+
+	for _, types := range r.dietTypes {
+		sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	}
 }
 
 // CreateConfig создаёт конфигурацию животного по типу
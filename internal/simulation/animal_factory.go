@@ -9,11 +9,17 @@ import (
 // Заменяет дублированную логику между AnimalCreationConfig и core.AnimalConfig
 func CreateAnimal(world *core.World, animalType core.AnimalType, x, y float32) core.EntityID {
 	config := CreateAnimalConfig(animalType)
-	return createEntityFromConfig(world, config, x, y)
+	return createEntityFromConfig(world, animalType, config, x, y)
 }
 
 // createEntityFromConfig создает сущность из конфигурации (единая точка создания)
-func createEntityFromConfig(world *core.World, config core.AnimalConfig, x, y float32) core.EntityID {
+// ИСПРАВЛЕНИЕ: тип животного передаётся явно - виды из файлов не выводятся из AttackRange
+func createEntityFromConfig(
+	world *core.World,
+	animalType core.AnimalType,
+	config core.AnimalConfig,
+	x, y float32,
+) core.EntityID {
 	entity := world.CreateEntity()
 
 	// КРИТИЧЕСКОЕ ИСПРАВЛЕНИЕ: Добавляем Size ПЕРЕД Position
@@ -29,15 +35,16 @@ func createEntityFromConfig(world *core.World, config core.AnimalConfig, x, y fl
 	world.AddHealth(entity, core.Health{Current: config.MaxHealth, Max: config.MaxHealth})
 
 	// РЕФАКТОРИНГ OCP: Используем Factory Pattern вместо switch для начального голода
-	animalType := getAnimalTypeFromConfig(config)
 	initialSatiation := GetInitialSatiationForAnimal(animalType)
 	world.AddSatiation(entity, core.Satiation{Value: initialSatiation})
 
+	// Животные создаются напившимися
+	world.AddHydration(entity, core.Hydration{Value: MaxHydration})
+
 	// Добавляем AnimalConfig компонент
 	world.AddAnimalConfig(entity, config)
 
-	// Тип животного определяется из анимации или конфигурации
-	world.AddAnimalType(entity, getAnimalTypeFromConfig(config))
+	world.AddAnimalType(entity, animalType)
 
 	// Скорость из конфигурации
 	world.AddSpeed(entity, core.Speed{
@@ -69,21 +76,19 @@ func createEntityFromConfig(world *core.World, config core.AnimalConfig, x, y fl
 		FacingRight: true,
 	})
 
-	return entity
-}
+	// Возраст: созданные напрямую животные взрослые (детёныши появляются через размножение)
+	world.AddAge(entity, ComputeAge(config.MaturityAge, config))
 
-// getAnimalTypeFromConfig определяет тип животного из конфигурации
-func getAnimalTypeFromConfig(config core.AnimalConfig) core.AnimalType {
-	// Определяем по характерным параметрам (AttackRange > 0 = хищник)
-	if config.AttackRange > 0 {
-		return core.TypeWolf
-	}
-	return core.TypeRabbit
+	return entity
 }
 
 // getBehaviorTypeFromConfig определяет тип поведения из конфигурации
 func getBehaviorTypeFromConfig(config core.AnimalConfig) core.BehaviorType {
-	// Определяем по характерным параметрам (AttackRange > 0 = хищник)
+	if config.Diet != core.BehaviorNone {
+		return config.Diet
+	}
+
+	// Конфигурации без типа питания: определяем по характерным параметрам (AttackRange > 0 = хищник)
 	if config.AttackRange > 0 {
 		return core.BehaviorPredator
 	}
@@ -8,6 +8,7 @@ import (
 
 // AttackSystem отвечает ТОЛЬКО за атаки и нанесение урона (устраняет нарушение SRP)
 type AttackSystem struct {
+	balanced                                  // Профиль баланса: фазы атаки и трупы
 	attackCooldowns map[core.EntityID]float32 // Кулдауны атак
 }
 
@@ -79,13 +80,13 @@ func (as *AttackSystem) findAttackTarget(world *core.World, attacker core.Entity
 	// Используем дальность видения из поведения (универсально!)
 	searchRadius := behavior.VisionRange
 
-	// ПОИСК ЛЮБЫХ ТРАВОЯДНЫХ (устраняет захардкоженность TypeRabbit)
-	// Ищем ближайшее животное с поведением травоядного
+	// ПОИСК ЛЮБОЙ ДОБЫЧИ (устраняет захардкоженность TypeRabbit)
+	// Ищем ближайшее травоядное или всеядное
 	var closestTarget core.EntityID
 	var closestDistanceSquared float32 = searchRadius*searchRadius + 1 // За пределами радиуса
 
 	world.ForEachWith(core.MaskBehavior|core.MaskPosition|core.MaskSize, func(candidate core.EntityID) {
-		if !as.isValidHerbivoreTarget(world, attacker, candidate) {
+		if !as.isValidPreyTarget(world, attacker, candidate) {
 			return
 		}
 
@@ -124,14 +125,14 @@ func (as *AttackSystem) findAttackTarget(world *core.World, attacker core.Entity
 	return 0
 }
 
-// isValidHerbivoreTarget проверяет что цель подходит для атаки (снижает сложность)
-func (as *AttackSystem) isValidHerbivoreTarget(world *core.World, attacker, candidate core.EntityID) bool {
+// isValidPreyTarget проверяет что цель подходит для атаки (снижает сложность)
+func (as *AttackSystem) isValidPreyTarget(world *core.World, attacker, candidate core.EntityID) bool {
 	if candidate == attacker || world.HasComponent(candidate, core.MaskCorpse) {
 		return false
 	}
 
 	candidateBehavior, hasBehavior := world.GetBehavior(candidate)
-	return hasBehavior && candidateBehavior.Type == core.BehaviorHerbivore
+	return hasBehavior && isPrey(candidateBehavior.Type)
 }
 
 // tryStartAttack пытается начать атаку для хищника (упрощена через вспомогательные методы)
@@ -285,7 +286,8 @@ func (as *AttackSystem) updateAttackStateWithAnimation(
 	}
 
 	// Резервный механизм завершения по таймеру (если анимация зависла)
-	if attackState.TotalTimer >= (AttackWindupDuration + AttackStrikeDuration) {
+	balance := as.profile().Combat
+	if attackState.TotalTimer >= (balance.WindupDuration + balance.StrikeDuration) {
 		// Атака завершена - устанавливаем кулдаун и удаляем состояние
 		as.setAttackCooldown(world, predator)
 		world.RemoveAttackState(predator)
@@ -323,7 +325,7 @@ func (as *AttackSystem) updateAttackStateWithTimer(
 ) bool {
 	switch attackState.Phase {
 	case core.AttackPhaseWindup:
-		if attackState.PhaseTimer >= AttackWindupDuration {
+		if attackState.PhaseTimer >= as.profile().Combat.WindupDuration {
 			// Время перейти к удару
 			attackState.Phase = core.AttackPhaseStrike
 			attackState.PhaseTimer = 0.0
@@ -337,7 +339,7 @@ func (as *AttackSystem) updateAttackStateWithTimer(
 		}
 
 		// Завершаем атаку по таймеру
-		if attackState.PhaseTimer >= AttackStrikeDuration {
+		if attackState.PhaseTimer >= as.profile().Combat.StrikeDuration {
 			// Атака завершена - устанавливаем кулдаун и удаляем состояние
 			as.setAttackCooldown(world, predator)
 			world.RemoveAttackState(predator)
@@ -395,7 +397,9 @@ func (as *AttackSystem) performStrikeAttempt(world *core.World, attacker, target
 	// Проверяем шанс попадания
 	rng := world.GetRNG()
 	if rng.Float32() < config.HitChance {
-		as.dealDamageToTarget(world, attacker, target, config.AttackDamage)
+		// Детёныши бьют слабее взрослых
+		damage := lifeStageAttackDamage(world, attacker, config.AttackDamage)
+		as.dealDamageToTarget(world, attacker, target, damage)
 	}
 }
 
@@ -414,10 +418,8 @@ func (as *AttackSystem) dealDamageToTarget(world *core.World, attacker, target c
 	}
 
 	world.SetHealth(target, health)
-
-	if damage > 0 {
-		_ = oldHealth // Избегаем warning о неиспользуемой переменной
-	}
+	world.EmitEvent(core.AttackLanded{Attacker: attacker, Target: target, Damage: damage, TargetHealth: health.Current})
+	reportDeath(world, target, oldHealth, health.Current, core.DeathKilled, attacker)
 
 	// Добавляем эффект мигания
 	world.AddDamageFlash(target, core.DamageFlash{
@@ -430,7 +432,7 @@ func (as *AttackSystem) dealDamageToTarget(world *core.World, attacker, target c
 	if health.Current == 0 {
 		// ИСПРАВЛЕНИЕ: Сначала создаём труп, потом создаём EatingState для трупа
 		// createCorpse() возвращает ID новой сущности-трупа
-		corpseEntity := CreateCorpseAndGetID(world, target)
+		corpseEntity := createCorpseWithBalance(world, target, as.profile())
 
 		// ИСПРАВЛЕНИЕ КРИТИЧЕСКОГО БАГА: Сбрасываем AttackState при убийстве цели
 		// Согласно требованию 3.2.3 из docs/tasks/2025-06-22-rework.md
@@ -0,0 +1,423 @@
+package simulation
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/aiseeq/savanna/internal/constants"
+	"github.com/aiseeq/savanna/internal/core"
+)
+
+// BalanceProfile профиль игрового баланса
+// Значения по умолчанию совпадают с константами game_balance.go, vegetation.go и combat.go.
+// Профиль загружается из YAML и передаётся системам через SetBalanceProfile -
+// эксперименты с балансом не требуют пересборки, A/B сравнение запускается из командной строки
+type BalanceProfile struct {
+	Name string `yaml:"name"` // Название профиля для отчётов
+
+	Animals      map[string]AnimalBalance `yaml:"animals"` // Параметры видов по имени (rabbit, wolf)
+	Satiation    SatiationBalance         `yaml:"satiation"`
+	Feeding      FeedingBalance           `yaml:"feeding"`
+	Vegetation   VegetationBalance        `yaml:"vegetation"`
+	Corpses      CorpseBalance            `yaml:"corpses"`
+	Speed        SpeedBalance             `yaml:"speed"`
+	Combat       CombatBalance            `yaml:"combat"`
+	Reproduction ReproductionBalance      `yaml:"reproduction"`
+	Thirst       ThirstBalance            `yaml:"thirst"`
+	Pack         PackBalance              `yaml:"pack"`
+}
+
+// AnimalBalance параметры вида поверх его конфигурации
+// nil - параметр не задан и остаётся значением вида; явный 0 применяется как есть
+type AnimalBalance struct {
+	MaxHealth          *int16   `yaml:"max_health"`
+	BaseSpeed          *float32 `yaml:"base_speed"`
+	SatiationThreshold *float32 `yaml:"satiation_threshold"`
+	InitialSatiation   *float32 `yaml:"initial_satiation"`
+	AttackDamage       *int16   `yaml:"attack_damage"`
+	AttackCooldown     *float32 `yaml:"attack_cooldown"`
+	HitChance          *float32 `yaml:"hit_chance"`
+	SearchSpeed        *float32 `yaml:"search_speed"`    // Множитель скорости при поиске еды
+	WanderingSpeed     *float32 `yaml:"wandering_speed"` // Множитель скорости при блуждании
+	ContentSpeed       *float32 `yaml:"content_speed"`   // Множитель скорости в покое
+	HerdRadius         *float32 `yaml:"herd_radius"`     // Радиус соседей по стаду (тайлы)
+	HerdCohesion       *float32 `yaml:"herd_cohesion"`
+	HerdAlignment      *float32 `yaml:"herd_alignment"`
+	HerdSeparation     *float32 `yaml:"herd_separation"`
+	HearingRange       *float32 `yaml:"hearing_range"` // Дальность тревоги сородичей (тайлы)
+}
+
+// SatiationBalance потеря сытости и урон от голода
+type SatiationBalance struct {
+	DecreaseRate              float32 `yaml:"decrease_rate"`                // Процентов в секунду
+	LargeAnimalSizeThreshold  float32 `yaml:"large_animal_size_threshold"`  // Радиус крупного животного (тайлы)
+	LargeAnimalRate           float32 `yaml:"large_animal_rate"`            // Множитель потери для крупных животных
+	StarvationDamagePerSecond int16   `yaml:"starvation_damage_per_second"` // Урон при сытости = 0
+}
+
+// FeedingBalance поедание травы и трупов
+type FeedingBalance struct {
+	GrassPerEatingTick     float32 `yaml:"grass_per_eating_tick"`     // Трава за кадр анимации поедания
+	GrassNutritionValue    float32 `yaml:"grass_nutrition_value"`     // Сытость за единицу травы
+	MinGrassAmountToFind   float32 `yaml:"min_grass_amount_to_find"`  // Минимум травы на тайле для поедания
+	CorpseNutritionPerTick float32 `yaml:"corpse_nutrition_per_tick"` // Питательность трупа за кадр анимации
+}
+
+// VegetationBalance рост травы
+type VegetationBalance struct {
+	GrassGrowthRate         float32 `yaml:"grass_growth_rate"`         // Единиц травы в секунду
+	GrassMaxAmount          float32 `yaml:"grass_max_amount"`          // Максимум травы на тайле
+	WetlandGrowthMultiplier float32 `yaml:"wetland_growth_multiplier"` // Рост на влажной земле
+	NearWaterGrowthPenalty  float32 `yaml:"near_water_growth_penalty"` // Рост на траве рядом с водой
+}
+
+// CorpseBalance трупы
+type CorpseBalance struct {
+	NutritionalValue float32 `yaml:"nutritional_value"` // Питательность трупа
+	DecayTime        float32 `yaml:"decay_time"`        // Время разложения (секунды)
+}
+
+// SpeedBalance влияние сытости на скорость
+type SpeedBalance struct {
+	SatiatedThreshold     float32 `yaml:"satiated_threshold"`      // Сытость выше которой животные замедляются
+	SatietySlowdownOffset float32 `yaml:"satiety_slowdown_offset"` // Формула замедления: 1 + offset - сытость
+}
+
+// CombatBalance фазы атаки
+type CombatBalance struct {
+	WindupDuration float32 `yaml:"windup_duration"` // Длительность замаха (секунды)
+	StrikeDuration float32 `yaml:"strike_duration"` // Длительность удара (секунды)
+}
+
+// ReproductionBalance условия спаривания и мутации
+type ReproductionBalance struct {
+	SatiationThreshold float32 `yaml:"satiation_threshold"` // Минимальная сытость родителей
+	SatiationCost      float32 `yaml:"satiation_cost"`      // Сытость теряемая каждым родителем
+	MatingRange        float32 `yaml:"mating_range"`        // Дистанция спаривания (тайлы)
+	MutationRate       float32 `yaml:"mutation_rate"`       // Мутация параметров потомка (±доля)
+}
+
+// ThirstBalance потеря воды, питьё и обезвоживание
+type ThirstBalance struct {
+	DecreaseRate               float32 `yaml:"decrease_rate"`                 // Процентов в секунду
+	LargeAnimalRate            float32 `yaml:"large_animal_rate"`             // Множитель потери для крупных животных
+	Threshold                  float32 `yaml:"threshold"`                     // Гидратация при которой животное ищет воду
+	WaterMemoryRangeMultiplier float32 `yaml:"water_memory_range_multiplier"` // Дальность поиска воды от зрения
+	DrinkRate                  float32 `yaml:"drink_rate"`                    // Гидратация за секунду питья
+	DehydrationDamagePerSecond int16   `yaml:"dehydration_damage_per_second"` // Урон при гидратации = 0
+}
+
+// PackBalance стайная охота хищников
+type PackBalance struct {
+	MaxSize        int     `yaml:"max_size"`        // Максимум хищников в стае (1 - одиночная охота)
+	FormationRange float32 `yaml:"formation_range"` // Дистанция объединения в стаю (тайлы)
+	CohesionRange  float32 `yaml:"cohesion_range"`  // Дальше от вожака - хищник покидает стаю (тайлы)
+	FlankDistance  float32 `yaml:"flank_distance"`  // Упреждение загонщиков перед добычей (тайлы)
+}
+
+// DefaultBalanceProfileName название профиля по умолчанию
+const DefaultBalanceProfileName = "default"
+
+// defaultBalanceProfile общий профиль по умолчанию для систем без явного профиля (только чтение)
+var defaultBalanceProfile = DefaultBalanceProfile()
+
+// DefaultBalanceProfile создаёт профиль со значениями текущих констант баланса
+func DefaultBalanceProfile() *BalanceProfile {
+	rabbit := NewRabbitConfigFactory().CreateConfig()
+	wolf := NewWolfConfigFactory().CreateConfig()
+
+	return &BalanceProfile{
+		Name: DefaultBalanceProfileName,
+		Animals: map[string]AnimalBalance{
+			strings.ToLower(core.TypeRabbit.String()): animalBalanceFromConfig(rabbit, RabbitInitialSatiation),
+			strings.ToLower(core.TypeWolf.String()):   animalBalanceFromConfig(wolf, WolfInitialSatiation),
+		},
+		Satiation: SatiationBalance{
+			DecreaseRate:              BaseSatiationDecreaseRate,
+			LargeAnimalSizeThreshold:  LargeAnimalSizeThreshold,
+			LargeAnimalRate:           LargeAnimalSaitationRate,
+			StarvationDamagePerSecond: StarvationDamagePerSecond,
+		},
+		Feeding: FeedingBalance{
+			GrassPerEatingTick:     GrassPerEatingTick,
+			GrassNutritionValue:    GrassNutritionValue,
+			MinGrassAmountToFind:   MinGrassAmountToFind,
+			CorpseNutritionPerTick: CorpseNutritionPerTick,
+		},
+		Vegetation: VegetationBalance{
+			GrassGrowthRate:         GrassGrowthRate,
+			GrassMaxAmount:          GrassMaxAmount,
+			WetlandGrowthMultiplier: WetlandGrowthMultiplier,
+			NearWaterGrowthPenalty:  NearWaterGrowthPenalty,
+		},
+		Corpses: CorpseBalance{
+			NutritionalValue: CorpseNutritionalValue,
+			DecayTime:        CorpseDecayTime,
+		},
+		Speed: SpeedBalance{
+			SatiatedThreshold:     SatiatedThreshold,
+			SatietySlowdownOffset: SatietySlowdownOffset,
+		},
+		Combat: CombatBalance{
+			WindupDuration: AttackWindupDuration,
+			StrikeDuration: AttackStrikeDuration,
+		},
+		Reproduction: ReproductionBalance{
+			SatiationThreshold: ReproductionSatiationThreshold,
+			SatiationCost:      ReproductionSatiationCost,
+			MatingRange:        MatingRange,
+			MutationRate:       ReproductionMutationRate,
+		},
+		Thirst: ThirstBalance{
+			DecreaseRate:               BaseHydrationDecreaseRate,
+			LargeAnimalRate:            LargeAnimalHydrationRate,
+			Threshold:                  ThirstThreshold,
+			WaterMemoryRangeMultiplier: WaterMemoryRangeMultiplier,
+			DrinkRate:                  DrinkRate,
+			DehydrationDamagePerSecond: DehydrationDamagePerSecond,
+		},
+		Pack: PackBalance{
+			MaxSize:        PackMaxSize,
+			FormationRange: PackFormationRange,
+			CohesionRange:  PackCohesionRange,
+			FlankDistance:  PackFlankDistance,
+		},
+	}
+}
+
+// animalBalanceFromConfig извлекает настраиваемые параметры вида из его конфигурации
+func animalBalanceFromConfig(config core.AnimalConfig, initialSatiation float32) AnimalBalance {
+	return AnimalBalance{
+		MaxHealth:          BalanceValue(config.MaxHealth),
+		BaseSpeed:          BalanceValue(config.BaseSpeed),
+		SatiationThreshold: BalanceValue(config.SatiationThreshold),
+		InitialSatiation:   BalanceValue(initialSatiation),
+		AttackDamage:       BalanceValue(config.AttackDamage),
+		AttackCooldown:     BalanceValue(config.AttackCooldown),
+		HitChance:          BalanceValue(config.HitChance),
+		SearchSpeed:        BalanceValue(config.SearchSpeed),
+		WanderingSpeed:     BalanceValue(config.WanderingSpeed),
+		ContentSpeed:       BalanceValue(config.ContentSpeed),
+		HerdRadius:         BalanceValue(config.HerdRadius),
+		HerdCohesion:       BalanceValue(config.HerdCohesion),
+		HerdAlignment:      BalanceValue(config.HerdAlignment),
+		HerdSeparation:     BalanceValue(config.HerdSeparation),
+		HearingRange:       BalanceValue(config.HearingRange),
+	}
+}
+
+// BalanceValue возвращает заданный параметр вида для AnimalBalance
+func BalanceValue[T int16 | float32](value T) *T {
+	return &value
+}
+
+// applyBalanceValue заменяет значение конфигурации заданным в профиле (nil - не задано)
+func applyBalanceValue[T int16 | float32](target *T, value *T) {
+	if value != nil {
+		*target = *value
+	}
+}
+
+// isNegative проверяет что параметр вида задан и отрицателен
+func isNegative[T int16 | float32](value *T) bool {
+	return value != nil && *value < 0
+}
+
+// LoadBalanceProfile загружает профиль баланса из YAML файла
+// Незаданные в файле параметры берутся из профиля по умолчанию
+func LoadBalanceProfile(filename string) (*BalanceProfile, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read balance profile: %w", err)
+	}
+
+	profile := DefaultBalanceProfile()
+	if err := yaml.Unmarshal(data, profile); err != nil {
+		return nil, fmt.Errorf("failed to parse balance profile %s: %w", filename, err)
+	}
+
+	if err := profile.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid balance profile %s: %w", filename, err)
+	}
+
+	return profile, nil
+}
+
+// SaveBalanceProfile сохраняет профиль баланса в YAML файл (шаблон для экспериментов)
+func SaveBalanceProfile(profile *BalanceProfile, filename string) error {
+	data, err := yaml.Marshal(profile)
+	if err != nil {
+		return fmt.Errorf("failed to marshal balance profile: %w", err)
+	}
+
+	if err := os.WriteFile(filename, data, BalanceProfileFilePermissions); err != nil {
+		return fmt.Errorf("failed to write balance profile: %w", err)
+	}
+
+	return nil
+}
+
+// MarshalBinary кодирует профиль в YAML для бинарных снапшотов
+// gob не передаёт нулевые значения - явный 0 в AnimalBalance после загрузки стал бы незаданным
+func (p *BalanceProfile) MarshalBinary() ([]byte, error) {
+	return yaml.Marshal(p)
+}
+
+// UnmarshalBinary восстанавливает профиль, закодированный MarshalBinary
+func (p *BalanceProfile) UnmarshalBinary(data []byte) error {
+	*p = BalanceProfile{}
+	return yaml.Unmarshal(data, p)
+}
+
+// BalanceProfileFilePermissions права доступа к файлу профиля (только владелец)
+const BalanceProfileFilePermissions = 0600
+
+// Validate проверяет корректность профиля баланса
+func (p *BalanceProfile) Validate() error {
+	for name, animal := range p.Animals {
+		if isNegative(animal.BaseSpeed) || isNegative(animal.AttackDamage) || isNegative(animal.AttackCooldown) {
+			return fmt.Errorf("animal %s: balance values cannot be negative", name)
+		}
+		if animal.MaxHealth != nil && *animal.MaxHealth <= 0 {
+			return fmt.Errorf("animal %s: max health must be positive, got %d", name, *animal.MaxHealth)
+		}
+		if animal.HitChance != nil && (*animal.HitChance < 0 || *animal.HitChance > 1) {
+			return fmt.Errorf("animal %s: hit chance must be between 0 and 1, got %f", name, *animal.HitChance)
+		}
+	}
+
+	positives := []struct {
+		name  string
+		value float32
+	}{
+		{"satiation.decrease_rate", p.Satiation.DecreaseRate},
+		{"satiation.large_animal_rate", p.Satiation.LargeAnimalRate},
+		{"feeding.grass_per_eating_tick", p.Feeding.GrassPerEatingTick},
+		{"feeding.grass_nutrition_value", p.Feeding.GrassNutritionValue},
+		{"feeding.corpse_nutrition_per_tick", p.Feeding.CorpseNutritionPerTick},
+		{"vegetation.grass_max_amount", p.Vegetation.GrassMaxAmount},
+		{"corpses.nutritional_value", p.Corpses.NutritionalValue},
+		{"corpses.decay_time", p.Corpses.DecayTime},
+		{"combat.windup_duration", p.Combat.WindupDuration},
+		{"combat.strike_duration", p.Combat.StrikeDuration},
+		{"reproduction.mating_range", p.Reproduction.MatingRange},
+		{"thirst.decrease_rate", p.Thirst.DecreaseRate},
+		{"thirst.drink_rate", p.Thirst.DrinkRate},
+		{"pack.formation_range", p.Pack.FormationRange},
+		{"pack.flank_distance", p.Pack.FlankDistance},
+	}
+	for _, field := range positives {
+		if field.value <= 0 {
+			return fmt.Errorf("%s must be positive, got %f", field.name, field.value)
+		}
+	}
+
+	if p.Vegetation.GrassGrowthRate < 0 || p.Satiation.StarvationDamagePerSecond < 0 ||
+		p.Thirst.DehydrationDamagePerSecond < 0 || p.Reproduction.SatiationCost < 0 {
+		return fmt.Errorf("growth rate, damage and costs cannot be negative")
+	}
+
+	if p.Feeding.MinGrassAmountToFind < 0 || p.Feeding.MinGrassAmountToFind > p.Vegetation.GrassMaxAmount {
+		return fmt.Errorf("feeding.min_grass_amount_to_find must be between 0 and grass_max_amount, got %f",
+			p.Feeding.MinGrassAmountToFind)
+	}
+
+	if p.Pack.MaxSize < 1 || p.Pack.MaxSize > constants.BitsPerUint64 {
+		return fmt.Errorf("pack.max_size must be between 1 and %d, got %d", constants.BitsPerUint64, p.Pack.MaxSize)
+	}
+	if p.Pack.CohesionRange < p.Pack.FormationRange {
+		return fmt.Errorf("pack.cohesion_range must be at least formation_range, got %f", p.Pack.CohesionRange)
+	}
+
+	if p.Reproduction.MutationRate < 0 || p.Reproduction.MutationRate >= 1 {
+		return fmt.Errorf("reproduction.mutation_rate must be in [0, 1), got %f", p.Reproduction.MutationRate)
+	}
+
+	return nil
+}
+
+// ApplyAnimalBalance применяет параметры вида из профиля к конфигурации животного
+func (p *BalanceProfile) ApplyAnimalBalance(animalType core.AnimalType, config core.AnimalConfig) core.AnimalConfig {
+	animal, exists := p.Animals[strings.ToLower(animalType.String())]
+	if !exists {
+		return config
+	}
+
+	applyBalanceValue(&config.MaxHealth, animal.MaxHealth)
+	applyBalanceValue(&config.BaseSpeed, animal.BaseSpeed)
+	applyBalanceValue(&config.SatiationThreshold, animal.SatiationThreshold)
+	applyBalanceValue(&config.AttackDamage, animal.AttackDamage)
+	applyBalanceValue(&config.AttackCooldown, animal.AttackCooldown)
+	applyBalanceValue(&config.HitChance, animal.HitChance)
+	applyBalanceValue(&config.SearchSpeed, animal.SearchSpeed)
+	applyBalanceValue(&config.WanderingSpeed, animal.WanderingSpeed)
+	applyBalanceValue(&config.ContentSpeed, animal.ContentSpeed)
+	applyBalanceValue(&config.HerdRadius, animal.HerdRadius)
+	applyBalanceValue(&config.HerdCohesion, animal.HerdCohesion)
+	applyBalanceValue(&config.HerdAlignment, animal.HerdAlignment)
+	applyBalanceValue(&config.HerdSeparation, animal.HerdSeparation)
+	applyBalanceValue(&config.HearingRange, animal.HearingRange)
+
+	return config
+}
+
+// InitialSatiation возвращает начальную сытость вида с учётом профиля
+func (p *BalanceProfile) InitialSatiation(animalType core.AnimalType) float32 {
+	satiation := GetInitialSatiationForAnimal(animalType)
+	if animal, exists := p.Animals[strings.ToLower(animalType.String())]; exists {
+		applyBalanceValue(&satiation, animal.InitialSatiation)
+	}
+	return satiation
+}
+
+// BalanceConfigurable система с настраиваемым профилем баланса
+type BalanceConfigurable interface {
+	SetBalanceProfile(profile *BalanceProfile)
+}
+
+// ApplyBalanceProfile передаёт профиль баланса системам (nil - профиль по умолчанию)
+func ApplyBalanceProfile(profile *BalanceProfile, systems ...BalanceConfigurable) {
+	for _, system := range systems {
+		system.SetBalanceProfile(profile)
+	}
+}
+
+// balanced встраиваемая поддержка профиля баланса для систем
+// Нулевое значение использует профиль по умолчанию - конструкторы систем не меняются
+type balanced struct {
+	balance *BalanceProfile
+}
+
+// SetBalanceProfile устанавливает профиль баланса системы (nil - профиль по умолчанию)
+func (b *balanced) SetBalanceProfile(profile *BalanceProfile) {
+	b.balance = profile
+}
+
+// profile возвращает действующий профиль баланса
+func (b *balanced) profile() *BalanceProfile {
+	if b.balance == nil {
+		return defaultBalanceProfile
+	}
+	return b.balance
+}
+
+// CreateAnimalWithBalance создаёт животное с параметрами вида из профиля баланса
+// Потомки наследуют конфигурацию родителей, поэтому профиль применяется к начальной популяции
+func CreateAnimalWithBalance(
+	world *core.World,
+	profile *BalanceProfile,
+	animalType core.AnimalType,
+	x, y float32,
+) core.EntityID {
+	if profile == nil {
+		return CreateAnimal(world, animalType, x, y)
+	}
+
+	config := profile.ApplyAnimalBalance(animalType, CreateAnimalConfig(animalType))
+	entity := createEntityFromConfig(world, animalType, config, x, y)
+	world.SetSatiation(entity, core.Satiation{Value: profile.InitialSatiation(animalType)})
+	return entity
+}
@@ -0,0 +1,171 @@
+package simulation
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/aiseeq/savanna/internal/core"
+)
+
+const testBalanceFile = "../../config/balance.yaml"
+
+func TestDefaultBalanceProfile_MatchesConstants(t *testing.T) {
+	profile := DefaultBalanceProfile()
+
+	if err := profile.Validate(); err != nil {
+		t.Fatalf("Default profile should be valid: %v", err)
+	}
+
+	if profile.Satiation.DecreaseRate != BaseSatiationDecreaseRate ||
+		profile.Vegetation.GrassGrowthRate != GrassGrowthRate ||
+		profile.Corpses.DecayTime != CorpseDecayTime ||
+		profile.Speed.SatiatedThreshold != SatiatedThreshold {
+		t.Error("Default profile should match balance constants")
+	}
+
+	wolf := profile.Animals["wolf"]
+	if *wolf.AttackDamage != WolfAttackDamageDefault || *wolf.InitialSatiation != WolfInitialSatiation {
+		t.Errorf("Wolf balance should match constants, got %+v", wolf)
+	}
+
+	// Профиль по умолчанию не меняет конфигурацию животных
+	config := CreateAnimalConfig(core.TypeRabbit)
+	if applied := profile.ApplyAnimalBalance(core.TypeRabbit, config); applied != config {
+		t.Errorf("Default profile should not change rabbit config: %+v vs %+v", applied, config)
+	}
+}
+
+func TestLoadBalanceProfile_ConfigFileMatchesDefaults(t *testing.T) {
+	profile, err := LoadBalanceProfile(testBalanceFile)
+	if err != nil {
+		t.Fatalf("Failed to load %s: %v", testBalanceFile, err)
+	}
+
+	if !reflect.DeepEqual(profile, DefaultBalanceProfile()) {
+		t.Errorf("%s should hold the default profile", testBalanceFile)
+	}
+}
+
+func TestLoadBalanceProfile_PartialOverride(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fast_grass.yaml")
+	data := "name: fast_grass\nvegetation:\n  grass_growth_rate: 2.5\nanimals:\n  wolf:\n    attack_damage: 10\n"
+	if err := os.WriteFile(path, []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	profile, err := LoadBalanceProfile(path)
+	if err != nil {
+		t.Fatalf("Failed to load profile: %v", err)
+	}
+
+	if profile.Name != "fast_grass" || profile.Vegetation.GrassGrowthRate != 2.5 {
+		t.Errorf("Profile values should be overridden, got %+v", profile.Vegetation)
+	}
+	if profile.Vegetation.GrassMaxAmount != GrassMaxAmount || profile.Corpses.DecayTime != CorpseDecayTime {
+		t.Error("Unset values should keep defaults")
+	}
+	// Незаданные поля вида остаются значениями конфигурации вида
+	wolf := profile.ApplyAnimalBalance(core.TypeWolf, CreateAnimalConfig(core.TypeWolf))
+	if wolf.AttackDamage != 10 || wolf.MaxHealth != WolfMaxHealth {
+		t.Errorf("Wolf override should keep other fields, got %+v", wolf)
+	}
+}
+
+func TestLoadBalanceProfile_ExplicitZeroApplies(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "no_cooldown.yaml")
+	data := "name: no_cooldown\nanimals:\n  wolf:\n    attack_cooldown: 0\n    search_speed: 0\n"
+	if err := os.WriteFile(path, []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	profile, err := LoadBalanceProfile(path)
+	if err != nil {
+		t.Fatalf("Failed to load profile: %v", err)
+	}
+
+	// Явный 0 - значение параметра, а не "не задано"
+	wolf := profile.ApplyAnimalBalance(core.TypeWolf, CreateAnimalConfig(core.TypeWolf))
+	if wolf.AttackCooldown != 0 || wolf.SearchSpeed != 0 {
+		t.Errorf("Explicit zero should be applied, got cooldown %f, search speed %f", wolf.AttackCooldown, wolf.SearchSpeed)
+	}
+	if wolf.AttackDamage != WolfAttackDamageDefault || wolf.MaxHealth != WolfMaxHealth {
+		t.Errorf("Unset wolf fields should keep species values, got %+v", wolf)
+	}
+}
+
+func TestBalanceProfile_ValidateErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(p *BalanceProfile)
+	}{
+		{"zero satiation rate", func(p *BalanceProfile) { p.Satiation.DecreaseRate = 0 }},
+		{"negative growth", func(p *BalanceProfile) { p.Vegetation.GrassGrowthRate = -1 }},
+		{"zero decay time", func(p *BalanceProfile) { p.Corpses.DecayTime = 0 }},
+		{"min grass above max", func(p *BalanceProfile) { p.Feeding.MinGrassAmountToFind = GrassMaxAmount + 1 }},
+		{"mutation rate too high", func(p *BalanceProfile) { p.Reproduction.MutationRate = 1 }},
+		{"hit chance above one", func(p *BalanceProfile) {
+			p.Animals["wolf"] = AnimalBalance{HitChance: BalanceValue[float32](1.5)}
+		}},
+		{"negative animal health", func(p *BalanceProfile) {
+			p.Animals["rabbit"] = AnimalBalance{MaxHealth: BalanceValue[int16](-5)}
+		}},
+		{"zero animal health", func(p *BalanceProfile) {
+			p.Animals["rabbit"] = AnimalBalance{MaxHealth: BalanceValue[int16](0)}
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile := DefaultBalanceProfile()
+			tt.modify(profile)
+			if err := profile.Validate(); err == nil {
+				t.Errorf("Expected validation error for %s", tt.name)
+			}
+		})
+	}
+}
+
+func TestBalanceProfile_ChangesSystemBehavior(t *testing.T) {
+	profile := DefaultBalanceProfile()
+	profile.Satiation.DecreaseRate = BaseSatiationDecreaseRate * 2
+	profile.Animals["rabbit"] = AnimalBalance{
+		MaxHealth:        BalanceValue[int16](80),
+		InitialSatiation: BalanceValue[float32](90),
+	}
+
+	world := core.NewWorld(640, 640, 12345)
+	defaultRabbit := CreateAnimal(world, core.TypeRabbit, 100, 100)
+	tunedRabbit := CreateAnimalWithBalance(world, profile, core.TypeRabbit, 300, 300)
+
+	if health, _ := world.GetHealth(tunedRabbit); health.Max != 80 {
+		t.Errorf("Profile max_health should apply to new animals, got %d", health.Max)
+	}
+	if satiation, _ := world.GetSatiation(tunedRabbit); satiation.Value != 90 {
+		t.Errorf("Profile initial_satiation should apply, got %f", satiation.Value)
+	}
+
+	world.SetSatiation(defaultRabbit, core.Satiation{Value: 50})
+	world.SetSatiation(tunedRabbit, core.Satiation{Value: 50})
+
+	defaultSystem := NewSatiationSystem()
+	tunedSystem := NewSatiationSystem()
+	tunedSystem.SetBalanceProfile(profile)
+
+	for _, update := range []struct {
+		system *SatiationSystem
+		rabbit core.EntityID
+	}{{defaultSystem, defaultRabbit}, {tunedSystem, tunedRabbit}} {
+		if satiation, ok := update.system.updateSatiation(world, update.rabbit, 1); ok {
+			world.SetSatiation(update.rabbit, satiation)
+		}
+	}
+
+	defaultSatiation, _ := world.GetSatiation(defaultRabbit)
+	tunedSatiation, _ := world.GetSatiation(tunedRabbit)
+	if defaultSatiation.Value != 50-BaseSatiationDecreaseRate || tunedSatiation.Value != 50-2*BaseSatiationDecreaseRate {
+		t.Errorf("Satiation should drop by profile rate: default %f, tuned %f",
+			defaultSatiation.Value, tunedSatiation.Value)
+	}
+}
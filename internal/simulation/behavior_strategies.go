@@ -13,16 +13,124 @@ type BehaviorStrategy interface {
 	UpdateBehavior(world core.BehaviorSystemAccess, entity core.EntityID, components AnimalComponents) core.Velocity
 }
 
+// SensingStrategy стратегия, которой нужно восприятие окружения до принятия решения
+// Sense только читает мир: AnimalBehaviorSystem вызывает её параллельно по секторам карты
+type SensingStrategy interface {
+	Sense(world core.BehaviorSystemAccess, entity core.EntityID, components AnimalComponents) AnimalSenses
+}
+
+// AnimalSenses ближайшие хищник и добыча в радиусе зрения, найденные до принятия решений
+// Решения животных не двигают других животных, поэтому результат тот же, что и при поиске во время решения
+type AnimalSenses struct {
+	Sensed      bool // false - стратегия ищет сама
+	Predator    core.EntityID
+	HasPredator bool
+	Alarmed     bool // Хищника заметил сородич по стаду, а не само животное
+	Prey        core.EntityID
+	HasPrey     bool
+	Herd        vec2.Vec2 // Поправка направления от соседей по стаду (доли скорости)
+}
+
 // HerbivoreBehaviorStrategy стратегия поведения травоядных
 type HerbivoreBehaviorStrategy struct {
+	drinkingBehavior
 	vegetation VegetationProvider
 }
 
 // NewHerbivoreBehaviorStrategy создаёт новую стратегию травоядных
-func NewHerbivoreBehaviorStrategy(vegetation VegetationProvider) *HerbivoreBehaviorStrategy {
-	return &HerbivoreBehaviorStrategy{
-		vegetation: vegetation,
+// water может быть nil - тогда животные не ищут водопой, terrain nil - бегут не глядя на воду
+// Если terrain реализует core.CoverProvider, мелкие травоядные прячутся от хищников в кустах
+func NewHerbivoreBehaviorStrategy(
+	vegetation VegetationProvider,
+	water core.WaterProvider,
+	terrain core.PassabilityProvider,
+) *HerbivoreBehaviorStrategy {
+	strategy := &HerbivoreBehaviorStrategy{
+		drinkingBehavior: newDrinkingBehavior(water, terrain),
+		vegetation:       vegetation,
+	}
+	strategy.cover, _ = terrain.(core.CoverProvider)
+	return strategy
+}
+
+// findNearestWithDiet находит ближайшее животное любого вида с одним из типов питания (радиус в тайлах)
+// При равном расстоянии выбирается меньший ID, как в FindNearestByTypeInTiles.
+// Спрятавшиеся в кустах животные заметны только на доле радиуса (Concealment)
+func findNearestWithDiet(
+	world core.BehaviorSystemAccess,
+	pos core.Position,
+	radiusInTiles float32,
+	diets ...core.BehaviorType,
+) (core.EntityID, bool) {
+	var nearest core.EntityID
+	bestDistance := float32(LargeDistanceValue)
+	found := false
+
+	for _, diet := range diets {
+		for _, animalType := range AnimalTypesWithDiet(diet) {
+			candidate, ok := findNearestVisible(world, pos, radiusInTiles, animalType)
+			if !ok {
+				continue
+			}
+
+			candidatePos, _ := world.GetPosition(candidate)
+			distance := pos.DistanceSquaredTo(candidatePos)
+			if !found || distance < bestDistance || (distance == bestDistance && candidate < nearest) {
+				nearest = candidate
+				bestDistance = distance
+				found = true
+			}
+		}
+	}
+
+	return nearest, found
+}
+
+// findNearestPrey находит ближайшую заметную добычу хищника (травоядное или всеядное)
+func findNearestPrey(world core.BehaviorSystemAccess, pos core.Position, radiusInTiles float32) (core.EntityID, bool) {
+	return findNearestWithDiet(world, pos, radiusInTiles, preyDiets...)
+}
+
+// findNearestVisible находит ближайшее заметное животное вида: если ближайшее спряталось,
+// перебирает остальных (редкий случай - обычно хватает FindNearestByTypeInTiles)
+func findNearestVisible(
+	world core.BehaviorSystemAccess,
+	pos core.Position,
+	radiusInTiles float32,
+	animalType core.AnimalType,
+) (core.EntityID, bool) {
+	nearest, found := world.FindNearestByTypeInTiles(pos.X, pos.Y, radiusInTiles, animalType)
+	if !found || isVisibleFrom(world, pos, nearest, radiusInTiles) {
+		return nearest, found
+	}
+
+	radius := constants.TilesToPixels(radiusInTiles)
+	bestDistance := radius * radius
+	found = false
+	world.ForEachWith(core.MaskPosition|core.MaskAnimalType, func(entity core.EntityID) {
+		if entityType, _ := world.GetAnimalType(entity); entityType != animalType {
+			return
+		}
+		entityPos, _ := world.GetPosition(entity)
+		distance := pos.DistanceSquaredTo(entityPos)
+		if distance < bestDistance && isVisibleFrom(world, pos, entity, radiusInTiles) {
+			nearest, bestDistance, found = entity, distance, true
+		}
+	})
+
+	return nearest, found
+}
+
+// isVisibleFrom проверяет что животное заметно с дальности зрения radiusInTiles с учётом укрытия
+func isVisibleFrom(world core.BehaviorSystemAccess, from core.Position, entity core.EntityID, radiusInTiles float32) bool {
+	concealment, hidden := world.GetConcealment(entity)
+	if !hidden {
+		return true
 	}
+
+	entityPos, _ := world.GetPosition(entity)
+	visibleRange := constants.TilesToPixels(radiusInTiles * concealment.Visibility)
+	return from.DistanceSquaredTo(entityPos) <= visibleRange*visibleRange
 }
 
 // AnimalComponents группирует компоненты животного для поведения
@@ -32,6 +140,67 @@ type AnimalComponents struct {
 	Position     core.Position
 	Speed        core.Speed
 	Satiation    core.Satiation
+	Hydration    core.Hydration
+	Senses       AnimalSenses
+}
+
+// Sense находит ближайшего хищника (побег - первый приоритет травоядного) и соседей по стаду
+func (h *HerbivoreBehaviorStrategy) Sense(
+	world core.BehaviorSystemAccess,
+	entity core.EntityID,
+	components AnimalComponents,
+) AnimalSenses {
+	senses := AnimalSenses{Sensed: true}
+	senses.Predator, senses.HasPredator = findNearestWithDiet(
+		world, components.Position, components.AnimalConfig.VisionRange, core.BehaviorPredator,
+	)
+	senses.Herd = herdSteering(world, entity, components)
+	return senses
+}
+
+// herdSteering поправка направления от соседей того же вида (boids): притяжение к центру стада,
+// выравнивание по их движению и отталкивание от слишком близких. Веса правил задаёт вид
+func herdSteering(world core.BehaviorSystemAccess, entity core.EntityID, components AnimalComponents) vec2.Vec2 {
+	config := components.AnimalConfig
+	if config.HerdRadius <= 0 {
+		return vec2.Zero()
+	}
+
+	animalType, _ := world.GetAnimalType(entity)
+	herdRadius := constants.TilesToPixels(config.HerdRadius)
+	separationRadius := constants.TilesToPixels(config.CollisionRadius * HerdSeparationRadiusMultiplier)
+
+	var center, heading, separation vec2.Vec2
+	neighbors := 0
+	for _, neighbor := range world.QueryInRadius(components.Position.X, components.Position.Y, herdRadius) {
+		neighborType, _ := world.GetAnimalType(neighbor)
+		if neighbor == entity || neighborType != animalType || !world.HasComponent(neighbor, core.MaskBehavior) {
+			continue
+		}
+
+		neighborPos, _ := world.GetPosition(neighbor)
+		velocity, _ := world.GetVelocity(neighbor)
+		center = center.Add(vec2.New(neighborPos.X, neighborPos.Y))
+		heading = heading.Add(vec2.New(velocity.X, velocity.Y).Normalize())
+		neighbors++
+
+		// Чем ближе сосед, тем сильнее отталкивание
+		away := components.Position.Sub(neighborPos)
+		if distance := away.Length(); distance < separationRadius && distance > 0 {
+			separation = separation.Add(vec2.New(away.X, away.Y).Normalize().Scale(1 - distance/separationRadius))
+		}
+	}
+	if neighbors == 0 {
+		return vec2.Zero()
+	}
+
+	// Притяжение растёт с удалением от центра: у края радиуса стада - полный вес
+	center = center.Scale(1 / float32(neighbors))
+	cohesion := center.Sub(vec2.New(components.Position.X, components.Position.Y)).Scale(1 / herdRadius)
+
+	return cohesion.Scale(config.HerdCohesion).
+		Add(heading.Normalize().Scale(config.HerdAlignment)).
+		Add(separation.Scale(config.HerdSeparation))
 }
 
 // UpdateBehavior реализует поведение травоядных (KISS: упрощено разбиением на методы)
@@ -45,12 +214,17 @@ func (h *HerbivoreBehaviorStrategy) UpdateBehavior(
 		return *velocity
 	}
 
-	// ПРИОРИТЕТ 2: Если голоден ИЛИ уже ест - обрабатываем поедание травы
+	// ПРИОРИТЕТ 2: Если хочет пить ИЛИ уже пьёт - идём к водопою
+	if velocity := h.handleDrinking(world, entity, components); velocity != nil {
+		return *velocity
+	}
+
+	// ПРИОРИТЕТ 3: Если голоден ИЛИ уже ест - обрабатываем поедание травы
 	if velocity := h.handleFeeding(world, entity, components); velocity != nil {
 		return *velocity
 	}
 
-	// ПРИОРИТЕТ 3: Если сыт - спокойное движение или отдых
+	// ПРИОРИТЕТ 4: Если сыт - спокойное движение или отдых
 	return h.handleIdleBehavior(world, entity, components)
 }
 
@@ -60,20 +234,29 @@ func (h *HerbivoreBehaviorStrategy) handlePredatorEscape(
 	entity core.EntityID,
 	components AnimalComponents,
 ) *core.Velocity {
-	nearestPredator, foundPredator := world.FindNearestByTypeInTiles(
-		components.Position.X, components.Position.Y,
-		components.AnimalConfig.VisionRange, core.TypeWolf,
-	)
-	if !foundPredator {
+	senses := components.Senses
+	if !senses.Sensed {
+		senses = h.Sense(world, entity, components)
+	}
+	if !senses.HasPredator {
 		return nil // Хищника нет
 	}
 
-	// КРИТИЧЕСКИ ВАЖНО: прерываем поедание травы при побеге
+	// КРИТИЧЕСКИ ВАЖНО: прерываем поедание травы и питьё при побеге
 	if world.HasComponent(entity, core.MaskEatingState) {
 		world.RemoveEatingState(entity)
 	}
+	if world.HasComponent(entity, core.MaskDrinkingState) {
+		world.RemoveDrinkingState(entity)
+	}
 
-	predatorPos, _ := world.GetPosition(nearestPredator)
+	world.EmitEvent(core.Fleeing{Entity: entity, Predator: senses.Predator})
+	predatorPos, _ := world.GetPosition(senses.Predator)
+
+	// Мелкие травоядные бегут в ближайший куст, если хищник не ближе к нему
+	if velocity := h.escapeToCover(world, entity, components, senses.Predator); velocity != nil {
+		return velocity
+	}
 
 	// ОПТИМИЗАЦИЯ: элегантное направление побега используя методы Position
 	escapeVector := components.Position.Sub(predatorPos).Normalize() // Вектор от хищника к нам
@@ -84,7 +267,10 @@ func (h *HerbivoreBehaviorStrategy) handlePredatorEscape(
 	boundaryRepulsion := h.calculateBoundaryRepulsion(components.Position, worldWidth, worldHeight)
 
 	// Комбинируем направление побега с отталкиванием (комплексная арифметика!)
-	finalEscapeDirection := escapeDirection.Add(boundaryRepulsion).Normalize()
+	// и сворачиваем, если путь упирается в воду или кусты
+	finalEscapeDirection := h.avoidImpassable(
+		components.Position, escapeDirection.Add(boundaryRepulsion).Normalize(), components.AnimalConfig.CollisionRadius,
+	)
 
 	// Обновляем таймер направления в поведении
 	components.Behavior.DirectionTimer = components.AnimalConfig.MinDirectionTime
@@ -96,6 +282,53 @@ func (h *HerbivoreBehaviorStrategy) handlePredatorEscape(
 	return &resultVelocity
 }
 
+// escapeToCover прячет мелкое травоядное от хищника: в кусте оно замирает, пока хищник его не видит,
+// рядом с кустом - бежит в него. Возвращает nil если животное крупное, кустов поблизости нет,
+// хищник отрезает путь к укрытию или уже заметил спрятавшегося - тогда животное убегает как обычно
+func (h *HerbivoreBehaviorStrategy) escapeToCover(
+	world core.BehaviorSystemAccess,
+	entity core.EntityID,
+	components AnimalComponents,
+	predator core.EntityID,
+) *core.Velocity {
+	config := components.AnimalConfig
+	predatorPos, _ := world.GetPosition(predator)
+	if h.cover == nil || !canHideInCover(config.Diet, config.CollisionRadius) {
+		return nil
+	}
+
+	x, y := constants.PositionToTiles(components.Position.X, components.Position.Y)
+	if h.cover.IsCover(constants.TileIndex(x), constants.TileIndex(y)) {
+		predatorConfig, _ := world.GetAnimalConfig(predator)
+		if isVisibleFrom(world, predatorPos, entity, predatorConfig.VisionRange) {
+			return nil // Хищник заметил - бежим сквозь кусты, куда он не пролезет
+		}
+		zeroVel := core.NewVelocity(0, 0)
+		return &zeroVel // Хищник не видит - замираем в кусте
+	}
+
+	coverX, coverY, found := h.cover.FindNearestCover(
+		components.Position.X, components.Position.Y, constants.TilesToPixels(config.VisionRange),
+	)
+	if !found {
+		return nil
+	}
+	coverPos := core.NewPosition(coverX, coverY)
+	if predatorPos.DistanceSquaredTo(coverPos) <= components.Position.DistanceSquaredTo(coverPos) {
+		return nil // Хищник ближе к кусту - бежим прочь
+	}
+
+	direction := coverPos.Sub(components.Position).Normalize()
+	coverDirection := h.avoidImpassable(components.Position, vec2.New(direction.X, direction.Y), config.CollisionRadius)
+
+	components.Behavior.DirectionTimer = config.MinDirectionTime
+	world.SetBehavior(entity, components.Behavior)
+
+	speed := components.Speed.Current
+	resultVelocity := core.Velocity{X: coverDirection.X * speed, Y: coverDirection.Y * speed}
+	return &resultVelocity
+}
+
 // handleFeeding обрабатывает поиск и поедание травы (KISS: выделено в отдельный метод)
 func (h *HerbivoreBehaviorStrategy) handleFeeding(
 	world core.BehaviorSystemAccess,
@@ -125,7 +358,7 @@ func (h *HerbivoreBehaviorStrategy) checkLocalGrass(
 	collisionRadiusPixels := constants.TilesToPixels(config.CollisionRadius) // Конвертируем physics.Tiles
 	localGrassX, localGrassY, hasLocalGrass := h.vegetation.FindNearestGrass(
 		components.Position.X, components.Position.Y,
-		collisionRadiusPixels, MinGrassAmountToFind,
+		collisionRadiusPixels, h.profile().Feeding.MinGrassAmountToFind,
 	)
 	if !hasLocalGrass {
 		return nil
@@ -157,13 +390,12 @@ func (h *HerbivoreBehaviorStrategy) searchForGrass(
 	visionRangePixels := constants.TilesToPixels(components.AnimalConfig.VisionRange)
 	grassX, grassY, foundGrass := h.vegetation.FindNearestGrass(
 		components.Position.X, components.Position.Y,
-		visionRangePixels, MinGrassAmountToFind,
+		visionRangePixels, h.profile().Feeding.MinGrassAmountToFind,
 	)
 	if foundGrass {
 		// ОПТИМИЗАЦИЯ: элегантное направление к траве через методы Position
-		grassPos := core.NewPosition(grassX, grassY)
-		grassVector := grassPos.Sub(components.Position).Normalize()
-		grassDir := vec2.New(grassVector.X, grassVector.Y)
+		// Направление к следующей точке маршрута: трава за водой или кустами обходится
+		grassDir := h.directionTo(components.Position, core.NewPosition(grassX, grassY))
 
 		// КРИТИЧЕСКОЕ ИСПРАВЛЕНИЕ: Добавляем избегание близких животных
 		avoidanceDir := h.calculateAvoidanceDirection(world, entity, components)
@@ -187,11 +419,11 @@ func (h *HerbivoreBehaviorStrategy) searchForGrass(
 		return &resultVel
 	}
 
-	// Трава не найдена - продолжаем случайное движение в поисках
-	vel := RandomWalk.GetRandomWalkVelocity(
-		world, entity, components.Behavior,
-		components.Speed.Current*components.AnimalConfig.WanderingSpeed,
-	)
+	// Трава не найдена - продолжаем случайное движение в поисках вместе со стадом
+	maxSpeed := components.Speed.Current * components.AnimalConfig.WanderingSpeed
+	vel := h.flock(world, entity, components, RandomWalk.GetRandomWalkVelocity(
+		world, entity, components.Behavior, maxSpeed,
+	), maxSpeed)
 	return &vel
 }
 
@@ -201,20 +433,70 @@ func (h *HerbivoreBehaviorStrategy) handleIdleBehavior(
 	entity core.EntityID,
 	components AnimalComponents,
 ) core.Velocity {
-	return RandomWalk.GetRandomWalkVelocity(
-		world, entity, components.Behavior,
-		components.Speed.Current*components.AnimalConfig.ContentSpeed,
-	)
+	maxSpeed := components.Speed.Current * components.AnimalConfig.ContentSpeed
+	return h.flock(world, entity, components, RandomWalk.GetRandomWalkVelocity(
+		world, entity, components.Behavior, maxSpeed,
+	), maxSpeed)
+}
+
+// flock доворачивает случайное движение к стаду: поправка копится от решения к решению,
+// поэтому животное плавно сходится с соседями, не теряя случайности блуждания
+func (h *HerbivoreBehaviorStrategy) flock(
+	world core.BehaviorSystemAccess,
+	entity core.EntityID,
+	components AnimalComponents,
+	velocity core.Velocity,
+	maxSpeed float32,
+) core.Velocity {
+	senses := components.Senses
+	if !senses.Sensed {
+		senses = h.Sense(world, entity, components)
+	}
+	if senses.Herd.LengthSquared() == 0 || maxSpeed <= 0 {
+		return velocity
+	}
+
+	direction := vec2.New(velocity.X, velocity.Y).Add(senses.Herd.Scale(maxSpeed)).Clamp(maxSpeed)
+
+	// Обход воды и кустов сохраняет скорость, меняя только направление
+	speed := direction.Length()
+	direction = h.avoidImpassable(components.Position, direction.Normalize(), components.AnimalConfig.CollisionRadius)
+	return core.Velocity{X: direction.X * speed, Y: direction.Y * speed}
 }
 
 // УДАЛЕНО: getRandomWalkVelocityWithBehavior заменена на RandomWalk.GetRandomWalkVelocity
 
 // PredatorBehaviorStrategy стратегия поведения хищников
-type PredatorBehaviorStrategy struct{}
+type PredatorBehaviorStrategy struct {
+	drinkingBehavior
+}
 
 // NewPredatorBehaviorStrategy создаёт новую стратегию хищников
-func NewPredatorBehaviorStrategy() *PredatorBehaviorStrategy {
-	return &PredatorBehaviorStrategy{}
+// water может быть nil - тогда хищники не ищут водопой, terrain nil - гонятся напрямик
+func NewPredatorBehaviorStrategy(water core.WaterProvider, terrain core.PassabilityProvider) *PredatorBehaviorStrategy {
+	return &PredatorBehaviorStrategy{
+		drinkingBehavior: newDrinkingBehavior(water, terrain),
+	}
+}
+
+// Sense находит добычу, если хищник голоден и не ест (иначе он не охотится)
+// Член стаи преследует общую цель, выбранную вожаком, одиночка - ближайшую добычу
+func (p *PredatorBehaviorStrategy) Sense(
+	world core.BehaviorSystemAccess,
+	entity core.EntityID,
+	components AnimalComponents,
+) AnimalSenses {
+	senses := AnimalSenses{Sensed: true}
+	if components.Satiation.Value < components.AnimalConfig.SatiationThreshold &&
+		!world.HasComponent(entity, core.MaskEatingState) {
+		if membership, inPack := world.GetPackMembership(entity); inPack && membership.Target != 0 &&
+			world.IsAlive(membership.Target) {
+			senses.Prey, senses.HasPrey = membership.Target, true
+			return senses
+		}
+		senses.Prey, senses.HasPrey = findNearestPrey(world, components.Position, components.AnimalConfig.VisionRange)
+	}
+	return senses
 }
 
 // UpdateBehavior реализует поведение хищников (заменяет updatePredatorBehavior)
@@ -228,21 +510,28 @@ func (p *PredatorBehaviorStrategy) UpdateBehavior(
 		return core.NewVelocity(0, 0) // Волк стоит на месте при поедании
 	}
 
+	// Жажда важнее охоты: хищник идёт к водопою
+	if velocity := p.handleDrinking(world, entity, components); velocity != nil {
+		return *velocity
+	}
+
 	// Хищники охотятся только когда голодны
 	if components.Satiation.Value < components.AnimalConfig.SatiationThreshold {
 		// ЭЛЕГАНТНАЯ МАТЕМАТИКА: прямое использование комплексной позиции
 
 		// Ищем ближайшую добычу (травоядных)
-		nearestPrey, foundPrey := world.FindNearestByTypeInTiles(
-			components.Position.X, components.Position.Y,
-			components.AnimalConfig.VisionRange, core.TypeRabbit, // РЕФАКТОРИНГ: используем AnimalConfig вместо Behavior
-		)
-		if foundPrey {
-			preyPos, _ := world.GetPosition(nearestPrey)
+		senses := components.Senses
+		if !senses.Sensed {
+			senses = p.Sense(world, entity, components)
+		}
+		if senses.HasPrey {
+			preyPos, _ := world.GetPosition(senses.Prey)
+			destination := p.packDestination(world, entity, components.Position, senses.Prey, preyPos)
 
 			// ОПТИМИЗАЦИЯ: элегантное направление к добыче через методы Position
-			huntVector := preyPos.Sub(components.Position).Normalize()
-			huntDir := vec2.New(huntVector.X, huntVector.Y)
+			huntDir := p.avoidImpassable(
+				components.Position, p.directionTo(components.Position, destination), components.AnimalConfig.CollisionRadius,
+			)
 
 			// Обновляем таймер направления в поведении используя значения из AnimalConfig
 			components.Behavior.DirectionTimer = components.AnimalConfig.MinDirectionTime
@@ -252,14 +541,20 @@ func (p *PredatorBehaviorStrategy) UpdateBehavior(
 			speed := components.Speed.Current * components.AnimalConfig.SearchSpeed
 			return core.Velocity{X: huntDir.X * speed, Y: huntDir.Y * speed}
 		} else {
-			// Добыча не найдена - блуждаем в поисках
+			// Добыча не найдена - отставший загонщик догоняет стаю, остальные блуждают в поисках
+			if velocity := p.regroup(world, entity, components); velocity != nil {
+				return *velocity
+			}
 			return RandomWalk.GetRandomWalkVelocity(
 				world, entity, components.Behavior,
 				components.Speed.Current*components.AnimalConfig.WanderingSpeed,
 			)
 		}
 	} else {
-		// Сыт - спокойное движение
+		// Сыт - спокойное движение рядом со стаей
+		if velocity := p.regroup(world, entity, components); velocity != nil {
+			return *velocity
+		}
 		return RandomWalk.GetRandomWalkVelocity(
 			world, entity, components.Behavior,
 			components.Speed.Current*components.AnimalConfig.ContentSpeed,
@@ -267,6 +562,210 @@ func (p *PredatorBehaviorStrategy) UpdateBehavior(
 	}
 }
 
+// packDestination точка погони: вожак и одиночка бегут к добыче, загонщик - к точке перехвата
+// впереди неё на линии бегства от вожака (нечётные номера обходят слева, чётные справа, дальние - шире).
+// Вблизи добычи и к трупу загонщик идёт прямо к цели
+func (p *PredatorBehaviorStrategy) packDestination(
+	world core.BehaviorSystemAccess,
+	entity core.EntityID,
+	position core.Position,
+	prey core.EntityID,
+	preyPos core.Position,
+) core.Position {
+	membership, inPack := world.GetPackMembership(entity)
+	if !inPack || membership.IsLeader(entity) || membership.Target != prey || !world.HasComponent(prey, core.MaskBehavior) {
+		return preyPos
+	}
+
+	flankDistance := constants.TilesToPixels(p.profile().Pack.FlankDistance)
+	leaderPos, _ := world.GetPosition(membership.Leader)
+	flight := vec2.New(preyPos.X-leaderPos.X, preyPos.Y-leaderPos.Y).Normalize() // Добыча бежит от вожака
+	if position.DistanceTo(preyPos) <= flankDistance || flight.LengthSquared() == 0 {
+		return preyPos
+	}
+
+	side := float32(1)
+	if membership.Slot%2 == 0 {
+		side = -1
+	}
+	rank := float32((membership.Slot + 1) / 2)
+	offset := flight.Rotate(side * PackFlankAngle).Scale(flankDistance * rank)
+	return core.NewPosition(preyPos.X+offset.X, preyPos.Y+offset.Y)
+}
+
+// regroup возвращает отставшего загонщика к вожаку (сплочённость стаи вне охоты)
+// Возвращает nil для одиночки, вожака и загонщика рядом с вожаком
+func (p *PredatorBehaviorStrategy) regroup(
+	world core.BehaviorSystemAccess,
+	entity core.EntityID,
+	components AnimalComponents,
+) *core.Velocity {
+	membership, inPack := world.GetPackMembership(entity)
+	if !inPack || membership.IsLeader(entity) {
+		return nil
+	}
+
+	leaderPos, _ := world.GetPosition(membership.Leader)
+	regroupDistance := constants.TilesToPixels(p.profile().Pack.CohesionRange) * PackRegroupFraction
+	if components.Position.DistanceTo(leaderPos) <= regroupDistance {
+		return nil
+	}
+
+	direction := p.avoidImpassable(
+		components.Position, p.directionTo(components.Position, leaderPos), components.AnimalConfig.CollisionRadius,
+	)
+
+	components.Behavior.DirectionTimer = components.AnimalConfig.MinDirectionTime
+	world.SetBehavior(entity, components.Behavior)
+
+	speed := components.Speed.Current * components.AnimalConfig.WanderingSpeed
+	return &core.Velocity{X: direction.X * speed, Y: direction.Y * speed}
+}
+
+// WaterFlowField имя поля потока навигации к тайлам водопоя
+const WaterFlowField = "water"
+
+// drinkingBehavior общая логика водопоя для стратегий травоядных и хищников (DRY)
+// Встраивает профиль баланса и обход ландшафта - через них обе стратегии получают
+// пороги жажды и травы, а путь к водопою прокладывается в обход воды и кустов
+type drinkingBehavior struct {
+	balanced
+	terrainAvoidance
+	water core.WaterProvider // Абстракция для поиска воды (соблюдение DIP)
+}
+
+// newDrinkingBehavior создаёт общую логику водопоя (nil-провайдеры отключают водопой и обход)
+func newDrinkingBehavior(water core.WaterProvider, terrain core.PassabilityProvider) drinkingBehavior {
+	return drinkingBehavior{
+		terrainAvoidance: terrainAvoidance{terrain: terrain},
+		water:            water,
+	}
+}
+
+// handleDrinking обрабатывает жажду: пьющее животное стоит, жаждущее идёт к ближайшему водопою
+// Возвращает nil если животное не хочет пить или водопой не найден
+func (d drinkingBehavior) handleDrinking(
+	world core.BehaviorSystemAccess,
+	entity core.EntityID,
+	components AnimalComponents,
+) *core.Velocity {
+	if world.HasComponent(entity, core.MaskDrinkingState) {
+		zeroVel := core.NewVelocity(0, 0)
+		return &zeroVel // Пьёт - стоит на месте
+	}
+
+	balance := d.profile().Thirst
+	isThirsty := components.Hydration.Value < balance.Threshold
+	// Едящее животное сначала доедает
+	if d.water == nil || !isThirsty || world.HasComponent(entity, core.MaskEatingState) {
+		return nil
+	}
+
+	if d.water.CanDrinkAt(components.Position.X, components.Position.Y) {
+		// Уже у воды - останавливаемся, ThirstSystem начнёт питьё
+		zeroVel := core.NewVelocity(0, 0)
+		return &zeroVel
+	}
+
+	searchRadius := constants.TilesToPixels(components.AnimalConfig.VisionRange * balance.WaterMemoryRangeMultiplier)
+	waterX, waterY, found := d.water.FindNearestDrinkableTile(
+		components.Position.X, components.Position.Y, searchRadius,
+	)
+	if !found {
+		return nil // Водопоя поблизости нет - ведём себя как обычно
+	}
+
+	waterDir := d.directionToWater(components.Position, core.NewPosition(waterX, waterY))
+
+	components.Behavior.DirectionTimer = components.AnimalConfig.MinDirectionTime
+	world.SetBehavior(entity, components.Behavior)
+
+	speed := components.Speed.Current * components.AnimalConfig.SearchSpeed
+	resultVel := core.Velocity{X: waterDir.X * speed, Y: waterDir.Y * speed}
+	return &resultVel
+}
+
+// directionToWater направление к водопою: поле потока ведёт к ближайшему по пути водопою,
+// без поля - маршрут к найденному тайлу водопоя
+func (d drinkingBehavior) directionToWater(from, water core.Position) vec2.Vec2 {
+	if d.navigation != nil {
+		if x, y, ok := d.navigation.FlowWaypoint(WaterFlowField, from.X, from.Y); ok {
+			direction := core.NewPosition(x, y).Sub(from).Normalize()
+			return vec2.New(direction.X, direction.Y)
+		}
+	}
+	return d.directionTo(from, water)
+}
+
+// terrainAvoidance обход непроходимых тайлов для стратегий травоядных и хищников (DRY)
+type terrainAvoidance struct {
+	terrain    core.PassabilityProvider // Абстракция проходимости (nil - обход отключён)
+	navigation core.NavigationProvider  // Маршруты к целям (nil - к цели напрямую)
+	cover      core.CoverProvider       // Кусты, проходимые для мелких травоядных (nil - кусты обходятся)
+}
+
+// SetNavigation устанавливает провайдер маршрутов (nil - животные идут к целям напрямую)
+func (t *terrainAvoidance) SetNavigation(navigation core.NavigationProvider) {
+	t.navigation = navigation
+}
+
+// directionTo возвращает единичное направление к цели через следующую точку маршрута
+// Без навигации, при исчерпанном бюджете поиска или недостижимой цели - прямое направление
+func (t terrainAvoidance) directionTo(from, to core.Position) vec2.Vec2 {
+	target := to
+	if t.navigation != nil {
+		if x, y, ok := t.navigation.NextWaypoint(from.X, from.Y, to.X, to.Y); ok {
+			target = core.NewPosition(x, y)
+		}
+	}
+
+	direction := target.Sub(from).Normalize()
+	return vec2.New(direction.X, direction.Y)
+}
+
+// avoidImpassable поворачивает направление движения, если впереди вода или кусты
+// Перебирает отклонения по очереди в обе стороны с шагом TerrainSteeringAngleStep;
+// если свободного направления нет, возвращает исходное (разрешит TerrainCollisionSystem)
+func (t terrainAvoidance) avoidImpassable(position core.Position, direction vec2.Vec2, radiusInTiles float32) vec2.Vec2 {
+	if t.terrain == nil || direction.LengthSquared() == 0 || t.isDirectionClear(position, direction, radiusInTiles) {
+		return direction
+	}
+
+	for step := 1; float32(step)*TerrainSteeringAngleStep <= TerrainSteeringMaxAngle; step++ {
+		angle := float32(step) * TerrainSteeringAngleStep
+		for _, candidate := range [2]vec2.Vec2{direction.Rotate(angle), direction.Rotate(-angle)} {
+			if t.isDirectionClear(position, candidate, radiusInTiles) {
+				return candidate
+			}
+		}
+	}
+
+	return direction
+}
+
+// isDirectionClear проверяет тайлы на пути: у края тела и на дистанции упреждения
+func (t terrainAvoidance) isDirectionClear(position core.Position, direction vec2.Vec2, radiusInTiles float32) bool {
+	x, y := constants.PositionToTiles(position.X, position.Y)
+
+	for _, distance := range [2]float32{radiusInTiles + TerrainSteeringProbeOffset, TerrainSteeringLookahead} {
+		probeX := x + direction.X*distance
+		probeY := y + direction.Y*distance
+		if !t.isPassable(constants.TileIndex(probeX), constants.TileIndex(probeY), radiusInTiles) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isPassable проверяет проходимость тайла: мелкое животное со стратегией укрытий идёт сквозь кусты
+func (t terrainAvoidance) isPassable(tileX, tileY int, radiusInTiles float32) bool {
+	if t.terrain.IsPassable(tileX, tileY) {
+		return true
+	}
+	return t.cover != nil && radiusInTiles <= CoverMaxRadius && t.cover.IsCover(tileX, tileY)
+}
+
 // calculateBoundaryRepulsion вычисляет вектор отталкивания от границ мира
 // Предотвращает кластеризацию животных в углах карты - ЭЛЕГАНТНАЯ МАТЕМАТИКА
 func (h *HerbivoreBehaviorStrategy) calculateBoundaryRepulsion(position core.Position, worldWidth, worldHeight float32) vec2.Vec2 {
@@ -368,4 +867,252 @@ func (h *HerbivoreBehaviorStrategy) calculateAvoidanceDirection(
 
 // УДАЛЕНО: getRandomWalkVelocityWithBehavior заменена на RandomWalk.GetRandomWalkVelocity
 
-// УДАЛЕНО: ScavengerBehaviorStrategy - не используется в игре (нет падальщиков)
+// ScavengerBehaviorStrategy стратегия поведения падальщиков (гиена)
+// Падальщик не охотится: ищет трупы и брошенную падаль, доедает туши рядом с хищниками
+// и убегает только от охотящегося хищника - едящий хищник его не гонит
+type ScavengerBehaviorStrategy struct {
+	drinkingBehavior
+}
+
+// NewScavengerBehaviorStrategy создаёт новую стратегию падальщиков
+// water может быть nil - тогда падальщики не ищут водопой, terrain nil - идут к туше напрямик
+func NewScavengerBehaviorStrategy(water core.WaterProvider, terrain core.PassabilityProvider) *ScavengerBehaviorStrategy {
+	return &ScavengerBehaviorStrategy{
+		drinkingBehavior: newDrinkingBehavior(water, terrain),
+	}
+}
+
+// Sense находит охотящегося хищника в пределах дистанции бегства и, если падальщик голоден
+// и не ест, ближайшую тушу (труп или падаль) в радиусе зрения
+func (s *ScavengerBehaviorStrategy) Sense(
+	world core.BehaviorSystemAccess,
+	entity core.EntityID,
+	components AnimalComponents,
+) AnimalSenses {
+	senses := AnimalSenses{Sensed: true}
+	config := components.AnimalConfig
+	senses.Predator, senses.HasPredator = findNearestInRadius(world, components.Position, config.FleeThreshold,
+		func(candidate core.EntityID) bool {
+			behavior, ok := world.GetBehavior(candidate)
+			return ok && behavior.Type == core.BehaviorPredator && !world.HasComponent(candidate, core.MaskEatingState)
+		})
+
+	if components.Satiation.Value < config.SatiationThreshold && !world.HasComponent(entity, core.MaskEatingState) {
+		senses.Prey, senses.HasPrey = findNearestCarcass(world, components.Position, config.VisionRange)
+	}
+	return senses
+}
+
+// findNearestInRadius находит ближайшую подходящую сущность в радиусе (в тайлах) через пространственную сетку
+// При равном расстоянии выбирается меньший ID, как в FindNearestByTypeInTiles
+func findNearestInRadius(
+	world carcassQueries,
+	pos core.Position,
+	radiusInTiles float32,
+	matches func(core.EntityID) bool,
+) (core.EntityID, bool) {
+	radius := constants.TilesToPixels(radiusInTiles)
+	var nearest core.EntityID
+	bestDistance := radius * radius
+	found := false
+
+	for _, candidate := range world.QueryInRadius(pos.X, pos.Y, radius) {
+		if !matches(candidate) {
+			continue
+		}
+		candidatePos, _ := world.GetPosition(candidate)
+		distance := pos.DistanceSquaredTo(candidatePos)
+		if distance > bestDistance || (found && distance == bestDistance && candidate > nearest) {
+			continue
+		}
+		nearest, bestDistance, found = candidate, distance, true
+	}
+
+	return nearest, found
+}
+
+// findNearestCarcass находит ближайший недоеденный труп или падаль в радиусе (в тайлах)
+func findNearestCarcass(world carcassQueries, pos core.Position, radiusInTiles float32) (core.EntityID, bool) {
+	return findNearestInRadius(world, pos, radiusInTiles, func(entity core.EntityID) bool {
+		return carcassNutrition(world, entity) > 0
+	})
+}
+
+// carcassNutrition остаток питательности трупа или падали (0 - не туша)
+func carcassNutrition(world carcassQueries, entity core.EntityID) float32 {
+	if corpse, ok := world.GetCorpse(entity); ok {
+		return corpse.NutritionalValue
+	}
+	carrion, _ := world.GetCarrion(entity)
+	return carrion.NutritionalValue
+}
+
+// UpdateBehavior реализует поведение падальщиков
+func (s *ScavengerBehaviorStrategy) UpdateBehavior(
+	world core.BehaviorSystemAccess,
+	entity core.EntityID,
+	components AnimalComponents,
+) core.Velocity {
+	senses := components.Senses
+	if !senses.Sensed {
+		senses = s.Sense(world, entity, components)
+	}
+
+	// ПРИОРИТЕТ 1: Охотящийся хищник рядом - бросаем тушу и убегаем
+	if senses.HasPredator {
+		return s.flee(world, entity, components, senses.Predator)
+	}
+
+	// ПРИОРИТЕТ 2: Ест - стоит на месте
+	if world.HasComponent(entity, core.MaskEatingState) {
+		return core.NewVelocity(0, 0)
+	}
+
+	// ПРИОРИТЕТ 3: Жажда
+	if velocity := s.handleDrinking(world, entity, components); velocity != nil {
+		return *velocity
+	}
+
+	// ПРИОРИТЕТ 4: Голоден и видит тушу - идёт к ней
+	if senses.HasPrey {
+		return s.approachCarcass(world, entity, components, senses.Prey)
+	}
+
+	// ПРИОРИТЕТ 5: Голодный блуждает в поисках туш, сытый - спокойно
+	speedMultiplier := components.AnimalConfig.ContentSpeed
+	if components.Satiation.Value < components.AnimalConfig.SatiationThreshold {
+		speedMultiplier = components.AnimalConfig.WanderingSpeed
+	}
+	return RandomWalk.GetRandomWalkVelocity(
+		world, entity, components.Behavior, components.Speed.Current*speedMultiplier,
+	)
+}
+
+// approachCarcass ведёт к туше в обход воды и кустов, у туши останавливает (EatingSystem начнёт поедание)
+func (t terrainAvoidance) approachCarcass(
+	world core.BehaviorSystemAccess,
+	entity core.EntityID,
+	components AnimalComponents,
+	carcass core.EntityID,
+) core.Velocity {
+	carcassPos, _ := world.GetPosition(carcass)
+	if components.Position.DistanceTo(carcassPos) <= constants.TilesToPixels(EatingRange) {
+		return core.NewVelocity(0, 0)
+	}
+
+	direction := t.avoidImpassable(
+		components.Position, t.directionTo(components.Position, carcassPos), components.AnimalConfig.CollisionRadius,
+	)
+
+	components.Behavior.DirectionTimer = components.AnimalConfig.MinDirectionTime
+	world.SetBehavior(entity, components.Behavior)
+
+	speed := components.Speed.Current * components.AnimalConfig.SearchSpeed
+	return core.Velocity{X: direction.X * speed, Y: direction.Y * speed}
+}
+
+// flee убегает от охотящегося хищника, прерывая поедание и питьё
+func (s *ScavengerBehaviorStrategy) flee(
+	world core.BehaviorSystemAccess,
+	entity core.EntityID,
+	components AnimalComponents,
+	predator core.EntityID,
+) core.Velocity {
+	if world.HasComponent(entity, core.MaskEatingState) {
+		world.RemoveEatingState(entity)
+	}
+	if world.HasComponent(entity, core.MaskDrinkingState) {
+		world.RemoveDrinkingState(entity)
+	}
+
+	world.EmitEvent(core.Fleeing{Entity: entity, Predator: predator})
+	predatorPos, _ := world.GetPosition(predator)
+	escape := components.Position.Sub(predatorPos).Normalize()
+	direction := s.avoidImpassable(
+		components.Position, vec2.New(escape.X, escape.Y), components.AnimalConfig.CollisionRadius,
+	)
+
+	components.Behavior.DirectionTimer = components.AnimalConfig.MinDirectionTime
+	world.SetBehavior(entity, components.Behavior)
+
+	speed := components.Speed.Current
+	return core.Velocity{X: direction.X * speed, Y: direction.Y * speed}
+}
+
+// OmnivoreBehaviorStrategy стратегия поведения всеядных
+// Всеядное ведёт себя как травоядное (бегство, водопой, стадо, пастьба), но голодным
+// выбирает между травой и тушей (chooseOmnivoreFood) и идёт к более выгодной еде
+type OmnivoreBehaviorStrategy struct {
+	*HerbivoreBehaviorStrategy
+	grass core.VegetationProvider // Количество травы для оценки ценности пастьбы (nil - только туши)
+}
+
+// NewOmnivoreBehaviorStrategy создаёт новую стратегию всеядных
+// Если vegetation реализует core.VegetationProvider, всеядное сравнивает траву с тушами
+func NewOmnivoreBehaviorStrategy(
+	vegetation VegetationProvider,
+	water core.WaterProvider,
+	terrain core.PassabilityProvider,
+) *OmnivoreBehaviorStrategy {
+	strategy := &OmnivoreBehaviorStrategy{
+		HerbivoreBehaviorStrategy: NewHerbivoreBehaviorStrategy(vegetation, water, terrain),
+	}
+	strategy.grass, _ = vegetation.(core.VegetationProvider)
+	strategy.cover = nil // Прячутся в кустах только травоядные - всеядное кусты обходит
+	return strategy
+}
+
+// Sense находит хищника и соседей по стаду как травоядное, а голодное и не едящее
+// всеядное - тушу, если она выгоднее травы (Prey)
+func (o *OmnivoreBehaviorStrategy) Sense(
+	world core.BehaviorSystemAccess,
+	entity core.EntityID,
+	components AnimalComponents,
+) AnimalSenses {
+	senses := o.HerbivoreBehaviorStrategy.Sense(world, entity, components)
+	if components.Satiation.Value < components.AnimalConfig.SatiationThreshold &&
+		!world.HasComponent(entity, core.MaskEatingState) {
+		meal := chooseOmnivoreFood(
+			world, o.grass, o.profile().Feeding, components.Position, components.AnimalConfig, components.Satiation.Value,
+		)
+		senses.Prey, senses.HasPrey = meal.Carcass, meal.Food == OmnivoreFoodCarcass
+	}
+	return senses
+}
+
+// UpdateBehavior реализует поведение всеядных
+func (o *OmnivoreBehaviorStrategy) UpdateBehavior(
+	world core.BehaviorSystemAccess,
+	entity core.EntityID,
+	components AnimalComponents,
+) core.Velocity {
+	if !components.Senses.Sensed {
+		components.Senses = o.Sense(world, entity, components)
+	}
+
+	// ПРИОРИТЕТ 1: Если видит хищника - убегать (всегда)
+	if velocity := o.handlePredatorEscape(world, entity, components); velocity != nil {
+		return *velocity
+	}
+
+	// ПРИОРИТЕТ 2: Ест тушу - стоит на месте (траву доедает как травоядное)
+	if eating, ok := world.GetEatingState(entity); ok && eating.TargetType == core.EatingTargetAnimal {
+		return core.NewVelocity(0, 0)
+	}
+
+	// ПРИОРИТЕТ 3: Жажда
+	if velocity := o.handleDrinking(world, entity, components); velocity != nil {
+		return *velocity
+	}
+
+	// ПРИОРИТЕТ 4: Туша выгоднее травы - идёт к ней
+	if components.Senses.HasPrey {
+		return o.approachCarcass(world, entity, components, components.Senses.Prey)
+	}
+
+	// ПРИОРИТЕТ 5: Пастьба или спокойное движение как у травоядного
+	if velocity := o.handleFeeding(world, entity, components); velocity != nil {
+		return *velocity
+	}
+	return o.handleIdleBehavior(world, entity, components)
+}
@@ -1,6 +1,7 @@
 package simulation
 
 import (
+	"github.com/aiseeq/savanna/internal/constants"
 	"github.com/aiseeq/savanna/internal/core"
 )
 
@@ -20,6 +21,17 @@ type AnimalBehaviorSystem struct {
 	vegetation VegetationProvider
 	// Стратегии поведения для разных типов животных (Strategy pattern)
 	strategies map[core.BehaviorType]BehaviorStrategy
+
+	// Восприятие животных текущего тика: считается по секторам карты (параллельно),
+	// сливается по порядку секторов, решения принимаются последовательно (ГСЧ и навигация)
+	senses         map[core.EntityID]AnimalSenses
+	sensedInSector [core.SectorCount][]sensedAnimal
+}
+
+// sensedAnimal восприятие животного, посчитанное в секторе
+type sensedAnimal struct {
+	entity core.EntityID
+	senses AnimalSenses
 }
 
 // NewAnimalBehaviorSystem создаёт новую систему поведения животных
@@ -29,16 +41,46 @@ func NewAnimalBehaviorSystem(vegetation VegetationProvider) *AnimalBehaviorSyste
 		directionChangeTimers: make(map[core.EntityID]float32),
 		vegetation:            vegetation,
 		strategies:            make(map[core.BehaviorType]BehaviorStrategy),
+		senses:                make(map[core.EntityID]AnimalSenses),
 	}
 
+	// Источник растительности может также знать о водоёмах и проходимости
+	// (VegetationSystem реализует все три интерфейса)
+	water, _ := vegetation.(core.WaterProvider)
+	terrain, _ := vegetation.(core.PassabilityProvider)
+
 	// Инициализируем стратегии поведения (Strategy pattern)
-	abs.strategies[core.BehaviorHerbivore] = NewHerbivoreBehaviorStrategy(vegetation)
-	abs.strategies[core.BehaviorPredator] = NewPredatorBehaviorStrategy()
-	// УДАЛЕНО: BehaviorScavenger - не используется в игре (нет падальщиков)
+	abs.strategies[core.BehaviorHerbivore] = NewHerbivoreBehaviorStrategy(vegetation, water, terrain)
+	abs.strategies[core.BehaviorPredator] = NewPredatorBehaviorStrategy(water, terrain)
+	abs.strategies[core.BehaviorScavenger] = NewScavengerBehaviorStrategy(water, terrain)
+	abs.strategies[core.BehaviorOmnivore] = NewOmnivoreBehaviorStrategy(vegetation, water, terrain)
 
 	return abs
 }
 
+// SetBalanceProfile передаёт профиль баланса стратегиям поведения (пороги жажды и травы)
+func (abs *AnimalBehaviorSystem) SetBalanceProfile(profile *BalanceProfile) {
+	for _, strategy := range abs.strategies {
+		if configurable, ok := strategy.(BalanceConfigurable); ok {
+			configurable.SetBalanceProfile(profile)
+		}
+	}
+}
+
+// NavigationConfigurable стратегия, прокладывающая маршруты через навигацию
+type NavigationConfigurable interface {
+	SetNavigation(navigation core.NavigationProvider)
+}
+
+// SetNavigation передаёт навигацию стратегиям поведения (погоня, поиск травы и водопоя)
+func (abs *AnimalBehaviorSystem) SetNavigation(navigation core.NavigationProvider) {
+	for _, strategy := range abs.strategies {
+		if configurable, ok := strategy.(NavigationConfigurable); ok {
+			configurable.SetNavigation(navigation)
+		}
+	}
+}
+
 // Update обновляет поведение всех животных через универсальную систему поведения
 // Update обновляет поведение всех животных
 // Рефакторинг: использует специализированный интерфейс вместо полного World (ISP)
@@ -48,8 +90,10 @@ func (abs *AnimalBehaviorSystem) Update(world core.BehaviorSystemAccess, deltaTi
 	// Обновляем таймеры поведения для всех животных с компонентом Behavior
 	abs.updateBehaviorTimers(world, deltaTime)
 
+	// Поиск хищников и добычи - самая дорогая часть, он только читает мир
+	abs.senseAnimals(world)
+
 	// Обрабатываем поведение всех животных через универсальную логику
-	behaviorMask := core.MaskBehavior | core.MaskPosition | core.MaskVelocity | core.MaskSpeed | core.MaskSatiation | core.MaskAnimalConfig
 	world.ForEachWith(behaviorMask, func(entity core.EntityID) {
 		abs.updateAnimalBehavior(world, entity, deltaTime)
 	})
@@ -58,6 +102,124 @@ func (abs *AnimalBehaviorSystem) Update(world core.BehaviorSystemAccess, deltaTi
 	abs.cleanupTimers(world)
 }
 
+// behaviorMask компоненты животных, принимающих решения
+const behaviorMask = core.MaskBehavior | core.MaskPosition | core.MaskVelocity | core.MaskSpeed |
+	core.MaskSatiation | core.MaskAnimalConfig
+
+// senseAnimals вычисляет восприятие животных по секторам карты и сливает его по порядку секторов
+func (abs *AnimalBehaviorSystem) senseAnimals(world core.BehaviorSystemAccess) {
+	for sector := range abs.sensedInSector {
+		abs.sensedInSector[sector] = abs.sensedInSector[sector][:0]
+	}
+
+	world.ForEachSector(behaviorMask, func(sector int, entities []core.EntityID) {
+		for _, entity := range entities {
+			if senses, ok := abs.senseAnimal(world, entity); ok {
+				abs.sensedInSector[sector] = append(abs.sensedInSector[sector], sensedAnimal{entity: entity, senses: senses})
+			}
+		}
+	})
+
+	clear(abs.senses)
+	alarm := false
+	for _, sensed := range abs.sensedInSector {
+		for _, animal := range sensed {
+			abs.senses[animal.entity] = animal.senses
+			alarm = alarm || animal.senses.HasPredator
+		}
+	}
+
+	if alarm {
+		abs.spreadAlarms(world)
+	}
+}
+
+// spreadAlarms передаёт тревогу по стаду: животное, не видящее хищника, убегает от хищника,
+// которого заметил ближайший сородич в пределах слышимости (HearingRange).
+// Услышавшие тревогу её не передают - паника не расходится по всей карте
+func (abs *AnimalBehaviorSystem) spreadAlarms(world core.BehaviorSystemAccess) {
+	world.ForEachWith(behaviorMask, func(entity core.EntityID) {
+		senses, sensed := abs.senses[entity]
+		config, _ := world.GetAnimalConfig(entity)
+		if !sensed || senses.HasPredator || config.HearingRange <= 0 {
+			return
+		}
+
+		animalType, _ := world.GetAnimalType(entity)
+		pos, _ := world.GetPosition(entity)
+		var witness core.EntityID
+		bestDistance := float32(LargeDistanceValue)
+		for _, neighbor := range world.QueryInRadius(pos.X, pos.Y, constants.TilesToPixels(config.HearingRange)) {
+			neighborSenses := abs.senses[neighbor]
+			neighborType, _ := world.GetAnimalType(neighbor)
+			if !neighborSenses.HasPredator || neighborSenses.Alarmed || neighborType != animalType {
+				continue
+			}
+
+			neighborPos, _ := world.GetPosition(neighbor)
+			distance := pos.DistanceSquaredTo(neighborPos)
+			if distance < bestDistance || (distance == bestDistance && neighbor < witness) {
+				witness, bestDistance = neighbor, distance
+			}
+		}
+		if witness == 0 {
+			return
+		}
+
+		senses.Predator, senses.HasPredator, senses.Alarmed = abs.senses[witness].Predator, true, true
+		abs.senses[entity] = senses
+	})
+}
+
+// senseAnimal вычисляет восприятие животного стратегией (только чтение мира)
+func (abs *AnimalBehaviorSystem) senseAnimal(world core.BehaviorSystemAccess, entity core.EntityID) (AnimalSenses, bool) {
+	if world.HasComponent(entity, core.MaskAttackState) {
+		return AnimalSenses{}, false // Атакующее животное не принимает решений
+	}
+
+	components, ok := abs.animalComponents(world, entity)
+	if !ok {
+		return AnimalSenses{}, false
+	}
+	strategy, ok := abs.strategies[components.Behavior.Type].(SensingStrategy)
+	if !ok {
+		return AnimalSenses{}, false
+	}
+	return strategy.Sense(world, entity, components), true
+}
+
+// animalComponents читает компоненты животного для стратегии поведения
+func (abs *AnimalBehaviorSystem) animalComponents(
+	world core.BehaviorSystemAccess,
+	entity core.EntityID,
+) (AnimalComponents, bool) {
+	behavior, ok := world.GetBehavior(entity)
+	if !ok {
+		return AnimalComponents{}, false
+	}
+
+	pos, _ := world.GetPosition(entity)
+	speed, _ := world.GetSpeed(entity)
+	satiation, _ := world.GetSatiation(entity)
+	animalConfig, _ := world.GetAnimalConfig(entity)
+
+	// Животные без гидратации не испытывают жажды
+	hydration, hasHydration := world.GetHydration(entity)
+	if !hasHydration {
+		hydration.Value = MaxHydration
+	}
+
+	return AnimalComponents{
+		Behavior:     behavior,
+		AnimalConfig: animalConfig,
+		Position:     pos,
+		Speed:        speed,
+		Satiation:    satiation,
+		Hydration:    hydration,
+		Senses:       abs.senses[entity],
+	}, true
+}
+
 // updateBehaviorTimers обновляет таймеры поведения для всех животных
 func (abs *AnimalBehaviorSystem) updateBehaviorTimers(world core.BehaviorSystemAccess, deltaTime float32) {
 	// Обновляем таймеры смены направления в старом стиле (для совместимости)
@@ -90,26 +252,15 @@ func (abs *AnimalBehaviorSystem) updateAnimalBehavior(
 		return // Животное атакует
 	}
 
-	behavior, ok := world.GetBehavior(entity)
+	components, ok := abs.animalComponents(world, entity)
 	if !ok {
 		return
 	}
-
-	pos, _ := world.GetPosition(entity)
-	speed, _ := world.GetSpeed(entity)
-	satiation, _ := world.GetSatiation(entity)
-	animalConfig, _ := world.GetAnimalConfig(entity)
+	behavior, speed, animalConfig := components.Behavior, components.Speed, components.AnimalConfig
 
 	// Используем стратегию поведения (Strategy pattern)
 	strategy, hasStrategy := abs.strategies[behavior.Type]
 	if hasStrategy {
-		components := AnimalComponents{
-			Behavior:     behavior,
-			AnimalConfig: animalConfig,
-			Position:     pos,
-			Speed:        speed,
-			Satiation:    satiation,
-		}
 		targetVel := strategy.UpdateBehavior(world, entity, components)
 		world.SetVelocity(entity, targetVel)
 	} else {
@@ -50,8 +50,18 @@ func NewCombatSystem() *CombatSystem {
 // Update обновляет все боевые подсистемы (паттерн Facade)
 func (cs *CombatSystem) Update(world *core.World, deltaTime float32) {
 	// Порядок важен: сначала атаки, потом эффекты, потом поедание
+	// Между подсистемами доставляются события и применяются команды - как между системами в SystemManager
 	cs.attackSystem.Update(world, deltaTime)
+	world.Sync()
 	cs.damageSystem.Update(world, deltaTime)
+	world.Sync()
 	cs.corpseSystem.Update(world, deltaTime)
+	world.Sync()
 	cs.eatingSystem.Update(world, deltaTime)
+	world.Sync()
+}
+
+// SetBalanceProfile передаёт профиль баланса боевым подсистемам
+func (cs *CombatSystem) SetBalanceProfile(profile *BalanceProfile) {
+	ApplyBalanceProfile(profile, cs.attackSystem, cs.eatingSystem, cs.corpseSystem)
 }
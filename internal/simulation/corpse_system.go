@@ -6,7 +6,10 @@ import (
 )
 
 // CorpseSystem отвечает ТОЛЬКО за управление трупами (устраняет нарушение SRP)
-type CorpseSystem struct{}
+// Сущности удаляются через world.Commands() - после системы, а не во время итерации
+type CorpseSystem struct {
+	balanced // Профиль баланса: время разложения
+}
 
 // NewCorpseSystem создаёт новую систему трупов
 func NewCorpseSystem() *CorpseSystem {
@@ -27,8 +30,6 @@ func (cs *CorpseSystem) Update(world *core.World, deltaTime float32) {
 
 // updateCorpseDecay обновляет разложение трупов
 func (cs *CorpseSystem) updateCorpseDecay(world *core.World, deltaTime float32) {
-	var corpsesToRemove []core.EntityID
-
 	world.ForEachWith(core.MaskCorpse, func(corpse core.EntityID) {
 		corpseData, hasCorpse := world.GetCorpse(corpse)
 		if !hasCorpse {
@@ -52,25 +53,18 @@ func (cs *CorpseSystem) updateCorpseDecay(world *core.World, deltaTime float32)
 
 		// ИСПРАВЛЕНИЕ: Труп исчезает когда питательность = 0 ИЛИ таймер = 0
 		if corpseData.NutritionalValue <= 0 || corpseData.DecayTimer <= 0 {
-			corpsesToRemove = append(corpsesToRemove, corpse)
+			world.Commands().DestroyEntity(corpse) // Удаление после итерации
 		} else {
 			world.SetCorpse(corpse, corpseData)
 		}
 		// Если труп поедается - таймер НЕ уменьшается (консервация)
 	})
-
-	// Удаляем разложившиеся трупы
-	for _, corpse := range corpsesToRemove {
-		world.DestroyEntity(corpse)
-	}
 }
 
 // handleAnimalDeaths обрабатывает смерть животных
 // Зайцы, убитые хищниками, превращаются в трупы через createCorpse()
 // Остальные мертвые животные (волки от голода и т.д.) просто удаляются
 func (cs *CorpseSystem) handleAnimalDeaths(world *core.World) {
-	var deadAnimals []core.EntityID
-
 	world.ForEachWith(core.MaskHealth|core.MaskAnimalType, func(entity core.EntityID) {
 		health, hasHealth := world.GetHealth(entity)
 		_, hasType := world.GetAnimalType(entity)
@@ -86,24 +80,24 @@ func (cs *CorpseSystem) handleAnimalDeaths(world *core.World) {
 		}
 
 		// Иначе удаляем мертвое животное которое НЕ является трупом
-		deadAnimals = append(deadAnimals, entity)
+		world.Commands().DestroyEntity(entity)
 	})
-
-	// Удаляем мертвых животных (кроме тех что уже стали трупами)
-	for _, entity := range deadAnimals {
-		world.DestroyEntity(entity)
-	}
 }
 
 // CreateCorpseAndGetID превращает мёртвое животное в труп НА МЕСТЕ, сохраняя анимацию
 func CreateCorpseAndGetID(world *core.World, animal core.EntityID) core.EntityID {
+	return createCorpseWithBalance(world, animal, defaultBalanceProfile)
+}
+
+// createCorpseWithBalance превращает животное в труп с питательностью и временем разложения из профиля
+func createCorpseWithBalance(world *core.World, animal core.EntityID, balance *BalanceProfile) core.EntityID {
 	// ИСПРАВЛЕНИЕ: НЕ уничтожаем животное, а превращаем его в труп на месте
 
 	// Добавляем компонент трупа
 	world.AddCorpse(animal, core.Corpse{
-		NutritionalValue: CorpseNutritionalValue,
-		MaxNutritional:   CorpseNutritionalValue,
-		DecayTimer:       CorpseDecayTime,
+		NutritionalValue: balance.Corpses.NutritionalValue,
+		MaxNutritional:   balance.Corpses.NutritionalValue,
+		DecayTimer:       balance.Corpses.DecayTime,
 	})
 
 	// Переключаем анимацию на смерть и ОСТАНАВЛИВАЕМ на последнем кадре
@@ -133,13 +127,17 @@ func CreateCorpseAndGetID(world *core.World, animal core.EntityID) core.EntityID
 	}
 	// Оставляем Health=0 для индикации что это труп
 
+	animalType, _ := world.GetAnimalType(animal)
+	pos, _ := world.GetPosition(animal)
+	world.EmitEvent(core.CorpseCreated{
+		Corpse: animal, Type: animalType, Position: pos, Nutrition: balance.Corpses.NutritionalValue,
+	})
+
 	return animal // Возвращаем ТОТ ЖЕ EntityID - животное стало трупом
 }
 
 // updateCarrionDecay обновляет разложение падали
 func (cs *CorpseSystem) updateCarrionDecay(world *core.World, deltaTime float32) {
-	var carrionToRemove []core.EntityID
-
 	world.ForEachWith(core.MaskCarrion, func(carrion core.EntityID) {
 		carrionData, hasCarrion := world.GetCarrion(carrion)
 		if !hasCarrion {
@@ -151,7 +149,7 @@ func (cs *CorpseSystem) updateCarrionDecay(world *core.World, deltaTime float32)
 
 		// ИСПРАВЛЕНИЕ: Падаль теряет питательность во время естественного гниения
 		// Скорость потери питательности: полная потеря за время DecayTime
-		nutritionLossPerSecond := carrionData.MaxNutritional / CorpseDecayTime
+		nutritionLossPerSecond := carrionData.MaxNutritional / cs.profile().Corpses.DecayTime
 		carrionData.NutritionalValue -= nutritionLossPerSecond * deltaTime
 
 		// Не даем питательности стать отрицательной
@@ -161,14 +159,9 @@ func (cs *CorpseSystem) updateCarrionDecay(world *core.World, deltaTime float32)
 
 		// ИСПРАВЛЕНИЕ: Падаль исчезает когда питательность = 0 ИЛИ таймер = 0
 		if carrionData.NutritionalValue <= 0 || carrionData.DecayTimer <= 0 {
-			carrionToRemove = append(carrionToRemove, carrion)
+			world.Commands().DestroyEntity(carrion)
 		} else {
 			world.SetCarrion(carrion, carrionData)
 		}
 	})
-
-	// Удаляем разложившуюся падаль
-	for _, carrion := range carrionToRemove {
-		world.DestroyEntity(carrion)
-	}
 }
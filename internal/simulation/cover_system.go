@@ -0,0 +1,88 @@
+package simulation
+
+import (
+	"github.com/aiseeq/savanna/internal/constants"
+	"github.com/aiseeq/savanna/internal/core"
+	"github.com/aiseeq/savanna/internal/vec2"
+)
+
+// CoverSystem отвечает ТОЛЬКО за укрытие мелких травоядных в кустах (SRP)
+// Животное в кусте или у его края получает Concealment - хищники замечают его
+// на меньшей дистанции. Бегство к укрытию задаёт HerbivoreBehaviorStrategy
+type CoverSystem struct {
+	cover core.CoverProvider // Абстракция укрытий ландшафта (соблюдение DIP)
+}
+
+// NewCoverSystem создаёт систему укрытий
+func NewCoverSystem(cover core.CoverProvider) *CoverSystem {
+	return &CoverSystem{cover: cover}
+}
+
+// Update обновляет скрытность животных по их положению относительно кустов
+func (cs *CoverSystem) Update(world *core.World, deltaTime float32) {
+	commands := world.Commands()
+
+	// Трупы теряют Size - скрытность живого животного им не достаётся
+	world.ForEachWith(core.MaskConcealment, func(entity core.EntityID) {
+		if !world.HasComponent(entity, core.MaskSize) {
+			commands.Modify(entity, removeConcealment)
+		}
+	})
+
+	world.ForEachWith(core.MaskPosition|core.MaskSize|core.MaskAnimalConfig, func(entity core.EntityID) {
+		config, _ := world.GetAnimalConfig(entity)
+		size, _ := world.GetSize(entity)
+		pos, _ := world.GetPosition(entity)
+
+		visibility, hidden := cs.visibility(pos, size.Radius)
+		if !canHideInCover(config.Diet, size.Radius) || !hidden {
+			if world.HasComponent(entity, core.MaskConcealment) {
+				commands.Modify(entity, removeConcealment)
+			}
+			return
+		}
+
+		concealment := core.Concealment{Visibility: visibility}
+		if !world.SetConcealment(entity, concealment) {
+			commands.Modify(entity, func(world *core.World, entity core.EntityID) {
+				world.AddConcealment(entity, concealment)
+			})
+		}
+	})
+}
+
+// removeConcealment снимает скрытность (отложенная команда)
+func removeConcealment(world *core.World, entity core.EntityID) {
+	world.RemoveConcealment(entity)
+}
+
+// visibility доля дальности зрения хищника, на которой заметно животное (false - не прячется)
+func (cs *CoverSystem) visibility(pos core.Position, radiusInTiles float32) (float32, bool) {
+	x, y := constants.PositionToTiles(pos.X, pos.Y)
+	if cs.cover.IsCover(constants.TileIndex(x), constants.TileIndex(y)) {
+		return CoverVisibilityInside, true
+	}
+
+	// У края куста: ближайшая точка тайла-куста в пределах CoverHugDistance от края тела
+	reach := radiusInTiles + CoverHugDistance
+	center := vec2.New(x, y)
+	for tileY := constants.TileIndex(y - reach); tileY <= constants.TileIndex(y+reach); tileY++ {
+		for tileX := constants.TileIndex(x - reach); tileX <= constants.TileIndex(x+reach); tileX++ {
+			if !cs.cover.IsCover(tileX, tileY) {
+				continue
+			}
+			left, top := float32(tileX), float32(tileY)
+			closest := vec2.New(clampFloat32(x, left, left+1), clampFloat32(y, top, top+1))
+			if center.Sub(closest).Length() <= reach {
+				return CoverVisibilityEdge, true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// canHideInCover проверяет что животное пролезает в кусты: только мелкие травоядные
+func canHideInCover(diet core.BehaviorType, radiusInTiles float32) bool {
+	return diet == core.BehaviorHerbivore && radiusInTiles <= CoverMaxRadius
+}
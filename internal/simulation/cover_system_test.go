@@ -0,0 +1,102 @@
+package simulation
+
+import (
+	"testing"
+
+	"github.com/aiseeq/savanna/internal/constants"
+	"github.com/aiseeq/savanna/internal/core"
+	"github.com/aiseeq/savanna/internal/generator"
+)
+
+// newBushTerrain создаёт травяную карту с кустом в тайле (bushX, bushY)
+func newBushTerrain(size, bushX, bushY int) *generator.Terrain {
+	terrain := newPondTerrain(size, bushX, bushY)
+	terrain.SetTileType(bushX, bushY, generator.TileBush)
+	return terrain
+}
+
+func TestCoverSystem_ConcealsSmallHerbivoresOnly(t *testing.T) {
+	world := core.NewWorld(640, 640, 12345)
+	system := NewCoverSystem(NewVegetationSystem(newBushTerrain(20, 10, 10)))
+
+	x, y := tileCenter(10, 10)
+	inside := CreateAnimal(world, core.TypeRabbit, x, y)
+	edge := CreateAnimal(world, core.TypeRabbit, x+24, y) // Край тела касается куста
+	open := CreateAnimal(world, core.TypeRabbit, x+3*TileSizeVegetation, y)
+	wolf := CreateAnimal(world, core.TypeWolf, x, y+TileSizeVegetation)
+
+	system.Update(world, 1.0/60.0)
+	world.Sync() // Скрытность добавляется отложенными командами
+
+	for _, check := range []struct {
+		name       string
+		entity     core.EntityID
+		hidden     bool
+		visibility float32
+	}{
+		{"rabbit inside bush", inside, true, CoverVisibilityInside},
+		{"rabbit hugging bush", edge, true, CoverVisibilityEdge},
+		{"rabbit in the open", open, false, 0},
+		{"wolf next to bush", wolf, false, 0},
+	} {
+		concealment, hidden := world.GetConcealment(check.entity)
+		if hidden != check.hidden || concealment.Visibility != check.visibility {
+			t.Errorf("%s: hidden %v visibility %.2f, expected %v %.2f",
+				check.name, hidden, concealment.Visibility, check.hidden, check.visibility)
+		}
+	}
+
+	// Вышедший из куста заяц и труп теряют скрытность
+	world.SetPosition(edge, core.NewPosition(x+5*TileSizeVegetation, y))
+	CreateCorpseAndGetID(world, inside)
+	system.Update(world, 1.0/60.0)
+	world.Sync()
+	if world.HasComponent(edge, core.MaskConcealment) || world.HasComponent(inside, core.MaskConcealment) {
+		t.Error("Rabbit out of cover and corpse should lose concealment")
+	}
+}
+
+func TestFindNearestWithDiet_HiddenPreyVisibleOnlyUpClose(t *testing.T) {
+	world := core.NewWorld(640, 640, 12345)
+	system := NewCoverSystem(NewVegetationSystem(newBushTerrain(20, 10, 10)))
+
+	x, y := tileCenter(10, 10)
+	hidden := CreateAnimal(world, core.TypeRabbit, x, y)
+	exposed := CreateAnimal(world, core.TypeRabbit, x, y+4*TileSizeVegetation)
+	system.Update(world, 1.0/60.0)
+	world.Sync()
+
+	// Волк в 3 тайлах от куста (зрение 5 тайлов): спрятавшийся ближе, но заметен только открытый заяц
+	wolfPos := core.NewPosition(x-3*TileSizeVegetation, y)
+	if prey, found := findNearestWithDiet(world, wolfPos, WolfBaseRadius*WolfVisionMultiplier, core.BehaviorHerbivore); !found || prey != exposed {
+		t.Errorf("Wolf should see only the exposed rabbit %d, got %d (found %v)", exposed, prey, found)
+	}
+
+	// Вплотную к кусту спрятавшегося видно
+	wolfPos = core.NewPosition(x-TileSizeVegetation, y)
+	if prey, _ := findNearestWithDiet(world, wolfPos, WolfBaseRadius*WolfVisionMultiplier, core.BehaviorHerbivore); prey != hidden {
+		t.Errorf("Wolf next to the bush should see hidden rabbit %d, got %d", hidden, prey)
+	}
+}
+
+func TestTerrainCollision_SmallHerbivoresEnterBushes(t *testing.T) {
+	world := core.NewWorld(640, 640, 12345)
+	system := NewTerrainCollisionSystem(NewVegetationSystem(newBushTerrain(20, 10, 10)))
+
+	x, y := tileCenter(10, 10)
+	rabbit := CreateAnimal(world, core.TypeRabbit, x, y)
+	wolf := CreateAnimal(world, core.TypeWolf, x+4, y)
+
+	system.Update(world)
+
+	if pos, _ := world.GetPosition(rabbit); pos.X != x || pos.Y != y {
+		t.Errorf("Rabbit should stay inside the bush, moved to %+v", pos)
+	}
+
+	pos, _ := world.GetPosition(wolf)
+	size, _ := world.GetSize(wolf)
+	tileX, _ := constants.PositionToTiles(pos.X, pos.Y)
+	if tileX-size.Radius < 11 {
+		t.Errorf("Wolf circle should be pushed out of the bush, left edge at %.3f tiles", tileX-size.Radius)
+	}
+}
@@ -0,0 +1,22 @@
+package simulation
+
+import "github.com/aiseeq/savanna/internal/core"
+
+// deathReporter чтение и публикация, нужные для события смерти
+type deathReporter interface {
+	GetPosition(core.EntityID) (core.Position, bool)
+	GetAnimalType(core.EntityID) (core.AnimalType, bool)
+	core.EventEmitter
+}
+
+// reportDeath публикует AnimalDied, если урон обнулил здоровье живого животного
+// previous - здоровье до урона: повторный урон по мёртвому не даёт второго события
+func reportDeath(world deathReporter, entity core.EntityID, previous, current int16, cause core.DeathCause, killer core.EntityID) {
+	if previous <= 0 || current > 0 {
+		return
+	}
+
+	animalType, _ := world.GetAnimalType(entity)
+	pos, _ := world.GetPosition(entity)
+	world.EmitEvent(core.AnimalDied{Entity: entity, Type: animalType, Cause: cause, Killer: killer, Position: pos})
+}
@@ -20,6 +20,7 @@ func (f *DefaultConfigFactory) CreateConfig() core.AnimalConfig {
 		CollisionRadius:    DefaultAnimalRadius * CollisionRadiusMultiplier,
 		AttackRange:        PacifistAttackDamage, // Не атакует
 		VisionRange:        DefaultAnimalRadius * DefaultVisionMultiplier,
+		Diet:               core.BehaviorHerbivore,
 		SatiationThreshold: DefaultSatiationThreshold,
 		FleeThreshold:      DefaultAnimalRadius * RabbitFleeDistanceMultiplier, // Используем множитель зайца как базовый
 		SearchSpeed:        SearchSpeedMultiplier,
@@ -30,5 +31,11 @@ func (f *DefaultConfigFactory) CreateConfig() core.AnimalConfig {
 		AttackDamage:       PacifistAttackDamage,
 		AttackCooldown:     PacifistAttackCooldown,
 		HitChance:          PacifistHitChance,
+
+		ReproductionCooldown: DefaultReproductionCooldown,
+		GestationTime:        DefaultGestationTime,
+		MaturityAge:          DefaultMaturityAge,
+		ElderAge:             DefaultElderAge,
+		MaxAge:               DefaultMaxAge,
 	}
 }
@@ -3,10 +3,9 @@ package simulation
 import (
 	"github.com/aiseeq/savanna/internal/constants"
 	"github.com/aiseeq/savanna/internal/core"
-	"github.com/aiseeq/savanna/internal/vec2"
 )
 
-// EatingSystem отвечает ТОЛЬКО за поедание трупов хищниками (устраняет нарушение SRP)
+// EatingSystem отвечает ТОЛЬКО за поедание трупов хищниками и падальщиками (устраняет нарушение SRP)
 //
 // ВАЖНАЯ ЛОГИКА TargetType в EatingState:
 // - TargetType = EatingTargetAnimal: поедание трупа/падали (обрабатывает EatingSystem)
@@ -14,6 +13,7 @@ import (
 //
 // Эта система игнорирует EatingState с TargetType = EatingTargetGrass, оставляя их для GrassEatingSystem
 type EatingSystem struct {
+	balanced                             // Профиль баланса: питательность трупов за кадр
 	previousFrames map[core.EntityID]int // Память предыдущих кадров для дискретного поедания
 }
 
@@ -44,14 +44,17 @@ func (es *EatingSystem) Update(world *core.World, deltaTime float32) {
 		} else if behavior.Type == core.BehaviorPredator {
 			// Животное не ест - ищем что поесть
 			// Хищники едят трупы
-			es.findCorpseToEat(world, animal)
-			// УДАЛЕНО: BehaviorScavenger - не используется в игре
+			es.findCorpseToEat(world, animal, false)
+		} else if behavior.Type == core.BehaviorScavenger || behavior.Type == core.BehaviorOmnivore {
+			// Падальщики и всеядные едят ближайшую тушу - труп или брошенную хищниками падаль
+			// (всеядное подходит к туше, только если выбрало её вместо травы)
+			es.findCorpseToEat(world, animal, true)
 		}
 	})
 }
 
-// findCorpseToEat ищет ближайший труп для поедания
-func (es *EatingSystem) findCorpseToEat(world *core.World, predator core.EntityID) {
+// findCorpseToEat ищет ближайший труп (с eatsCarrion - ближайшую тушу: труп или падаль) для поедания
+func (es *EatingSystem) findCorpseToEat(world *core.World, predator core.EntityID, eatsCarrion bool) {
 	// Хищник начинает есть только если голоден (используем AnimalConfig)
 	satiation, hasSatiation := world.GetSatiation(predator)
 	config, hasConfig := world.GetAnimalConfig(predator)
@@ -64,38 +67,47 @@ func (es *EatingSystem) findCorpseToEat(world *core.World, predator core.EntityI
 		return
 	}
 
-	// Ищем ближайший труп
-	var closestCorpse core.EntityID
-	var closestDistance float32 = 999999.0
+	// Член стаи присоединяется к трапезе стаи, даже если сытый сородич уже бросил её падалью
+	if meal, found := packMeal(world, predator, predatorPos); found {
+		es.startEating(world, predator, meal)
+		return
+	}
 
-	world.ForEachWith(core.MaskCorpse|core.MaskPosition, func(corpse core.EntityID) {
-		corpsePos, hasCorpsePos := world.GetPosition(corpse)
-		if !hasCorpsePos {
-			return
+	// Ищем ближайшую тушу в пределах досягаемости - так же, как поведение выбирает, к какой идти
+	food, found := findNearestInRadius(world, predatorPos, EatingRange, func(entity core.EntityID) bool {
+		if eatsCarrion {
+			return carcassNutrition(world, entity) > 0
 		}
+		corpse, isCorpse := world.GetCorpse(entity)
+		return isCorpse && corpse.NutritionalValue > 0
+	})
+	if found {
+		es.startEating(world, predator, food)
+	}
+}
 
-		// ЭЛЕГАНТНАЯ МАТЕМАТИКА: расстояние через векторы
-		predatorVec := vec2.Vec2{X: predatorPos.X, Y: predatorPos.Y}
-		corpseVec := vec2.Vec2{X: corpsePos.X, Y: corpsePos.Y}
-		distanceSquared := predatorVec.DistanceSquared(corpseVec)
-
-		// ИСПРАВЛЕНИЕ: Конвертируем EatingRange из тайлов в пиксели
-		eatingRangePixels := EatingRange * float32(constants.TileSizePixels)
-		if distanceSquared < closestDistance && distanceSquared <= eatingRangePixels*eatingRangePixels {
-			closestDistance = distanceSquared
-			closestCorpse = corpse
-		}
+// startEating начинает поедание трупа или падали
+func (es *EatingSystem) startEating(world *core.World, predator, food core.EntityID) {
+	world.AddEatingState(predator, core.EatingState{
+		Target:          food,
+		TargetType:      core.EatingTargetAnimal, // Тип: поедание животного
+		EatingProgress:  constants.InitialProgress,
+		NutritionGained: constants.InitialNutrition,
 	})
+}
 
-	// Если нашли труп рядом, начинаем есть
-	if closestCorpse != constants.NoTarget {
-		world.AddEatingState(predator, core.EatingState{
-			Target:          closestCorpse,
-			TargetType:      core.EatingTargetAnimal, // Тип: поедание животного
-			EatingProgress:  constants.InitialProgress,
-			NutritionGained: constants.InitialNutrition,
-		})
+// packMeal возвращает общую добычу стаи (труп или падаль), если она в пределах досягаемости
+func packMeal(world *core.World, predator core.EntityID, predatorPos core.Position) (core.EntityID, bool) {
+	membership, inPack := world.GetPackMembership(predator)
+	if !inPack || membership.Target == constants.NoTarget || !world.IsAlive(membership.Target) {
+		return 0, false
 	}
+	if !world.HasComponent(membership.Target, core.MaskCorpse) && !world.HasComponent(membership.Target, core.MaskCarrion) {
+		return 0, false // Добыча ещё жива
+	}
+
+	mealPos, _ := world.GetPosition(membership.Target)
+	return membership.Target, predatorPos.DistanceTo(mealPos) <= EatingRange*float32(constants.TileSizePixels)
 }
 
 // continueEating продолжает процесс поедания
@@ -218,7 +230,10 @@ func (es *EatingSystem) processCorpseEatingTick(
 	params CorpseEatingParams,
 ) {
 	// Количество питательности съедаемое за один кадр анимации (как у зайцев - дискретно)
-	nutritionPerTick := float32(CorpseNutritionPerTick)
+	nutritionPerTick := es.profile().Feeding.CorpseNutritionPerTick
+	if behavior, ok := world.GetBehavior(predator); ok && behavior.Type == core.BehaviorScavenger {
+		nutritionPerTick *= ScavengerBiteMultiplier
+	}
 
 	// Съедаем питательность
 	nutritionEaten := nutritionPerTick
@@ -290,4 +305,5 @@ func (es *EatingSystem) convertCorpseToCarrion(world *core.World, corpseEntity,
 	// Удаляем компонент трупа и добавляем компонент падали
 	world.RemoveCorpse(corpseEntity)
 	world.AddCarrion(corpseEntity, carrion)
+	world.EmitEvent(core.CarrionCreated{Carrion: corpseEntity, AbandonedBy: abandonedBy, Nutrition: carrion.NutritionalValue})
 }
@@ -0,0 +1,45 @@
+package simulation
+
+import (
+	"testing"
+
+	"github.com/aiseeq/savanna/internal/core"
+)
+
+func TestEatingSystem_StartsOnNearestCarcass(t *testing.T) {
+	for _, tt := range []struct {
+		name        string
+		diet        core.BehaviorType
+		eatsCarrion bool
+	}{
+		{"scavenger takes nearer carrion over corpse", core.BehaviorScavenger, true},
+		{"predator ignores carrion", core.BehaviorPredator, false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			world := core.NewWorld(640, 640, 12345)
+			system := NewEatingSystem()
+			x, y := tileCenter(10, 10)
+
+			// Труп создан первым, но дальше падали - обе туши в пределах EatingRange
+			corpse := CreateCorpseAndGetID(world, CreateAnimal(world, core.TypeRabbit, x+0.4*TileSizeVegetation, y))
+			carrion := CreateCorpseAndGetID(world, CreateAnimal(world, core.TypeRabbit, x-0.2*TileSizeVegetation, y))
+			system.convertCorpseToCarrion(world, carrion, 0)
+
+			eater := CreateAnimal(world, core.TypeWolf, x, y)
+			behavior, _ := world.GetBehavior(eater)
+			behavior.Type = tt.diet
+			world.SetBehavior(eater, behavior)
+			world.SetSatiation(eater, core.Satiation{Value: 10})
+
+			system.Update(world, 1.0/60.0)
+
+			expected := corpse
+			if tt.eatsCarrion {
+				expected = carrion
+			}
+			if state, eating := world.GetEatingState(eater); !eating || state.Target != expected {
+				t.Errorf("Should start eating carcass %d, eating state %+v (eating %v)", expected, state, eating)
+			}
+		})
+	}
+}
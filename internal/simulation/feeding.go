@@ -171,7 +171,7 @@ func (fs *FeedingSystem) handleRabbitFeeding(world core.SimulationAccess, _ floa
 func (fs *FeedingSystem) processHerbivoreFeeding(world core.SimulationAccess, entity core.EntityID) {
 	// Проверяем что это травоядное
 	behavior, hasBehavior := world.GetBehavior(entity)
-	if !hasBehavior || behavior.Type != core.BehaviorHerbivore {
+	if !hasBehavior || !eatsGrass(behavior.Type) {
 		return
 	}
 
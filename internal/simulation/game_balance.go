@@ -1,5 +1,7 @@
 package simulation
 
+import "math"
+
 // game_balance.go содержит все константы игрового баланса
 // Устраняет магические числа и обеспечивает единую точку настройки игровых параметров
 
@@ -195,6 +197,71 @@ const (
 	AttackAnimationFPS = 6.0 // Скорость анимации атаки (из loader.go)
 )
 
+// === РАЗМНОЖЕНИЕ ===
+
+const (
+	// Условия спаривания (см. docs/article/design.md: сытость определяет возможность размножения)
+	ReproductionSatiationThreshold = 70.0 // Минимальная сытость обоих родителей (%)
+	ReproductionSatiationCost      = 20.0 // Сытость которую теряет каждый родитель при спаривании
+	MatingRange                    = 1.0  // Дистанция спаривания (в тайлах)
+
+	// Время между спариваниями и вынашивания (секунды)
+	RabbitReproductionCooldown  = 40.0 // Зайцы размножаются часто
+	RabbitGestationTime         = 20.0
+	WolfReproductionCooldown    = 90.0 // Волки размножаются медленно
+	WolfGestationTime           = 45.0
+	DefaultReproductionCooldown = 60.0
+	DefaultGestationTime        = 30.0
+
+	// Потомство
+	OffspringSpawnDistance   = 0.5  // Расстояние появления потомка от матери (в тайлах)
+	ReproductionMutationRate = 0.05 // Мутация параметров потомка (±5%)
+
+	// Визуализация
+	BreedAnimationDuration = 1.5 // Длительность анимации спаривания (секунды)
+)
+
+// === ЖАЖДА ===
+
+const (
+	// Гидратация (0-100), аналогично сытости
+	MaxHydration               = 100.0
+	BaseHydrationDecreaseRate  = 1.0  // Процентов в секунду
+	LargeAnimalHydrationRate   = 0.75 // Крупные животные теряют воду медленнее
+	ThirstThreshold            = 50.0 // При какой гидратации животное идёт к воде
+	WaterMemoryRangeMultiplier = 3.0  // Животные помнят водопои дальше дальности зрения
+
+	// Питьё: животное стоит на тайле рядом с водой
+	DrinkRate = 25.0 // Гидратация восстанавливаемая за секунду питья
+
+	// Урон здоровью при обезвоживании (гидратация = 0)
+	DehydrationDamagePerSecond = 2 // Жажда опаснее голода
+)
+
+// === ВОЗРАСТ И СТАДИИ ЖИЗНИ ===
+
+const (
+	// Возраст взросления, начала старения и естественной смерти (секунды)
+	RabbitMaturityAge  = 60.0
+	RabbitElderAge     = 480.0
+	RabbitMaxAge       = 600.0
+	WolfMaturityAge    = 120.0 // Волки взрослеют и живут дольше зайцев
+	WolfElderAge       = 900.0
+	WolfMaxAge         = 1200.0
+	DefaultMaturityAge = 90.0
+	DefaultElderAge    = 700.0
+	DefaultMaxAge      = 900.0
+
+	// Детёныши: множители при рождении, линейно растут до 1.0 к возрасту взросления
+	JuvenileSizeMultiplier   = 0.5 // Детёныш вдвое меньше взрослого
+	JuvenileSpeedMultiplier  = 0.6
+	JuvenileAttackMultiplier = 0.3
+
+	// Старость: множители линейно падают от 1.0 до этих значений к MaxAge
+	ElderMinSpeedMultiplier  = 0.5
+	ElderMinHealthMultiplier = 0.5
+)
+
 // === ГЕЙМПЛЕЙНЫЕ КОНСТАНТЫ ===
 
 const (
@@ -235,4 +302,65 @@ const (
 
 	// Дистанция безопасности для предварительного расталкивания
 	SafeDistanceBufferInTiles = 0.1 // Буферная дистанция в тайлах для предотвращения слипания
+
+	// Обход воды и кустов при побеге и погоне (behavior_strategies.go)
+	TerrainSteeringProbeOffset = 0.5             // Проверка тайла на полтайла впереди края тела
+	TerrainSteeringLookahead   = 1.5             // Дальняя проверка пути (тайлы)
+	TerrainSteeringAngleStep   = math.Pi / 6     // Шаг поворота при поиске свободного направления (30°)
+	TerrainSteeringMaxAngle    = 5 * math.Pi / 6 // Максимальное отклонение (150°) - назад не разворачиваемся
+)
+
+// === СТАДО ТРАВОЯДНЫХ ===
+
+const (
+	// Стадо зайцев (boids): веса правил - доля скорости, на которую животное доворачивает за решение
+	RabbitHerdRadius     = 3.0  // Соседи по стаду в пределах 3 тайлов (≈ зрение зайца)
+	RabbitHerdCohesion   = 0.03 // Слабое притяжение - стадо держится рыхлой группой
+	RabbitHerdAlignment  = 0.05
+	RabbitHerdSeparation = 0.15 // Отталкивание сильнее притяжения - зайцы не слипаются
+	RabbitHearingRange   = 4.0  // Тревогу сородича слышно дальше, чем видно хищника
+
+	// Отталкивание от соседей ближе этой дистанции (в радиусах коллизий)
+	HerdSeparationRadiusMultiplier = 3.0
+)
+
+// === УКРЫТИЯ В КУСТАХ ===
+
+const (
+	// Мелкие травоядные (заяц) пролезают в кусты, крупные (зебра) и хищники - нет
+	CoverMaxRadius   = 0.3  // Максимальный радиус тела для укрытия в кустах (тайлы)
+	CoverHugDistance = 0.25 // Животное прижимается к кусту, если край тела ближе этой дистанции (тайлы)
+
+	// Хищник замечает спрятавшееся животное только на доле дальности зрения
+	CoverVisibilityInside = 0.3 // В кусте: волк (5 тайлов) видит зайца с 1.5 тайлов
+	CoverVisibilityEdge   = 0.6 // У края куста
+)
+
+// === ПАДАЛЬЩИКИ ===
+
+const (
+	// Падальщик (гиена) не охотится: ищет трупы и падаль, делит добычу с хищниками
+	ScavengerBiteMultiplier = 2.0 // Падальщик отрывает вдвое больше за укус - туши исчезают быстрее
+)
+
+// === ВСЕЯДНЫЕ ===
+
+const (
+	// Всеядное выбирает между травой и тушей по ценности: сытость, которую даст еда
+	// (не больше недостающей), делённая на путь до неё с поправкой OmnivoreDistanceBias
+	OmnivoreMeatPreference = 4.0 // Мясо ценнее травы: ради туши всеядное идёт вчетверо дальше
+	OmnivoreDistanceBias   = 1.0 // Поправка пути (тайлы): еда под ногами не бесконечно ценна
+)
+
+// === СТАЙНАЯ ОХОТА ===
+
+const (
+	// Стая: вожак выбирает добычу, загонщики обходят её с флангов
+	PackMaxSize        = 4    // Максимум хищников в стае (1 - одиночная охота)
+	PackFormationRange = 5.0  // Хищники ближе этой дистанции объединяются в стаю (в тайлах, ≈ зрение волка)
+	PackCohesionRange  = 10.0 // Дальше от вожака - хищник покидает стаю (в тайлах)
+	PackFlankDistance  = 2.0  // Точка перехвата загонщика впереди добычи (в тайлах)
+
+	PackFlankAngle      = math.Pi / 3 // Отклонение точки перехвата от линии бегства добычи
+	PackRegroupFraction = 0.5         // Не охотящийся загонщик возвращается к вожаку дальше этой доли сплочённости
 )
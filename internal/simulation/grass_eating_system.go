@@ -14,6 +14,7 @@ import (
 //
 // Эта система работает ТОЛЬКО с EatingState где TargetType = EatingTargetGrass
 type GrassEatingSystem struct {
+	balanced                               // Профиль баланса: поедание травы
 	vegetation     core.VegetationProvider // Интерфейс для работы с растительностью (соблюдение DIP)
 	previousFrames map[core.EntityID]int   // Память предыдущих кадров для обнаружения смены
 }
@@ -37,7 +38,7 @@ func (ges *GrassEatingSystem) Update(world *core.World, deltaTime float32) {
 	world.ForEachWith(grassEatingMask, func(entity core.EntityID) {
 		// Проверяем что это травоядное через поведение, а НЕ через захардкоженный тип
 		behavior, hasBehavior := world.GetBehavior(entity)
-		if !hasBehavior || behavior.Type != core.BehaviorHerbivore {
+		if !hasBehavior || !eatsGrass(behavior.Type) {
 			return
 		}
 
@@ -53,7 +54,7 @@ func (ges *GrassEatingSystem) Update(world *core.World, deltaTime float32) {
 
 		// Проверяем что рядом есть трава (ТИПОБЕЗОПАСНО)
 		grassAmount := ges.vegetation.GetGrassAt(pos.X, pos.Y)
-		if grassAmount < MinGrassAmountToFind {
+		if grassAmount < ges.profile().Feeding.MinGrassAmountToFind {
 			// Нет травы - убираем состояние поедания
 			world.RemoveEatingState(entity)
 			// Очищаем память кадров
@@ -112,10 +113,12 @@ func (ges *GrassEatingSystem) processGrassEatingTick(
 	world *core.World, entity core.EntityID, eatingState core.EatingState, pos core.Position,
 ) {
 	// Количество травы съедаемое за один кадр анимации (как у волка - дискретно)
-	grassPerTick := float32(GrassPerEatingTick) // 1.0 единица травы за кадр анимации
+	grassPerTick := ges.profile().Feeding.GrassPerEatingTick
 
 	// Съедаем траву (ТИПОБЕЗОПАСНО)
+	grassBefore := ges.vegetation.GetGrassAt(pos.X, pos.Y)
 	consumedGrass := ges.vegetation.ConsumeGrassAt(pos.X, pos.Y, grassPerTick)
+	ges.reportDepletion(world, entity, pos, grassBefore)
 	if consumedGrass <= 0 {
 		// Нет травы - заканчиваем поедание
 		world.RemoveEatingState(entity)
@@ -130,8 +133,8 @@ func (ges *GrassEatingSystem) processGrassEatingTick(
 	world.SetEatingState(entity, eatingState)
 
 	// Восстанавливаем голод пропорционально съеденной траве
-	// Используем константу питательности травы
-	hungerToRestore := consumedGrass * GrassNutritionValue
+	// Используем питательность травы из профиля баланса
+	hungerToRestore := consumedGrass * ges.profile().Feeding.GrassNutritionValue
 
 	hunger, hasHunger := world.GetSatiation(entity)
 	if hasHunger {
@@ -151,6 +154,20 @@ func (ges *GrassEatingSystem) processGrassEatingTick(
 	}
 }
 
+// reportDepletion публикует GrassDepleted, если укус сделал тайл непригодным для поедания
+func (ges *GrassEatingSystem) reportDepletion(world *core.World, entity core.EntityID, pos core.Position, grassBefore float32) {
+	minGrass := ges.profile().Feeding.MinGrassAmountToFind
+	if grassBefore < minGrass || ges.vegetation.GetGrassAt(pos.X, pos.Y) >= minGrass {
+		return
+	}
+
+	world.EmitEvent(core.GrassDepleted{
+		TileX: int(pos.X / TileSizeVegetation),
+		TileY: int(pos.Y / TileSizeVegetation),
+		Eater: entity,
+	})
+}
+
 // feedAnimal восстанавливает голод животного
 func (ges *GrassEatingSystem) feedAnimal(world *core.World, entity core.EntityID, foodValue float32) {
 	hunger, hasHunger := world.GetSatiation(entity)
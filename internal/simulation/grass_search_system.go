@@ -8,6 +8,7 @@ import (
 // GrassSearchSystem ищет траву и создаёт EatingState для травоядных (SRP)
 // Единственная ответственность: поиск травы и создание состояния поедания
 type GrassSearchSystem struct {
+	balanced                           // Профиль баланса: минимум травы для поедания
 	vegetation core.VegetationProvider // Интерфейс для работы с растительностью (соблюдение DIP)
 }
 
@@ -56,10 +57,8 @@ func (gss *GrassSearchSystem) processHerbivoreFeeding(world core.GrassSearchSyst
 		return
 	}
 
-	isHerbivore := behavior.Type == core.BehaviorHerbivore
 	isHungry := satiation.Value < config.SatiationThreshold
-
-	if !isHerbivore || !isHungry {
+	if !eatsGrass(behavior.Type) || !isHungry {
 		return
 	}
 
@@ -68,6 +67,12 @@ func (gss *GrassSearchSystem) processHerbivoreFeeding(world core.GrassSearchSyst
 		return
 	}
 
+	// Всеядное пасётся, только если трава выгоднее ближайшей туши
+	if behavior.Type == core.BehaviorOmnivore &&
+		chooseOmnivoreFood(world, gss.vegetation, gss.profile().Feeding, pos, config, satiation.Value).Food != OmnivoreFoodGrass {
+		return
+	}
+
 	// Ищем и управляем поеданием травы
 	gss.manageGrassEating(world, entity, pos)
 }
@@ -85,7 +90,8 @@ func (gss *GrassSearchSystem) manageGrassEating(
 	// ИСПРАВЛЕНИЕ: FindNearestGrass ожидает радиус в пикселях, а VisionRange в тайлах
 	visionRangePixels := constants.TilesToPixels(visionRange)
 
-	_, _, found := gss.vegetation.FindNearestGrass(pos.X, pos.Y, visionRangePixels, MinGrassAmountToFind)
+	minGrass := gss.profile().Feeding.MinGrassAmountToFind
+	_, _, found := gss.vegetation.FindNearestGrass(pos.X, pos.Y, visionRangePixels, minGrass)
 
 	if found {
 		// Создаём состояние поедания травы
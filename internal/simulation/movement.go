@@ -70,6 +70,11 @@ func NewMovementSystem(worldWidth, worldHeight float32) *MovementSystem {
 	}
 }
 
+// SetTerrain включает коллизии с водой и кустами (nil - движение без учёта ландшафта)
+func (ms *MovementSystem) SetTerrain(terrain core.PassabilityProvider) {
+	ms.manager.SetTerrain(terrain)
+}
+
 // Update обновляет движение всех сущностей
 // РЕФАКТОРИНГ SRP: Делегирует работу специализированному менеджеру
 func (ms *MovementSystem) Update(world core.MovementSystemAccess, deltaTime float32) {
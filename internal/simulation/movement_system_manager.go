@@ -10,6 +10,7 @@ type MovementSystemManager struct {
 	positionUpdateSystem     *PositionUpdateSystem
 	collisionSystem          *CollisionSystem
 	boundaryConstraintSystem *BoundaryConstraintSystem
+	terrainCollisionSystem   *TerrainCollisionSystem // nil - ландшафт не учитывается (тесты без карты)
 }
 
 // NewMovementSystemManager создаёт новый менеджер систем движения
@@ -21,6 +22,15 @@ func NewMovementSystemManager(worldWidth, worldHeight float32) *MovementSystemMa
 	}
 }
 
+// SetTerrain включает коллизии с непроходимыми тайлами (nil - отключает)
+func (msm *MovementSystemManager) SetTerrain(terrain core.PassabilityProvider) {
+	if terrain == nil {
+		msm.terrainCollisionSystem = nil
+		return
+	}
+	msm.terrainCollisionSystem = NewTerrainCollisionSystem(terrain)
+}
+
 // Update обновляет все системы движения в правильном порядке
 func (msm *MovementSystemManager) Update(world core.MovementSystemAccess, deltaTime float32) {
 	// 1. Обновляем позиции по скорости
@@ -32,4 +42,10 @@ func (msm *MovementSystemManager) Update(world core.MovementSystemAccess, deltaT
 	// 3. ИСПРАВЛЕНИЕ: Ограничиваем границами мира ПОСЛЕ коллизий
 	// чтобы расталкивание не выталкивало животных за границы
 	msm.boundaryConstraintSystem.Update(world)
+
+	// 4. Выталкиваем из воды и кустов ПОСЛЕДНИМ: ни расталкивание, ни границы не должны
+	// оставить животное в непроходимом тайле. Выталкивание идёт к проходимым тайлам внутри мира
+	if msm.terrainCollisionSystem != nil {
+		msm.terrainCollisionSystem.Update(world)
+	}
 }
@@ -0,0 +1,88 @@
+package simulation
+
+import (
+	"slices"
+
+	"github.com/aiseeq/savanna/internal/constants"
+	"github.com/aiseeq/savanna/internal/core"
+)
+
+// OmnivoreFood пища, выбранная всеядным
+type OmnivoreFood int
+
+const (
+	OmnivoreFoodNone    OmnivoreFood = iota // Еды в поле зрения нет
+	OmnivoreFoodGrass                       // Пастись
+	OmnivoreFoodCarcass                     // Доедать тушу
+)
+
+// OmnivoreMeal выбор всеядного: вид пищи, туша (для OmnivoreFoodCarcass) и точка, куда идти
+type OmnivoreMeal struct {
+	Food    OmnivoreFood
+	Carcass core.EntityID
+	Target  core.Position
+}
+
+// carcassQueries чтение мира для поиска туш (ISP: хватает поведению и поиску травы)
+type carcassQueries interface {
+	QueryInRadius(x, y, radius float32) []core.EntityID
+	GetPosition(core.EntityID) (core.Position, bool)
+	GetCorpse(core.EntityID) (core.Corpse, bool)
+	GetCarrion(core.EntityID) (core.Carrion, bool)
+}
+
+// preyDiets типы питания добычи хищников: травоядные и всеядные
+var preyDiets = []core.BehaviorType{core.BehaviorHerbivore, core.BehaviorOmnivore}
+
+// isPrey проверяет что животное с этим типом питания - добыча хищников
+func isPrey(diet core.BehaviorType) bool {
+	return slices.Contains(preyDiets, diet)
+}
+
+// eatsGrass проверяет что животное с этим типом питания пасётся (травоядные и всеядные)
+func eatsGrass(diet core.BehaviorType) bool {
+	return diet == core.BehaviorHerbivore || diet == core.BehaviorOmnivore
+}
+
+// chooseOmnivoreFood выбирает между ближайшей травой и ближайшей тушей в радиусе зрения
+// Ценность еды - сытость, которую она даст (не больше недостающей), делённая на путь до неё.
+// Туша ценнее травы в OmnivoreMeatPreference раз; при равной ценности всеядное пасётся.
+// Используется и поведением (куда идти), и GrassSearchSystem (начинать ли пастись) - решение одно
+func chooseOmnivoreFood(
+	world carcassQueries,
+	vegetation core.VegetationProvider,
+	feeding FeedingBalance,
+	pos core.Position,
+	config core.AnimalConfig,
+	satiation float32,
+) OmnivoreMeal {
+	hunger := MaxSatiationLimit - satiation
+	meal := OmnivoreMeal{}
+	bestScore := float32(0)
+
+	if vegetation != nil {
+		visionPixels := constants.TilesToPixels(config.VisionRange)
+		if grassX, grassY, found := vegetation.FindNearestGrass(pos.X, pos.Y, visionPixels, feeding.MinGrassAmountToFind); found {
+			grassPos := core.NewPosition(grassX, grassY)
+			value := min(vegetation.GetGrassAt(grassX, grassY)*feeding.GrassNutritionValue, hunger)
+			meal = OmnivoreMeal{Food: OmnivoreFoodGrass, Target: grassPos}
+			bestScore = foodScore(value, pos, grassPos)
+		}
+	}
+
+	if carcass, found := findNearestCarcass(world, pos, config.VisionRange); found {
+		carcassPos, _ := world.GetPosition(carcass)
+		value := min(carcassNutrition(world, carcass)*constants.NutritionToHungerRatio, hunger) * OmnivoreMeatPreference
+		if score := foodScore(value, pos, carcassPos); score > bestScore {
+			meal = OmnivoreMeal{Food: OmnivoreFoodCarcass, Carcass: carcass, Target: carcassPos}
+		}
+	}
+
+	return meal
+}
+
+// foodScore ценность еды с учётом пути до неё
+func foodScore(value float32, from, food core.Position) float32 {
+	distance := constants.PixelsToTiles(from.DistanceTo(food))
+	return value / (distance + OmnivoreDistanceBias)
+}
@@ -0,0 +1,59 @@
+package simulation
+
+import (
+	"testing"
+
+	"github.com/aiseeq/savanna/internal/core"
+)
+
+func TestChooseOmnivoreFood_WeighsValueDistanceAndHunger(t *testing.T) {
+	const omnivoreTile = 10
+	config := core.AnimalConfig{VisionRange: 5}
+	feeding := DefaultBalanceProfile().Feeding
+
+	tests := []struct {
+		name      string
+		grassTile int     // -1 - травы нет
+		carcass   int     // Тайл туши, -1 - туши нет
+		nutrition float32 // Остаток питательности туши
+		satiation float32
+		expected  OmnivoreFood
+	}{
+		{"nothing in sight", -1, -1, 0, 40, OmnivoreFoodNone},
+		{"only grass", omnivoreTile, -1, 0, 40, OmnivoreFoodGrass},
+		{"only carcass", -1, 13, 50, 40, OmnivoreFoodCarcass},
+		{"nearby carcass beats distant grass", 6, 12, 50, 40, OmnivoreFoodCarcass},
+		{"grass underfoot beats distant carcass", omnivoreTile, 14, 50, 40, OmnivoreFoodGrass},
+		// Почти съеденная туша: голодному мало, почти сытому хватает
+		{"starving prefers pasture to leftovers", omnivoreTile, 11, 10, 0, OmnivoreFoodGrass},
+		{"nearly full takes leftovers nearby", omnivoreTile, 11, 10, 90, OmnivoreFoodCarcass},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			terrain := newPondTerrain(20, 0, 0)
+			if tt.grassTile >= 0 {
+				terrain.SetGrassAmount(tt.grassTile, omnivoreTile, GrassMaxAmount)
+			}
+			world := core.NewWorld(640, 640, 12345)
+			var carcass core.EntityID
+			if tt.carcass >= 0 {
+				x, y := tileCenter(tt.carcass, omnivoreTile)
+				carcass = CreateCorpseAndGetID(world, CreateAnimal(world, core.TypeRabbit, x, y))
+				corpse, _ := world.GetCorpse(carcass)
+				corpse.NutritionalValue = tt.nutrition
+				world.SetCorpse(carcass, corpse)
+			}
+
+			x, y := tileCenter(omnivoreTile, omnivoreTile)
+			meal := chooseOmnivoreFood(world, NewVegetationSystem(terrain), feeding, core.NewPosition(x, y), config, tt.satiation)
+
+			if meal.Food != tt.expected {
+				t.Fatalf("Expected food %d, got %d", tt.expected, meal.Food)
+			}
+			if meal.Food == OmnivoreFoodCarcass && meal.Carcass != carcass {
+				t.Errorf("Expected carcass %d, got %d", carcass, meal.Carcass)
+			}
+		})
+	}
+}
@@ -0,0 +1,274 @@
+package simulation
+
+import (
+	"github.com/aiseeq/savanna/internal/constants"
+	"github.com/aiseeq/savanna/internal/core"
+)
+
+// PackSystem отвечает ТОЛЬКО за состав стай хищников и их общую цель (SRP)
+// Хищники одного вида рядом друг с другом объединяются в стаю, отставшие её покидают,
+// вожак выбирает добычу. Движение членов стаи задаёт PredatorBehaviorStrategy:
+// вожак гонит добычу, загонщики отрезают ей пути бегства, затем стая вместе ест труп
+type PackSystem struct {
+	balanced // Профиль баланса: размер и сплочённость стаи
+}
+
+// NewPackSystem создаёт новую систему стай
+func NewPackSystem() *PackSystem {
+	return &PackSystem{}
+}
+
+// packMask компоненты хищника, способного охотиться в стае
+const packMask = core.MaskBehavior | core.MaskPosition | core.MaskAnimalType | core.MaskAnimalConfig | core.MaskSatiation
+
+// pack члены одной стаи: вожак первым, остальные в порядке слотов сущностей
+type pack struct {
+	leader      core.EntityID
+	target      core.EntityID   // Общая цель стаи
+	members     []core.EntityID // Вожак первым
+	memberSlots []uint8         // Номер каждого члена в стае (параллельно members)
+	slots       uint64          // Занятые номера в стае (бит на номер)
+}
+
+// newPack создаёт стаю из одного вожака
+func newPack(leader, target core.EntityID) *pack {
+	return &pack{leader: leader, target: target, members: []core.EntityID{leader}, memberSlots: []uint8{0}, slots: 1}
+}
+
+// add добавляет члена стаи с известным номером
+func (p *pack) add(entity core.EntityID, slot uint8) {
+	p.members = append(p.members, entity)
+	p.memberSlots = append(p.memberSlots, slot)
+	p.slots |= 1 << slot
+}
+
+// Update пересобирает стаи, обновляет их цели и записывает членство
+func (ps *PackSystem) Update(world *core.World, deltaTime float32) {
+	packs := ps.collectPacks(world)
+	packs = ps.formPacks(world, packs)
+
+	for _, p := range packs {
+		if len(p.members) >= 2 {
+			ps.updateTarget(world, p) // Стая из одного вожака распадается
+		}
+	}
+	ps.writeMemberships(world, packs)
+}
+
+// collectPacks собирает стаи из членства без погибших и отставших, возвращает их в порядке вожаков
+func (ps *PackSystem) collectPacks(world *core.World) []*pack {
+	cohesion := constants.TilesToPixels(ps.profile().Pack.CohesionRange)
+
+	var members []core.EntityID
+	world.ForEachWith(core.MaskPackMembership, func(entity core.EntityID) {
+		members = append(members, entity)
+	})
+
+	// Сначала вожаки: загонщик остаётся только при живом вожаке рядом
+	var packs []*pack
+	byLeader := make(map[core.EntityID]*pack)
+	for _, entity := range members {
+		membership, _ := world.GetPackMembership(entity)
+		if !membership.IsLeader(entity) || !isPackHunter(world, entity) {
+			continue
+		}
+		p := newPack(entity, membership.Target)
+		byLeader[entity] = p
+		packs = append(packs, p)
+	}
+
+	for _, entity := range members {
+		membership, _ := world.GetPackMembership(entity)
+		if membership.IsLeader(entity) {
+			continue
+		}
+
+		p, leaderAlive := byLeader[membership.Leader]
+		if !leaderAlive || !isPackHunter(world, entity) || distanceBetween(world, entity, p.leader) > cohesion {
+			continue
+		}
+		p.add(entity, membership.Slot)
+	}
+
+	return packs
+}
+
+// formPacks присоединяет хищников без стаи к ближайшей стае своего вида или объединяет их в новую
+func (ps *PackSystem) formPacks(world *core.World, packs []*pack) []*pack {
+	balance := ps.profile().Pack
+	if balance.MaxSize < 2 {
+		return packs // Одиночная охота
+	}
+	formation := constants.TilesToPixels(balance.FormationRange)
+
+	inPack := make(map[core.EntityID]bool)
+	for _, p := range packs {
+		for _, member := range p.members {
+			inPack[member] = true
+		}
+	}
+
+	var loners []core.EntityID
+	world.ForEachWith(packMask, func(entity core.EntityID) {
+		if !inPack[entity] && isPackHunter(world, entity) {
+			loners = append(loners, entity)
+		}
+	})
+
+	for i, entity := range loners {
+		if inPack[entity] {
+			continue // Уже принят в стаю, созданную на этом тике
+		}
+		animalType, _ := world.GetAnimalType(entity)
+
+		if p := nearestOpenPack(world, packs, entity, animalType, formation, balance.MaxSize); p != nil {
+			p.join(entity)
+			inPack[entity] = true
+			continue
+		}
+
+		// Ближайший свободный хищник того же вида становится первым загонщиком новой стаи
+		partner, found := core.EntityID(0), false
+		bestDistance := formation
+		for _, other := range loners[i+1:] {
+			otherType, _ := world.GetAnimalType(other)
+			if otherType != animalType || inPack[other] {
+				continue
+			}
+			if distance := distanceBetween(world, entity, other); distance <= bestDistance {
+				partner, found, bestDistance = other, true, distance
+			}
+		}
+		if !found {
+			continue
+		}
+
+		p := newPack(entity, 0)
+		p.join(partner)
+		inPack[entity], inPack[partner] = true, true
+		packs = append(packs, p)
+	}
+
+	return packs
+}
+
+// nearestOpenPack ближайшая стая того же вида со свободным местом (по расстоянию до вожака)
+func nearestOpenPack(
+	world *core.World, packs []*pack, entity core.EntityID, animalType core.AnimalType, formation float32, maxSize int,
+) *pack {
+	var nearest *pack
+	bestDistance := formation
+	for _, p := range packs {
+		leaderType, _ := world.GetAnimalType(p.leader)
+		if leaderType != animalType || len(p.members) >= maxSize {
+			continue
+		}
+		if distance := distanceBetween(world, entity, p.leader); distance <= bestDistance {
+			nearest, bestDistance = p, distance
+		}
+	}
+	return nearest
+}
+
+// join добавляет хищника в стаю на первый свободный номер
+func (p *pack) join(entity core.EntityID) {
+	slot := uint8(1)
+	for p.slots&(1<<slot) != 0 {
+		slot++
+	}
+	p.add(entity, slot)
+}
+
+// updateTarget выбирает общую цель стаи
+// Цель сохраняется пока добыча рядом со стаей; убитая добыча остаётся целью как труп - стая ест вместе
+func (ps *PackSystem) updateTarget(world *core.World, p *pack) {
+	switch {
+	case !packIsHungry(world, p):
+		p.target = 0
+	case ps.isValidTarget(world, p.leader, p.target):
+		// Продолжаем охоту или трапезу
+	default:
+		p.target = 0
+		pos, _ := world.GetPosition(p.leader)
+		config, _ := world.GetAnimalConfig(p.leader)
+		if prey, found := findNearestPrey(world, pos, config.VisionRange); found {
+			p.target = prey
+		}
+	}
+}
+
+// writeMemberships сообщает членам стай их номер и общую цель, остальные хищники покидают стаи
+// Изменение значения применяется сразу, добавление и снятие компонента - отложенными командами
+func (ps *PackSystem) writeMemberships(world *core.World, packs []*pack) {
+	commands := world.Commands()
+	members := make(map[core.EntityID]bool)
+
+	for _, p := range packs {
+		if len(p.members) < 2 {
+			continue
+		}
+		for i, member := range p.members {
+			members[member] = true
+			membership := core.PackMembership{Leader: p.leader, Target: p.target, Slot: p.memberSlots[i]}
+			if !world.SetPackMembership(member, membership) {
+				commands.Modify(member, func(world *core.World, entity core.EntityID) {
+					world.AddPackMembership(entity, membership)
+				})
+			}
+		}
+	}
+
+	world.ForEachWith(core.MaskPackMembership, func(entity core.EntityID) {
+		if !members[entity] {
+			commands.Modify(entity, removePackMembership)
+		}
+	})
+}
+
+// removePackMembership выводит хищника из стаи (отложенная команда)
+func removePackMembership(world *core.World, entity core.EntityID) {
+	world.RemovePackMembership(entity)
+}
+
+// isValidTarget проверяет цель стаи: живая добыча в пределах сплочённости или недоеденный труп
+func (ps *PackSystem) isValidTarget(world *core.World, leader, target core.EntityID) bool {
+	if target == 0 || !world.IsAlive(target) {
+		return false
+	}
+	if corpse, isCorpse := world.GetCorpse(target); isCorpse {
+		return corpse.NutritionalValue > 0
+	}
+	if carrion, isCarrion := world.GetCarrion(target); isCarrion {
+		return carrion.NutritionalValue > 0
+	}
+
+	behavior, hasBehavior := world.GetBehavior(target)
+	return hasBehavior && isPrey(behavior.Type) &&
+		distanceBetween(world, leader, target) <= constants.TilesToPixels(ps.profile().Pack.CohesionRange)
+}
+
+// packIsHungry стая охотится, пока голоден хотя бы один её член
+func packIsHungry(world *core.World, p *pack) bool {
+	for _, member := range p.members {
+		satiation, _ := world.GetSatiation(member)
+		config, _ := world.GetAnimalConfig(member)
+		if satiation.Value < config.SatiationThreshold {
+			return true
+		}
+	}
+	return false
+}
+
+// isPackHunter проверяет что сущность - живой хищник, способный охотиться в стае
+func isPackHunter(world *core.World, entity core.EntityID) bool {
+	behavior, hasBehavior := world.GetBehavior(entity)
+	return hasBehavior && behavior.Type == core.BehaviorPredator &&
+		world.HasComponents(entity, packMask) && isAliveAnimal(world, entity)
+}
+
+// distanceBetween расстояние между двумя сущностями (пиксели)
+func distanceBetween(world *core.World, a, b core.EntityID) float32 {
+	posA, _ := world.GetPosition(a)
+	posB, _ := world.GetPosition(b)
+	return posA.DistanceTo(posB)
+}
@@ -35,6 +35,10 @@ func (pus *PositionUpdateSystem) shouldSkipPositionUpdate(world core.MovementSys
 		world.SetVelocity(entity, core.Velocity{X: 0, Y: 0})
 		return true // Животное ест, не двигается
 	}
+	if world.HasComponent(entity, core.MaskDrinkingState) {
+		world.SetVelocity(entity, core.Velocity{X: 0, Y: 0})
+		return true // Животное пьёт, не двигается
+	}
 
 	// Читаем скорость
 	vel, hasVel := world.GetVelocity(entity)
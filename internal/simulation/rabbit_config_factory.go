@@ -26,6 +26,7 @@ func (f *RabbitConfigFactory) CreateConfig() core.AnimalConfig {
 		VisionRange:     RabbitBaseRadius * RabbitVisionMultiplier,
 
 		// Поведение травоядного
+		Diet:               core.BehaviorHerbivore,
 		SatiationThreshold: RabbitSatiationThreshold,
 		FleeThreshold:      RabbitBaseRadius * RabbitFleeDistanceMultiplier,
 
@@ -38,9 +39,23 @@ func (f *RabbitConfigFactory) CreateConfig() core.AnimalConfig {
 		MinDirectionTime: RabbitMinDirectionTime,
 		MaxDirectionTime: RabbitMaxDirectionTime,
 
+		// Стадо
+		HerdRadius:     RabbitHerdRadius,
+		HerdCohesion:   RabbitHerdCohesion,
+		HerdAlignment:  RabbitHerdAlignment,
+		HerdSeparation: RabbitHerdSeparation,
+		HearingRange:   RabbitHearingRange,
+
 		// Боевые характеристики (заяц мирный)
 		AttackDamage:   PacifistAttackDamage,
 		AttackCooldown: PacifistAttackCooldown,
 		HitChance:      PacifistHitChance,
+
+		// Размножение
+		ReproductionCooldown: RabbitReproductionCooldown,
+		GestationTime:        RabbitGestationTime,
+		MaturityAge:          RabbitMaturityAge,
+		ElderAge:             RabbitElderAge,
+		MaxAge:               RabbitMaxAge,
 	}
 }
@@ -0,0 +1,330 @@
+package simulation
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/aiseeq/savanna/internal/constants"
+	"github.com/aiseeq/savanna/internal/core"
+)
+
+// ReproductionSystem отвечает ТОЛЬКО за размножение (SRP)
+// Спаривание сытых животных одного типа, вынашивание и рождение потомства
+type ReproductionSystem struct {
+	balanced // Профиль баланса: условия спаривания и мутации
+}
+
+// NewReproductionSystem создаёт новую систему размножения
+func NewReproductionSystem() *ReproductionSystem {
+	return &ReproductionSystem{}
+}
+
+// Update обновляет кулдауны, беременности и ищет новые пары
+func (rs *ReproductionSystem) Update(world *core.World, deltaTime float32) {
+	rs.updateCooldowns(world, deltaTime)
+	rs.updatePregnancies(world, deltaTime)
+	rs.matePairs(world)
+}
+
+// updateCooldowns уменьшает таймеры кулдауна и удаляет истёкшие
+func (rs *ReproductionSystem) updateCooldowns(world *core.World, deltaTime float32) {
+	world.ForEachWith(core.MaskReproductionCooldown, func(entity core.EntityID) {
+		cooldown, _ := world.GetReproductionCooldown(entity)
+		cooldown.Timer = max(cooldown.Timer-deltaTime, 0)
+		cooldown.BreedTimer = max(cooldown.BreedTimer-deltaTime, 0)
+		world.SetReproductionCooldown(entity, cooldown)
+
+		if cooldown.Timer <= 0 && cooldown.BreedTimer <= 0 {
+			world.Commands().Modify(entity, removeReproductionCooldown)
+		}
+	})
+}
+
+// updatePregnancies уменьшает таймеры вынашивания и рождает потомство
+func (rs *ReproductionSystem) updatePregnancies(world *core.World, deltaTime float32) {
+	world.ForEachWith(core.MaskPregnancy, func(mother core.EntityID) {
+		// ИСПРАВЛЕНИЕ: Труп сохраняет компоненты - беременность погибшей матери прерывается
+		if !isAliveAnimal(world, mother) {
+			world.Commands().Modify(mother, removePregnancy)
+			return
+		}
+
+		pregnancy, _ := world.GetPregnancy(mother)
+		pregnancy.Timer -= deltaTime
+		world.SetPregnancy(mother, pregnancy)
+
+		if pregnancy.Timer <= 0 {
+			world.Commands().Modify(mother, rs.giveBirth)
+		}
+	})
+}
+
+// removeReproductionCooldown снимает истёкший кулдаун (команда буфера)
+func removeReproductionCooldown(world *core.World, entity core.EntityID) {
+	world.RemoveReproductionCooldown(entity)
+}
+
+// removePregnancy прерывает беременность (команда буфера)
+func removePregnancy(world *core.World, mother core.EntityID) {
+	world.RemovePregnancy(mother)
+}
+
+// giveBirth создаёт потомка рядом с матерью с унаследованной конфигурацией (команда буфера)
+func (rs *ReproductionSystem) giveBirth(world *core.World, mother core.EntityID) {
+	pregnancy, _ := world.GetPregnancy(mother)
+	world.RemovePregnancy(mother)
+
+	animalType, _ := world.GetAnimalType(mother)
+	pos, _ := world.GetPosition(mother)
+	x, y := offspringPosition(world, pos)
+
+	offspring := CreateAnimal(world, animalType, x, y)
+	applyAnimalConfig(world, offspring, pregnancy.OffspringConfig)
+
+	// Новорождённый - детёныш: не спаривается, пока не вырастет
+	NewbornAge(world, offspring)
+
+	world.EmitEvent(core.Birth{Child: offspring, Mother: mother, Type: animalType, Position: core.NewPosition(x, y)})
+}
+
+// matePairs ищет пары готовых к спариванию животных одного типа
+func (rs *ReproductionSystem) matePairs(world *core.World) {
+	candidates := make(map[core.EntityID]bool)
+	var order []core.EntityID
+
+	world.ForEachWith(core.MaskPosition|core.MaskSatiation|core.MaskAnimalConfig|core.MaskAnimalType,
+		func(entity core.EntityID) {
+			if rs.canMate(world, entity) {
+				candidates[entity] = true
+				order = append(order, entity)
+			}
+		})
+
+	// ВАЖНО: обходим кандидатов по возрастанию ID - выбор пар детерминирован
+	for _, entity := range order {
+		if !candidates[entity] {
+			continue // Уже нашёл пару в этом тике
+		}
+
+		partner, found := rs.findMatingPartner(world, entity, candidates)
+		if !found {
+			continue
+		}
+
+		delete(candidates, entity)
+		delete(candidates, partner)
+		rs.mate(world, entity, partner)
+	}
+}
+
+// mate спаривает пару: мать вынашивает потомка, оба родителя теряют сытость и получают кулдаун
+func (rs *ReproductionSystem) mate(world *core.World, mother, father core.EntityID) {
+	motherConfig, _ := world.GetAnimalConfig(mother)
+	fatherConfig, _ := world.GetAnimalConfig(father)
+
+	balance := rs.profile().Reproduction
+	world.AddPregnancy(mother, core.Pregnancy{
+		Timer:           motherConfig.GestationTime,
+		OffspringConfig: inheritAnimalConfig(motherConfig, fatherConfig, world.GetRNG(), balance.MutationRate),
+	})
+
+	for _, parent := range []core.EntityID{mother, father} {
+		config, _ := world.GetAnimalConfig(parent)
+		satiation, _ := world.GetSatiation(parent)
+		satiation.Value = max(satiation.Value-balance.SatiationCost, 0)
+		world.SetSatiation(parent, satiation)
+
+		world.AddReproductionCooldown(parent, core.ReproductionCooldown{
+			Timer:      config.ReproductionCooldown,
+			BreedTimer: BreedAnimationDuration,
+		})
+	}
+}
+
+// canMate проверяет условия спаривания: взрослое сытое животное вне опасности без кулдауна
+func (rs *ReproductionSystem) canMate(world *core.World, entity core.EntityID) bool {
+	config, _ := world.GetAnimalConfig(entity)
+	if config.GestationTime <= 0 {
+		return false // Вид не размножается
+	}
+
+	if world.HasComponent(entity, core.MaskReproductionCooldown) ||
+		world.HasComponent(entity, core.MaskPregnancy) ||
+		world.HasComponent(entity, core.MaskEatingState) ||
+		world.HasComponent(entity, core.MaskAttackState) {
+		return false
+	}
+
+	if !isAliveAnimal(world, entity) {
+		return false
+	}
+
+	if age, hasAge := world.GetAge(entity); hasAge && age.Stage != core.LifeStageAdult {
+		return false // Детёныши ещё не размножаются, старые животные уже не размножаются
+	}
+
+	satiation, _ := world.GetSatiation(entity)
+	if satiation.Value < rs.profile().Reproduction.SatiationThreshold {
+		return false
+	}
+
+	return !isThreatenedByPredator(world, entity, config.VisionRange)
+}
+
+// isAliveAnimal проверяет что животное живо (не труп)
+func isAliveAnimal(world *core.World, entity core.EntityID) bool {
+	if world.HasComponent(entity, core.MaskCorpse) {
+		return false
+	}
+	health, hasHealth := world.GetHealth(entity)
+	return hasHealth && health.Current > 0
+}
+
+// isThreatenedByPredator проверяет есть ли хищник другого типа в поле зрения
+func isThreatenedByPredator(world *core.World, entity core.EntityID, visionRange float32) bool {
+	pos, _ := world.GetPosition(entity)
+	animalType, _ := world.GetAnimalType(entity)
+
+	for _, other := range world.QueryInRadius(pos.X, pos.Y, constants.TilesToPixels(visionRange)) {
+		behavior, hasBehavior := world.GetBehavior(other)
+		if !hasBehavior || behavior.Type != core.BehaviorPredator {
+			continue
+		}
+
+		otherType, _ := world.GetAnimalType(other)
+		if otherType != animalType && isAliveAnimal(world, other) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// findMatingPartner находит ближайшего кандидата того же типа в радиусе спаривания
+func (rs *ReproductionSystem) findMatingPartner(
+	world *core.World,
+	entity core.EntityID,
+	candidates map[core.EntityID]bool,
+) (core.EntityID, bool) {
+	pos, _ := world.GetPosition(entity)
+	animalType, _ := world.GetAnimalType(entity)
+
+	var partner core.EntityID
+	bestDistance := float32(LargeDistanceValue)
+	found := false
+
+	matingRange := constants.TilesToPixels(rs.profile().Reproduction.MatingRange)
+	for _, other := range world.QueryInRadius(pos.X, pos.Y, matingRange) {
+		if other == entity || !candidates[other] {
+			continue
+		}
+
+		otherType, _ := world.GetAnimalType(other)
+		if otherType != animalType {
+			continue
+		}
+
+		otherPos, _ := world.GetPosition(other)
+		distance := pos.DistanceSquaredTo(otherPos)
+
+		// При равном расстоянии выбираем меньший ID (детерминизм не зависит от порядка в сетке)
+		if distance < bestDistance || (distance == bestDistance && other < partner) {
+			partner = other
+			bestDistance = distance
+			found = true
+		}
+	}
+
+	return partner, found
+}
+
+// offspringPosition выбирает точку рядом с матерью в пределах мира
+func offspringPosition(world *core.World, motherPos core.Position) (x, y float32) {
+	angle := world.GetRNG().Float64() * 2 * math.Pi
+	distance := float64(constants.TilesToPixels(OffspringSpawnDistance))
+
+	x = motherPos.X + float32(math.Cos(angle)*distance)
+	y = motherPos.Y + float32(math.Sin(angle)*distance)
+
+	width, height := world.GetWorldDimensions()
+	return clampFloat32(x, 0, width), clampFloat32(y, 0, height)
+}
+
+// applyAnimalConfig заменяет конфигурацию животного и все выводимые из неё компоненты
+func applyAnimalConfig(world *core.World, entity core.EntityID, config core.AnimalConfig) {
+	world.SetAnimalConfig(entity, config)
+	world.SetHealth(entity, core.Health{Current: config.MaxHealth, Max: config.MaxHealth})
+	world.SetSpeed(entity, core.Speed{Base: config.BaseSpeed, Current: config.BaseSpeed})
+	world.SetSize(entity, core.Size{Radius: config.CollisionRadius, AttackRange: config.AttackRange})
+
+	behavior, _ := world.GetBehavior(entity)
+	behavior.SatiationThreshold = config.SatiationThreshold
+	behavior.FleeThreshold = config.FleeThreshold
+	behavior.SearchSpeed = config.SearchSpeed
+	behavior.WanderingSpeed = config.WanderingSpeed
+	behavior.ContentSpeed = config.ContentSpeed
+	behavior.VisionRange = config.VisionRange
+	behavior.MinDirectionTime = config.MinDirectionTime
+	behavior.MaxDirectionTime = config.MaxDirectionTime
+	world.SetBehavior(entity, behavior)
+
+	// Радиус в пространственной сетке берётся из Size - обновляем запись
+	pos, _ := world.GetPosition(entity)
+	world.UpdateSpatialPosition(entity, pos)
+}
+
+// InheritAnimalConfig вычисляет конфигурацию потомка: среднее родителей с мутацией ±ReproductionMutationRate
+func InheritAnimalConfig(mother, father core.AnimalConfig, rng *rand.Rand) core.AnimalConfig {
+	return inheritAnimalConfig(mother, father, rng, ReproductionMutationRate)
+}
+
+// inheritAnimalConfig вычисляет конфигурацию потомка с мутацией ±mutationRate из профиля баланса
+func inheritAnimalConfig(mother, father core.AnimalConfig, rng *rand.Rand, mutationRate float32) core.AnimalConfig {
+	offspring := mother
+
+	childFloats, fatherFloats := animalConfigFloatFields(&offspring), animalConfigFloatFields(&father)
+	for i, field := range childFloats {
+		*field = (*field + *fatherFloats[i]) / 2 * mutationFactor(rng, mutationRate)
+	}
+
+	childInts, fatherInts := animalConfigIntFields(&offspring), animalConfigIntFields(&father)
+	for i, field := range childInts {
+		average := (float32(*field) + float32(*fatherInts[i])) / 2
+		*field = int16(math.Round(float64(average * mutationFactor(rng, mutationRate))))
+	}
+
+	// Шанс попадания - вероятность, не может превышать 1
+	offspring.HitChance = min(offspring.HitChance, 1)
+
+	return offspring
+}
+
+// mutationFactor возвращает случайный множитель в диапазоне [1-rate, 1+rate]
+func mutationFactor(rng *rand.Rand, rate float32) float32 {
+	return 1 + (rng.Float32()*2-1)*rate
+}
+
+// animalConfigFloatFields возвращает указатели на наследуемые вещественные поля конфигурации
+// ВАЖНО: порядок полей фиксирован - от него зависит последовательность вызовов RNG
+func animalConfigFloatFields(config *core.AnimalConfig) []*float32 {
+	return []*float32{
+		&config.BaseRadius, &config.BaseSpeed,
+		&config.CollisionRadius, &config.AttackRange, &config.VisionRange,
+		&config.SatiationThreshold, &config.FleeThreshold,
+		&config.SearchSpeed, &config.WanderingSpeed, &config.ContentSpeed,
+		&config.MinDirectionTime, &config.MaxDirectionTime,
+		&config.AttackCooldown, &config.HitChance,
+		&config.ReproductionCooldown, &config.GestationTime,
+		&config.MaturityAge, &config.ElderAge, &config.MaxAge,
+	}
+}
+
+// animalConfigIntFields возвращает указатели на наследуемые целочисленные поля конфигурации
+func animalConfigIntFields(config *core.AnimalConfig) []*int16 {
+	return []*int16{&config.MaxHealth, &config.AttackDamage}
+}
+
+// clampFloat32 ограничивает значение диапазоном [minValue, maxValue]
+func clampFloat32(value, minValue, maxValue float32) float32 {
+	return max(minValue, min(value, maxValue))
+}
@@ -0,0 +1,182 @@
+package simulation
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/aiseeq/savanna/internal/constants"
+	"github.com/aiseeq/savanna/internal/core"
+)
+
+const reproductionTestDeltaTime = float32(1.0 / 60.0)
+
+// runReproduction выполняет систему размножения заданное количество секунд
+func runReproduction(world *core.World, system *ReproductionSystem, seconds float32) {
+	for elapsed := float32(0); elapsed < seconds; elapsed += reproductionTestDeltaTime {
+		system.Update(world, reproductionTestDeltaTime)
+		world.Sync()
+	}
+}
+
+func TestReproduction_FedRabbitsBreed(t *testing.T) {
+	world := core.NewWorld(640, 640, 12345)
+	system := NewReproductionSystem()
+
+	mother := CreateAnimal(world, core.TypeRabbit, 300, 300)
+	father := CreateAnimal(world, core.TypeRabbit, 310, 300)
+
+	system.Update(world, reproductionTestDeltaTime)
+	world.Sync()
+
+	if !world.HasComponent(mother, core.MaskPregnancy) {
+		t.Fatal("Rabbit with lower ID should become pregnant")
+	}
+	if world.HasComponent(father, core.MaskPregnancy) {
+		t.Error("Only one parent should carry offspring")
+	}
+
+	for _, parent := range []core.EntityID{mother, father} {
+		satiation, _ := world.GetSatiation(parent)
+		if expected := float32(RabbitInitialSatiation - ReproductionSatiationCost); satiation.Value != expected {
+			t.Errorf("Parent %d satiation: expected %f, got %f", parent, expected, satiation.Value)
+		}
+		cooldown, ok := world.GetReproductionCooldown(parent)
+		if !ok || cooldown.BreedTimer <= 0 {
+			t.Errorf("Parent %d should have reproduction cooldown with breed animation", parent)
+		}
+	}
+
+	runReproduction(world, system, RabbitGestationTime)
+
+	if world.HasComponent(mother, core.MaskPregnancy) {
+		t.Fatal("Pregnancy should end after gestation time")
+	}
+	if count := world.CountEntitiesWith(core.MaskAnimalType); count != 3 {
+		t.Fatalf("Expected 3 rabbits after birth, got %d", count)
+	}
+
+	offspring := core.EntityID(father + 1)
+	animalType, _ := world.GetAnimalType(offspring)
+	if animalType != core.TypeRabbit {
+		t.Fatalf("Offspring should be a rabbit, got %s", animalType)
+	}
+	if age, ok := world.GetAge(offspring); !ok || age.Stage != core.LifeStageJuvenile {
+		t.Error("Newborn should be a juvenile and unable to mate immediately")
+	}
+
+	// Родители одинаковые - каждый параметр потомка в пределах ±5% от родительского
+	parentConfig := CreateAnimalConfig(core.TypeRabbit)
+	offspringConfig, _ := world.GetAnimalConfig(offspring)
+	assertWithinMutation(t, "BaseSpeed", parentConfig.BaseSpeed, offspringConfig.BaseSpeed, 0)
+	assertWithinMutation(t, "VisionRange", parentConfig.VisionRange, offspringConfig.VisionRange, 0)
+	assertWithinMutation(t, "MaxHealth", float32(parentConfig.MaxHealth), float32(offspringConfig.MaxHealth), 1)
+
+	speed, _ := world.GetSpeed(offspring)
+	if speed.Base != offspringConfig.BaseSpeed {
+		t.Errorf("Offspring Speed should follow inherited config: %f vs %f", speed.Base, offspringConfig.BaseSpeed)
+	}
+}
+
+func TestReproduction_BlockedConditions(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(world *core.World, first, second core.EntityID)
+	}{
+		{
+			name: "hungry",
+			setup: func(world *core.World, first, _ core.EntityID) {
+				world.SetSatiation(first, core.Satiation{Value: ReproductionSatiationThreshold - 1})
+			},
+		},
+		{
+			name: "predator nearby",
+			setup: func(world *core.World, first, _ core.EntityID) {
+				pos, _ := world.GetPosition(first)
+				CreateAnimal(world, core.TypeWolf, pos.X+constants.TilesToPixels(2), pos.Y)
+			},
+		},
+		{
+			name: "cooldown",
+			setup: func(world *core.World, _, second core.EntityID) {
+				world.AddReproductionCooldown(second, core.ReproductionCooldown{Timer: 10})
+			},
+		},
+		{
+			name: "juvenile",
+			setup: func(world *core.World, _, second core.EntityID) {
+				NewbornAge(world, second)
+			},
+		},
+		{
+			name: "too far",
+			setup: func(world *core.World, _, second core.EntityID) {
+				pos, _ := world.GetPosition(second)
+				pos.X += constants.TilesToPixels(MatingRange * 3)
+				world.SetPosition(second, pos)
+				world.UpdateSpatialPosition(second, pos)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			world := core.NewWorld(640, 640, 12345)
+			system := NewReproductionSystem()
+
+			first := CreateAnimal(world, core.TypeRabbit, 300, 300)
+			second := CreateAnimal(world, core.TypeRabbit, 310, 300)
+			tt.setup(world, first, second)
+
+			system.Update(world, reproductionTestDeltaTime)
+			world.Sync()
+
+			if world.HasComponent(first, core.MaskPregnancy) || world.HasComponent(second, core.MaskPregnancy) {
+				t.Errorf("Rabbits should not mate when %s", tt.name)
+			}
+		})
+	}
+}
+
+func TestReproduction_DifferentTypesDoNotMate(t *testing.T) {
+	world := core.NewWorld(640, 640, 12345)
+	system := NewReproductionSystem()
+
+	rabbit := CreateAnimal(world, core.TypeRabbit, 300, 300)
+	wolf := CreateAnimal(world, core.TypeWolf, 310, 300)
+	world.SetSatiation(wolf, core.Satiation{Value: MaxSatiationLimit})
+
+	system.Update(world, reproductionTestDeltaTime)
+	world.Sync()
+
+	if world.HasComponent(rabbit, core.MaskPregnancy) || world.HasComponent(wolf, core.MaskPregnancy) {
+		t.Error("Animals of different types should not mate")
+	}
+}
+
+func TestInheritAnimalConfig_AveragesParents(t *testing.T) {
+	mother := CreateAnimalConfig(core.TypeWolf)
+	father := mother
+	father.BaseSpeed = mother.BaseSpeed * 2
+	father.MaxHealth = mother.MaxHealth * 2
+
+	rng := rand.New(rand.NewSource(42))
+	for i := 0; i < 100; i++ {
+		offspring := InheritAnimalConfig(mother, father, rng)
+		assertWithinMutation(t, "BaseSpeed", (mother.BaseSpeed+father.BaseSpeed)/2, offspring.BaseSpeed, 0)
+		assertWithinMutation(t, "MaxHealth", float32(mother.MaxHealth+father.MaxHealth)/2, float32(offspring.MaxHealth), 1)
+		if offspring.HitChance > 1 {
+			t.Fatalf("HitChance should not exceed 1, got %f", offspring.HitChance)
+		}
+	}
+}
+
+// assertWithinMutation проверяет что значение отличается от базового не более чем на ReproductionMutationRate
+// rounding - дополнительный допуск на округление целочисленных полей
+func assertWithinMutation(t *testing.T, name string, base, actual, rounding float32) {
+	t.Helper()
+
+	tolerance := base*ReproductionMutationRate + rounding + 1e-4
+	if actual < base-tolerance || actual > base+tolerance {
+		t.Errorf("%s: expected %f ±%.0f%%, got %f", name, base, ReproductionMutationRate*100, actual)
+	}
+}
@@ -6,7 +6,9 @@ import (
 
 // SatiationSpeedModifierSystem изменяет скорость в зависимости от сытости (SRP)
 // Единственная ответственность: влияние сытости на скорость движения
-type SatiationSpeedModifierSystem struct{}
+type SatiationSpeedModifierSystem struct {
+	balanced // Профиль баланса: порог и формула замедления сытых
+}
 
 // NewSatiationSpeedModifierSystem создаёт новую систему изменения скорости
 func NewSatiationSpeedModifierSystem() *SatiationSpeedModifierSystem {
@@ -25,6 +27,7 @@ func (ssms *SatiationSpeedModifierSystem) Update(world core.SatiationSpeedModifi
 // НОВАЯ ЛОГИКА (по требованию пользователя):
 // 1. Малосытные (< 80%) бегают с полной скоростью (1.0)
 // 2. Сытые (> 80%) замедляются: скорость *= (1 + 0.8 - сытость/100)
+// 3. Раненые и животные не во взрослой стадии жизни замедляются пропорционально
 func (ssms *SatiationSpeedModifierSystem) updateSpeedBasedOnSatiation(
 	world core.SatiationSpeedModifierSystemAccess,
 	entity core.EntityID,
@@ -47,11 +50,12 @@ func (ssms *SatiationSpeedModifierSystem) updateSpeedBasedOnSatiation(
 	var speedMultiplier float32 = NormalSpeedMultiplier
 
 	// НОВАЯ ЛОГИКА 1: Сытость влияет на скорость только при > 80%
-	if satiation.Value > SatiatedThreshold {
+	balance := ssms.profile().Speed
+	if satiation.Value > balance.SatiatedThreshold {
 		// Сытые животные замедляются: скорость *= (1 + 0.8 - сытость)
 		// где сытость в долях от 1.0 (90% = 0.9, 95% = 0.95)
 		satietyRatio := satiation.Value / PercentToRatioConversion
-		speedMultiplier = NormalSpeedMultiplier + SatietySlowdownOffset - satietyRatio
+		speedMultiplier = NormalSpeedMultiplier + balance.SatietySlowdownOffset - satietyRatio
 
 		// Минимальная скорость не меньше 0.1 (для безопасности)
 		if speedMultiplier < MinimumSpeedMultiplier {
@@ -68,6 +72,11 @@ func (ssms *SatiationSpeedModifierSystem) updateSpeedBasedOnSatiation(
 	}
 	// Здоровые животные (100% хитов) не получают штрафа
 
+	// Стадия жизни: детёныши и старые животные медленнее взрослых
+	if age, hasAge := world.GetAge(entity); hasAge {
+		speedMultiplier *= age.SpeedMultiplier
+	}
+
 	// Обновляем текущую скорость (ТИПОБЕЗОПАСНО)
 	speed.Current = speed.Base * speedMultiplier
 	world.SetSpeed(entity, speed)
@@ -6,7 +6,18 @@ import (
 
 // SatiationSystem управляет только сытостью животных (SRP - Single Responsibility Principle)
 // Единственная ответственность: уменьшение сытости со временем
-type SatiationSystem struct{}
+// Новая сытость считается по секторам карты (параллельно), а применяется по порядку секторов
+type SatiationSystem struct {
+	balanced // Профиль баланса: скорость потери сытости
+
+	updates [core.SectorCount][]satiationUpdate // Буферы изменений секторов
+}
+
+// satiationUpdate новая сытость животного, посчитанная в секторе
+type satiationUpdate struct {
+	entity    core.EntityID
+	satiation core.Satiation
+}
 
 // NewSatiationSystem создаёт новую систему сытости
 func NewSatiationSystem() *SatiationSystem {
@@ -15,41 +26,68 @@ func NewSatiationSystem() *SatiationSystem {
 
 // Update обновляет сытость для всех животных
 // ISP Улучшение: использует узкоспециализированный интерфейс
+// Сытость каждого животного зависит только от его компонентов, поэтому порядок применения не влияет на результат
 func (ss *SatiationSystem) Update(world core.SatiationSystemAccess, deltaTime float32) {
-	world.ForEachWith(core.MaskSatiation, func(entity core.EntityID) {
-		ss.updateSatiation(world, entity, deltaTime)
+	for sector := range ss.updates {
+		ss.updates[sector] = ss.updates[sector][:0]
+	}
+
+	world.ForEachSector(core.MaskSatiation, func(sector int, entities []core.EntityID) {
+		for _, entity := range entities {
+			if satiation, ok := ss.updateSatiation(world, entity, deltaTime); ok {
+				ss.updates[sector] = append(ss.updates[sector], satiationUpdate{entity: entity, satiation: satiation})
+			}
+		}
 	})
+
+	for _, updates := range ss.updates {
+		for _, update := range updates {
+			world.SetSatiation(update.entity, update.satiation)
+		}
+	}
 }
 
-// updateSatiation обновляет сытость животного
-func (ss *SatiationSystem) updateSatiation(world core.SatiationSystemAccess, entity core.EntityID, deltaTime float32) {
+// updateSatiation вычисляет новую сытость животного (только чтение мира)
+// false - сытость не меняется
+func (ss *SatiationSystem) updateSatiation(
+	world core.SatiationSystemAccess, entity core.EntityID, deltaTime float32,
+) (core.Satiation, bool) {
 	satiation, ok := world.GetSatiation(entity)
 	if !ok {
-		return
+		return satiation, false
 	}
 
 	// ИСПРАВЛЕНИЕ: Животные не теряют сытость когда едят!
 	// Проверяем есть ли EatingState - если есть, пропускаем снижение сытости
 	if world.HasComponent(entity, core.MaskEatingState) {
-		return // Животное ест - сытость не снижается
-	}
-
-	// Определяем скорость снижения сытости в зависимости от размера животного
-	satiationRate := float32(BaseSatiationDecreaseRate)
-	if size, hasSize := world.GetSize(entity); hasSize {
-		// Большие животные (хищники) теряют сытость медленнее
-		if size.Radius > LargeAnimalSizeThreshold {
-			satiationRate *= LargeAnimalSaitationRate
-		}
+		return satiation, false // Животное ест - сытость не снижается
 	}
 
 	// Уменьшаем сытость
-	satiation.Value -= satiationRate * deltaTime
+	satiation.Value -= ss.satiationRate(world, entity) * deltaTime
 
 	// Ограничиваем снизу
 	if satiation.Value < 0 {
 		satiation.Value = 0
 	}
 
-	world.SetSatiation(entity, satiation)
+	return satiation, true
+}
+
+// satiationRate возвращает скорость снижения сытости животного
+// Вид может задать скорость явно, иначе она зависит от размера животного
+func (ss *SatiationSystem) satiationRate(world core.SatiationSystemAccess, entity core.EntityID) float32 {
+	if config, hasConfig := world.GetAnimalConfig(entity); hasConfig && config.SatiationDecreaseRate > 0 {
+		return config.SatiationDecreaseRate
+	}
+
+	balance := ss.profile().Satiation
+	satiationRate := balance.DecreaseRate
+	if size, hasSize := world.GetSize(entity); hasSize {
+		// Большие животные (хищники) теряют сытость медленнее
+		if size.Radius > balance.LargeAnimalSizeThreshold {
+			satiationRate *= balance.LargeAnimalRate
+		}
+	}
+	return satiationRate
 }
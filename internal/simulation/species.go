@@ -0,0 +1,329 @@
+package simulation
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/aiseeq/savanna/internal/core"
+)
+
+// SpeciesDefinition описание вида животного из YAML файла
+// Новые виды (зебры, львы, гиены) добавляются файлом без изменения Go кода (OCP)
+// Все расстояния в тайлах, скорости в тайлах в секунду, времена в секундах
+type SpeciesDefinition struct {
+	Name string `yaml:"name"` // Уникальное имя вида (rabbit, wolf, zebra)
+	Diet string `yaml:"diet"` // Тип питания: herbivore, predator, scavenger или omnivore
+
+	BaseRadius   float32 `yaml:"base_radius"`   // Радиус тела
+	Speed        float32 `yaml:"speed"`         // Базовая скорость
+	Vision       float32 `yaml:"vision"`        // Дальность видения
+	FleeDistance float32 `yaml:"flee_distance"` // Дистанция бегства от хищника
+	Health       int16   `yaml:"health"`        // Максимальное здоровье
+
+	Combat       SpeciesCombat       `yaml:"combat"`
+	Satiation    SpeciesSatiation    `yaml:"satiation"`
+	Movement     SpeciesMovement     `yaml:"movement"`
+	Herd         SpeciesHerd         `yaml:"herd"`
+	Reproduction SpeciesReproduction `yaml:"reproduction"`
+	Age          SpeciesAge          `yaml:"age"`
+	Sprite       SpeciesSprite       `yaml:"sprite"`
+}
+
+// SpeciesCombat боевые характеристики вида (у травоядных нулевые)
+type SpeciesCombat struct {
+	Damage    int16   `yaml:"damage"`     // Урон атаки
+	Range     float32 `yaml:"range"`      // Дальность атаки
+	Cooldown  float32 `yaml:"cooldown"`   // Время между атаками
+	HitChance float32 `yaml:"hit_chance"` // Шанс попадания (0.0-1.0)
+}
+
+// SpeciesSatiation параметры голода вида
+type SpeciesSatiation struct {
+	Initial      float32 `yaml:"initial"`       // Начальная сытость
+	Threshold    float32 `yaml:"threshold"`     // Сытость при которой животное ищет еду
+	DecreaseRate float32 `yaml:"decrease_rate"` // Потеря сытости в секунду (0 - по размеру животного)
+}
+
+// SpeciesMovement множители скорости и таймеры случайного движения (0 - значения по умолчанию)
+type SpeciesMovement struct {
+	SearchSpeed      float32 `yaml:"search_speed"`
+	WanderingSpeed   float32 `yaml:"wandering_speed"`
+	ContentSpeed     float32 `yaml:"content_speed"`
+	MinDirectionTime float32 `yaml:"min_direction_time"`
+	MaxDirectionTime float32 `yaml:"max_direction_time"`
+}
+
+// SpeciesHerd стадное поведение (radius 0 - вид держится особняком, hearing 0 - не слышит тревоги)
+type SpeciesHerd struct {
+	Radius     float32 `yaml:"radius"`     // Радиус соседей по стаду
+	Cohesion   float32 `yaml:"cohesion"`   // Вес притяжения к центру стада
+	Alignment  float32 `yaml:"alignment"`  // Вес выравнивания направления
+	Separation float32 `yaml:"separation"` // Вес отталкивания от близких соседей
+	Hearing    float32 `yaml:"hearing"`    // Дальность, на которой слышна тревога сородичей
+}
+
+// SpeciesReproduction параметры размножения (gestation 0 - вид не размножается)
+type SpeciesReproduction struct {
+	Cooldown  float32 `yaml:"cooldown"`
+	Gestation float32 `yaml:"gestation"`
+}
+
+// SpeciesAge стадии жизни (max 0 - вид не стареет)
+type SpeciesAge struct {
+	Maturity float32 `yaml:"maturity"`
+	Elder    float32 `yaml:"elder"`
+	Max      float32 `yaml:"max"`
+}
+
+// SpeciesSprite внешний вид: префикс файлов спрайтов, масштаб и кадры анимаций
+type SpeciesSprite struct {
+	Prefix     string                      `yaml:"prefix"`     // Префикс файлов в assets/animations (hare, wolf; пусто - заглушка)
+	Scale      float64                     `yaml:"scale"`      // Масштаб спрайта
+	Animations map[string]SpeciesAnimation `yaml:"animations"` // Анимации по имени (idle, walk, run, ...)
+}
+
+// SpeciesAnimation количество кадров и скорость одной анимации
+type SpeciesAnimation struct {
+	Frames int     `yaml:"frames"`
+	FPS    float32 `yaml:"fps"`
+}
+
+// Имена типов питания в файлах видов
+const (
+	DietHerbivore = "herbivore"
+	DietPredator  = "predator"
+	DietScavenger = "scavenger"
+	DietOmnivore  = "omnivore"
+
+	// SpeciesFileExtension расширение файлов описаний видов
+	SpeciesFileExtension = ".yaml"
+
+	// Префиксы спрайтов-заглушек для видов без своих спрайтов
+	HerbivorePlaceholderSprite = "hare"
+	CarnivorePlaceholderSprite = "wolf"
+)
+
+// speciesRegistry загруженные описания видов по типу животного
+// Рендеринг берёт из него префиксы спрайтов и кадры анимаций
+var speciesRegistry = struct {
+	sync.RWMutex
+	definitions map[core.AnimalType]SpeciesDefinition
+}{definitions: make(map[core.AnimalType]SpeciesDefinition)}
+
+// LoadSpeciesDir загружает и регистрирует все описания видов из каталога
+// Файлы обрабатываются в алфавитном порядке - динамические типы выделяются детерминированно
+func LoadSpeciesDir(dir string) ([]core.AnimalType, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*"+SpeciesFileExtension))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list species files: %w", err)
+	}
+	sort.Strings(files)
+
+	types := make([]core.AnimalType, 0, len(files))
+	for _, file := range files {
+		animalType, err := LoadSpeciesFile(file)
+		if err != nil {
+			return nil, err
+		}
+		types = append(types, animalType)
+	}
+
+	return types, nil
+}
+
+// LoadSpeciesFile загружает описание вида из YAML файла и регистрирует его
+func LoadSpeciesFile(filename string) (core.AnimalType, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return core.TypeNone, fmt.Errorf("failed to read species file: %w", err)
+	}
+
+	var definition SpeciesDefinition
+	if err := yaml.Unmarshal(data, &definition); err != nil {
+		return core.TypeNone, fmt.Errorf("failed to parse species file %s: %w", filename, err)
+	}
+
+	animalType, err := RegisterSpecies(definition)
+	if err != nil {
+		return core.TypeNone, fmt.Errorf("invalid species file %s: %w", filename, err)
+	}
+
+	return animalType, nil
+}
+
+// RegisterSpecies регистрирует вид: выделяет тип животного и добавляет factory конфигурации и голода
+// Вид с именем встроенного типа (rabbit, wolf) переопределяет его параметры
+func RegisterSpecies(definition SpeciesDefinition) (core.AnimalType, error) {
+	if err := definition.Validate(); err != nil {
+		return core.TypeNone, err
+	}
+
+	animalType, err := core.RegisterAnimalType(definition.Name)
+	if err != nil {
+		return core.TypeNone, err
+	}
+
+	RegisterAnimalConfigFactory(animalType, &SpeciesConfigFactory{definition: definition})
+	defaultSatiationRegistry.RegisterFactory(animalType, &SpeciesConfigFactory{definition: definition})
+
+	speciesRegistry.Lock()
+	speciesRegistry.definitions[animalType] = definition
+	speciesRegistry.Unlock()
+
+	return animalType, nil
+}
+
+// GetSpecies возвращает описание вида загруженного из файла
+func GetSpecies(animalType core.AnimalType) (SpeciesDefinition, bool) {
+	speciesRegistry.RLock()
+	defer speciesRegistry.RUnlock()
+
+	definition, exists := speciesRegistry.definitions[animalType]
+	return definition, exists
+}
+
+// RegisteredSpecies возвращает типы всех видов загруженных из файлов (по возрастанию)
+func RegisteredSpecies() []core.AnimalType {
+	speciesRegistry.RLock()
+	defer speciesRegistry.RUnlock()
+
+	types := make([]core.AnimalType, 0, len(speciesRegistry.definitions))
+	for animalType := range speciesRegistry.definitions {
+		types = append(types, animalType)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	return types
+}
+
+// Validate проверяет корректность описания вида
+func (d SpeciesDefinition) Validate() error {
+	if strings.TrimSpace(d.Name) == "" {
+		return fmt.Errorf("species name is required")
+	}
+
+	diet, err := d.DietType()
+	if err != nil {
+		return err
+	}
+
+	if d.BaseRadius <= 0 || d.Speed <= 0 || d.Vision <= 0 || d.Health <= 0 {
+		return fmt.Errorf("species %s: base_radius, speed, vision and health must be positive", d.Name)
+	}
+
+	if diet == core.BehaviorPredator && (d.Combat.Damage <= 0 || d.Combat.Range <= 0) {
+		return fmt.Errorf("predator species %s must have positive combat damage and range", d.Name)
+	}
+
+	if d.Combat.HitChance < 0 || d.Combat.HitChance > 1 {
+		return fmt.Errorf("species %s: hit_chance must be between 0 and 1, got %f", d.Name, d.Combat.HitChance)
+	}
+
+	herd := d.Herd
+	if herd.Radius < 0 || herd.Cohesion < 0 || herd.Alignment < 0 || herd.Separation < 0 || herd.Hearing < 0 {
+		return fmt.Errorf("species %s: herd parameters must not be negative", d.Name)
+	}
+
+	if d.Age.Max > 0 && !(d.Age.Maturity < d.Age.Elder && d.Age.Elder <= d.Age.Max) {
+		return fmt.Errorf("species %s: ages must satisfy maturity < elder <= max", d.Name)
+	}
+
+	return nil
+}
+
+// DietType преобразует тип питания из файла в тип поведения
+func (d SpeciesDefinition) DietType() (core.BehaviorType, error) {
+	switch strings.ToLower(d.Diet) {
+	case DietHerbivore:
+		return core.BehaviorHerbivore, nil
+	case DietPredator:
+		return core.BehaviorPredator, nil
+	case DietScavenger:
+		return core.BehaviorScavenger, nil
+	case DietOmnivore:
+		return core.BehaviorOmnivore, nil
+	default:
+		return core.BehaviorNone, fmt.Errorf("species %s: unknown diet %q (expected %s, %s, %s or %s)",
+			d.Name, d.Diet, DietHerbivore, DietPredator, DietScavenger, DietOmnivore)
+	}
+}
+
+// SpritePrefix возвращает префикс спрайтов вида
+// Вид без своих спрайтов рисуется заглушкой по типу питания: пасущиеся как заяц, остальные как волк
+func (d SpeciesDefinition) SpritePrefix() string {
+	if d.Sprite.Prefix != "" {
+		return d.Sprite.Prefix
+	}
+	if diet, _ := d.DietType(); eatsGrass(diet) {
+		return HerbivorePlaceholderSprite
+	}
+	return CarnivorePlaceholderSprite
+}
+
+// SpeciesConfigFactory создаёт конфигурацию животного из описания вида (Factory Pattern)
+// Реализует AnimalConfigFactory и SatiationConfigFactory
+type SpeciesConfigFactory struct {
+	definition SpeciesDefinition
+}
+
+// CreateConfig создаёт конфигурацию животного из описания вида
+func (f *SpeciesConfigFactory) CreateConfig() core.AnimalConfig {
+	d := f.definition
+	diet, _ := d.DietType() // Описание проверено при регистрации
+
+	return core.AnimalConfig{
+		BaseRadius: d.BaseRadius,
+		MaxHealth:  d.Health,
+		BaseSpeed:  d.Speed,
+
+		CollisionRadius: d.BaseRadius * CollisionRadiusMultiplier,
+		AttackRange:     d.Combat.Range,
+		VisionRange:     d.Vision,
+
+		Diet:                  diet,
+		SatiationThreshold:    orDefault(d.Satiation.Threshold, DefaultSatiationThreshold),
+		FleeThreshold:         d.FleeDistance,
+		SatiationDecreaseRate: d.Satiation.DecreaseRate,
+
+		SearchSpeed:    orDefault(d.Movement.SearchSpeed, SearchSpeedMultiplier),
+		WanderingSpeed: orDefault(d.Movement.WanderingSpeed, WanderingSpeedMultiplier),
+		ContentSpeed:   orDefault(d.Movement.ContentSpeed, ContentSpeedMultiplier),
+
+		MinDirectionTime: orDefault(d.Movement.MinDirectionTime, DefaultMinDirectionTime),
+		MaxDirectionTime: orDefault(d.Movement.MaxDirectionTime, DefaultMaxDirectionTime),
+
+		HerdRadius:     d.Herd.Radius,
+		HerdCohesion:   d.Herd.Cohesion,
+		HerdAlignment:  d.Herd.Alignment,
+		HerdSeparation: d.Herd.Separation,
+		HearingRange:   d.Herd.Hearing,
+
+		AttackDamage:   d.Combat.Damage,
+		AttackCooldown: d.Combat.Cooldown,
+		HitChance:      d.Combat.HitChance,
+
+		ReproductionCooldown: d.Reproduction.Cooldown,
+		GestationTime:        d.Reproduction.Gestation,
+		MaturityAge:          d.Age.Maturity,
+		ElderAge:             d.Age.Elder,
+		MaxAge:               d.Age.Max,
+	}
+}
+
+// GetInitialSatiation возвращает начальную сытость вида
+func (f *SpeciesConfigFactory) GetInitialSatiation() float32 {
+	return orDefault(f.definition.Satiation.Initial, DefaultInitialSatiation)
+}
+
+// orDefault возвращает значение из файла или значение по умолчанию если поле не задано
+func orDefault(value, defaultValue float32) float32 {
+	if value == 0 {
+		return defaultValue
+	}
+	return value
+}
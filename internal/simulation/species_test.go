@@ -0,0 +1,165 @@
+package simulation
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aiseeq/savanna/internal/constants"
+	"github.com/aiseeq/savanna/internal/core"
+)
+
+// testSpeciesDir каталог описаний видов в репозитории
+const testSpeciesDir = "../../config/species"
+
+// loadTestSpecies загружает виды из репозитория и возвращает тип по имени
+func loadTestSpecies(t *testing.T, name string) core.AnimalType {
+	t.Helper()
+
+	if _, err := LoadSpeciesDir(testSpeciesDir); err != nil {
+		t.Fatalf("Species files should load: %v", err)
+	}
+	animalType, ok := core.AnimalTypeByName(name)
+	if !ok {
+		t.Fatalf("Species %s should be registered", name)
+	}
+	return animalType
+}
+
+func TestSpecies_LoadedFromYAML(t *testing.T) {
+	zebra := loadTestSpecies(t, "zebra")
+
+	if zebra <= core.TypeGrass {
+		t.Errorf("Species type should be allocated after built-in types, got %d", zebra)
+	}
+	if zebra.String() != "zebra" {
+		t.Errorf("Species type name: expected zebra, got %s", zebra)
+	}
+	if again, _ := core.RegisterAnimalType("Zebra"); again != zebra {
+		t.Error("Registering the same species name should return the existing type")
+	}
+
+	world := core.NewWorld(640, 640, 12345)
+	entity := CreateAnimal(world, zebra, 300, 300)
+
+	if animalType, _ := world.GetAnimalType(entity); animalType != zebra {
+		t.Fatalf("Animal type should be taken from species, got %s", animalType)
+	}
+	behavior, _ := world.GetBehavior(entity)
+	if behavior.Type != core.BehaviorHerbivore {
+		t.Errorf("Zebra should be a herbivore, got %s", behavior.Type)
+	}
+
+	config, _ := world.GetAnimalConfig(entity)
+	if config.BaseRadius != 0.4 || config.MaxHealth != 90 || config.SatiationDecreaseRate != 3.0 {
+		t.Errorf("Config should follow species file, got %+v", config)
+	}
+	if config.HerdRadius != 5.0 || config.HerdCohesion != 0.05 || config.HearingRange != 6.0 {
+		t.Errorf("Herd parameters should follow species file, got %+v", config)
+	}
+	if satiation, _ := world.GetSatiation(entity); satiation.Value != 80 {
+		t.Errorf("Initial satiation should follow species file, got %f", satiation.Value)
+	}
+
+	species, ok := GetSpecies(zebra)
+	if !ok || species.Sprite.Prefix == "" {
+		t.Error("Species definition with sprite prefix should be available for rendering")
+	}
+}
+
+func TestSpecies_PredatorsHuntAnyHerbivore(t *testing.T) {
+	lion := loadTestSpecies(t, "lion")
+	zebra := loadTestSpecies(t, "zebra")
+
+	world := core.NewWorld(640, 640, 12345)
+	behavior := NewAnimalBehaviorSystem(nil)
+
+	hunter := CreateAnimal(world, lion, 300, 300)
+	prey := CreateAnimal(world, zebra, 300+constants.TilesToPixels(3), 300)
+	world.SetSatiation(hunter, core.Satiation{Value: 0}) // Голодный лев
+
+	behavior.Update(world, reproductionTestDeltaTime)
+
+	hunterVelocity, _ := world.GetVelocity(hunter)
+	if hunterVelocity.X <= 0 {
+		t.Errorf("Hungry lion should chase the zebra (positive X), got %+v", hunterVelocity)
+	}
+	preyVelocity, _ := world.GetVelocity(prey)
+	if preyVelocity.X <= 0 {
+		t.Errorf("Zebra should flee from the lion (positive X), got %+v", preyVelocity)
+	}
+}
+
+func TestSpecies_ScavengerWithPlaceholderSprite(t *testing.T) {
+	hyena := loadTestSpecies(t, "hyena")
+
+	world := core.NewWorld(640, 640, 12345)
+	entity := CreateAnimal(world, hyena, 300, 300)
+	if behavior, _ := world.GetBehavior(entity); behavior.Type != core.BehaviorScavenger {
+		t.Errorf("Hyena should be a scavenger, got %s", behavior.Type)
+	}
+
+	// Своих спрайтов у гиены нет - рисуется заглушкой по типу питания
+	species, _ := GetSpecies(hyena)
+	if species.Sprite.Prefix != "" || species.SpritePrefix() != CarnivorePlaceholderSprite {
+		t.Errorf("Hyena should use placeholder sprites %s, got %q", CarnivorePlaceholderSprite, species.SpritePrefix())
+	}
+	herbivore := SpeciesDefinition{Name: "gazelle", Diet: DietHerbivore}
+	if herbivore.SpritePrefix() != HerbivorePlaceholderSprite {
+		t.Errorf("Herbivore without sprites should use %s, got %s", HerbivorePlaceholderSprite, herbivore.SpritePrefix())
+	}
+}
+
+func TestSpecies_OmnivoreEatsGrassAndCarcasses(t *testing.T) {
+	warthog := loadTestSpecies(t, "warthog")
+
+	world := core.NewWorld(640, 640, 12345)
+	entity := CreateAnimal(world, warthog, 300, 300)
+	behavior, _ := world.GetBehavior(entity)
+	if behavior.Type != core.BehaviorOmnivore || !eatsGrass(behavior.Type) {
+		t.Errorf("Warthog should be a grazing omnivore, got %s", behavior.Type)
+	}
+
+	species, _ := GetSpecies(warthog)
+	if species.SpritePrefix() != HerbivorePlaceholderSprite {
+		t.Errorf("Grazing species without sprites should use %s, got %s", HerbivorePlaceholderSprite, species.SpritePrefix())
+	}
+}
+
+func TestSpecies_InvalidDefinitions(t *testing.T) {
+	valid := SpeciesDefinition{Name: "gazelle", Diet: DietHerbivore, BaseRadius: 0.3, Speed: 1.5, Vision: 4, Health: 40}
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("Valid definition rejected: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(d *SpeciesDefinition)
+	}{
+		{"no name", func(d *SpeciesDefinition) { d.Name = "" }},
+		{"unknown diet", func(d *SpeciesDefinition) { d.Diet = "carnivore" }},
+		{"zero speed", func(d *SpeciesDefinition) { d.Speed = 0 }},
+		{"predator without attack", func(d *SpeciesDefinition) { d.Diet = DietPredator }},
+		{"hit chance above 1", func(d *SpeciesDefinition) { d.Combat.HitChance = 1.5 }},
+		{"elder before maturity", func(d *SpeciesDefinition) { d.Age = SpeciesAge{Maturity: 100, Elder: 50, Max: 200} }},
+		{"negative herd weight", func(d *SpeciesDefinition) { d.Herd.Cohesion = -0.1 }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			definition := valid
+			tt.modify(&definition)
+			if _, err := RegisterSpecies(definition); err == nil {
+				t.Errorf("Definition with %s should be rejected", tt.name)
+			}
+		})
+	}
+
+	file := filepath.Join(t.TempDir(), "broken.yaml")
+	if err := os.WriteFile(file, []byte("name: [broken"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadSpeciesFile(file); err == nil {
+		t.Error("Malformed species file should return an error")
+	}
+}
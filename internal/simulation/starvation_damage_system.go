@@ -7,6 +7,7 @@ import (
 // StarvationDamageSystem наносит урон голодающим животным (SRP)
 // Единственная ответственность: урон здоровью при голоде
 type StarvationDamageSystem struct {
+	balanced                  // Профиль баланса: урон от голода
 	healthDamageTimer float32 // Таймер для нанесения урона здоровью (раз в секунду)
 }
 
@@ -31,6 +32,8 @@ func (sds *StarvationDamageSystem) Update(world core.StarvationDamageSystemAcces
 
 // damageStarvingAnimals наносит урон здоровью голодающим животным
 func (sds *StarvationDamageSystem) damageStarvingAnimals(world core.StarvationDamageSystemAccess) {
+	damage := sds.profile().Satiation.StarvationDamagePerSecond
+
 	world.ForEachWith(core.MaskSatiation|core.MaskHealth, func(entity core.EntityID) {
 		hunger, hasHunger := world.GetSatiation(entity)
 		if !hasHunger {
@@ -48,11 +51,13 @@ func (sds *StarvationDamageSystem) damageStarvingAnimals(world core.StarvationDa
 		}
 
 		// Наносим урон от голода
-		health.Current -= StarvationDamagePerSecond
+		previous := health.Current
+		health.Current -= damage
 		if health.Current < 0 {
 			health.Current = 0
 		}
 
 		world.SetHealth(entity, health)
+		reportDeath(world, entity, previous, health.Current, core.DeathStarvation, 0)
 	})
 }
@@ -0,0 +1,75 @@
+package simulation
+
+import "github.com/aiseeq/savanna/internal/core"
+
+// Имена систем симуляции в core.SystemManager (ограничения порядка ссылаются на них)
+const (
+	SystemVegetation     = "vegetation"
+	SystemSatiation      = "satiation"
+	SystemThirst         = "thirst"
+	SystemGrassSearch    = "grass_search"
+	SystemGrassEating    = "grass_eating"
+	SystemEating         = "eating"
+	SystemCover          = "cover"
+	SystemPack           = "pack"
+	SystemBehavior       = "behavior"
+	SystemSatiationSpeed = "satiation_speed"
+	SystemMovement       = "movement"
+	SystemAging          = "aging"
+	SystemCombat         = "combat"
+	SystemDamage         = "damage"
+	SystemStarvation     = "starvation"
+	SystemCorpse         = "corpse"
+	SystemReproduction   = "reproduction"
+	SystemNavigation     = "navigation"
+)
+
+// Объявления систем: фаза и зависимости, критичные для питания и боя
+// Внутри фазы системы без зависимостей выполняются в порядке регистрации
+var (
+	VegetationSystemSpec = core.SystemSpec{Name: SystemVegetation, Phase: core.PhaseInput}
+	SatiationSystemSpec  = core.SystemSpec{Name: SystemSatiation, Phase: core.PhaseInput}
+	ThirstSystemSpec     = core.SystemSpec{Name: SystemThirst, Phase: core.PhaseInput}
+
+	// EatingState создаётся поиском травы, поедание его продолжает, поведение на него смотрит
+	GrassSearchSystemSpec = core.SystemSpec{Name: SystemGrassSearch, Phase: core.PhaseAI}
+	GrassEatingSystemSpec = core.SystemSpec{
+		Name: SystemGrassEating, Phase: core.PhaseAI, After: []string{SystemGrassSearch},
+	}
+	// Поедание трупов перед поведением: хищник у трупа не уходит на охоту
+	EatingSystemSpec = core.SystemSpec{
+		Name: SystemEating, Phase: core.PhaseAI, After: []string{SystemGrassEating}, Before: []string{SystemBehavior},
+	}
+	// Скрытность в кустах до выбора целей стаей и поведением
+	CoverSystemSpec = core.SystemSpec{
+		Name: SystemCover, Phase: core.PhaseAI, Before: []string{SystemPack, SystemBehavior},
+	}
+	// Стая выбирает общую цель до того, как поведение её прочитает
+	PackSystemSpec = core.SystemSpec{
+		Name: SystemPack, Phase: core.PhaseAI, Before: []string{SystemBehavior},
+	}
+	BehaviorSystemSpec = core.SystemSpec{
+		Name: SystemBehavior, Phase: core.PhaseAI, After: []string{SystemGrassEating},
+	}
+	// Сытость меняет скорость, выбранную поведением
+	SatiationSpeedSystemSpec = core.SystemSpec{
+		Name: SystemSatiationSpeed, Phase: core.PhaseAI, After: []string{SystemBehavior},
+	}
+
+	// Движение сбрасывает скорость едящих
+	MovementSystemSpec = core.SystemSpec{Name: SystemMovement, Phase: core.PhasePhysics}
+
+	// Старение перед боем: умершие от старости не атакуют и не атакуются
+	AgingSystemSpec      = core.SystemSpec{Name: SystemAging, Phase: core.PhaseCombat, Before: []string{SystemCombat}}
+	CombatSystemSpec     = core.SystemSpec{Name: SystemCombat, Phase: core.PhaseCombat}
+	DamageSystemSpec     = core.SystemSpec{Name: SystemDamage, Phase: core.PhaseCombat, After: []string{SystemCombat}}
+	StarvationSystemSpec = core.SystemSpec{Name: SystemStarvation, Phase: core.PhaseCombat}
+
+	// Трупы и рождение - структурные изменения конца тика
+	CorpseSystemSpec       = core.SystemSpec{Name: SystemCorpse, Phase: core.PhaseCleanup}
+	ReproductionSystemSpec = core.SystemSpec{Name: SystemReproduction, Phase: core.PhaseCleanup}
+	// Навигация последней: восстанавливает бюджет поиска пути к следующему тику
+	NavigationSystemSpec = core.SystemSpec{
+		Name: SystemNavigation, Phase: core.PhaseCleanup, After: []string{SystemCorpse, SystemReproduction},
+	}
+)
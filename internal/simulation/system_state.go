@@ -0,0 +1,105 @@
+package simulation
+
+import "github.com/aiseeq/savanna/internal/core"
+
+// Сохранение внутреннего состояния систем для снапшотов (core.StatefulSystem)
+// Системы без собственных полей состояния (Satiation, Movement и т.п.) интерфейс не реализуют
+
+// Ключи скалярных таймеров
+const (
+	starvationTimerKey  = "healthDamageTimer"
+	dehydrationTimerKey = "dehydrationDamageTimer"
+)
+
+// SaveState возвращает кулдауны атак
+func (as *AttackSystem) SaveState() core.SystemState {
+	return core.SystemState{EntityTimers: copyEntityTimers(as.attackCooldowns)}
+}
+
+// LoadState восстанавливает кулдауны атак
+func (as *AttackSystem) LoadState(state core.SystemState) {
+	as.attackCooldowns = copyEntityTimers(state.EntityTimers)
+}
+
+// SaveState возвращает память кадров поедания трупов
+func (es *EatingSystem) SaveState() core.SystemState {
+	return core.SystemState{EntityFrames: copyEntityFrames(es.previousFrames)}
+}
+
+// LoadState восстанавливает память кадров поедания трупов
+func (es *EatingSystem) LoadState(state core.SystemState) {
+	es.previousFrames = copyEntityFrames(state.EntityFrames)
+}
+
+// SaveState возвращает память кадров поедания травы
+func (ges *GrassEatingSystem) SaveState() core.SystemState {
+	return core.SystemState{EntityFrames: copyEntityFrames(ges.previousFrames)}
+}
+
+// LoadState восстанавливает память кадров поедания травы
+func (ges *GrassEatingSystem) LoadState(state core.SystemState) {
+	ges.previousFrames = copyEntityFrames(state.EntityFrames)
+}
+
+// SaveState возвращает таймер урона от голода
+func (sds *StarvationDamageSystem) SaveState() core.SystemState {
+	return core.SystemState{Timers: map[string]float32{starvationTimerKey: sds.healthDamageTimer}}
+}
+
+// LoadState восстанавливает таймер урона от голода
+func (sds *StarvationDamageSystem) LoadState(state core.SystemState) {
+	sds.healthDamageTimer = state.Timers[starvationTimerKey]
+}
+
+// SaveState возвращает таймер урона от обезвоживания
+func (ts *ThirstSystem) SaveState() core.SystemState {
+	return core.SystemState{Timers: map[string]float32{dehydrationTimerKey: ts.healthDamageTimer}}
+}
+
+// LoadState восстанавливает таймер урона от обезвоживания
+func (ts *ThirstSystem) LoadState(state core.SystemState) {
+	ts.healthDamageTimer = state.Timers[dehydrationTimerKey]
+}
+
+// SaveState объединяет состояние подсистем боя (паттерн Facade)
+// Кулдауны принадлежат AttackSystem, память кадров - EatingSystem
+func (cs *CombatSystem) SaveState() core.SystemState {
+	return core.SystemState{
+		EntityTimers: cs.attackSystem.SaveState().EntityTimers,
+		EntityFrames: cs.eatingSystem.SaveState().EntityFrames,
+	}
+}
+
+// LoadState раздаёт состояние подсистемам боя
+func (cs *CombatSystem) LoadState(state core.SystemState) {
+	cs.attackSystem.LoadState(state)
+	cs.eatingSystem.LoadState(state)
+}
+
+// copyEntityTimers копирует таймеры (nil превращается в пустую map)
+func copyEntityTimers(source map[core.EntityID]float32) map[core.EntityID]float32 {
+	result := make(map[core.EntityID]float32, len(source))
+	for entity, value := range source {
+		result[entity] = value
+	}
+	return result
+}
+
+// copyEntityFrames копирует память кадров (nil превращается в пустую map)
+func copyEntityFrames(source map[core.EntityID]int) map[core.EntityID]int {
+	result := make(map[core.EntityID]int, len(source))
+	for entity, value := range source {
+		result[entity] = value
+	}
+	return result
+}
+
+// Статические проверки интерфейса (проверяются на этапе компиляции)
+var (
+	_ core.StatefulSystem = (*AttackSystem)(nil)
+	_ core.StatefulSystem = (*EatingSystem)(nil)
+	_ core.StatefulSystem = (*GrassEatingSystem)(nil)
+	_ core.StatefulSystem = (*StarvationDamageSystem)(nil)
+	_ core.StatefulSystem = (*ThirstSystem)(nil)
+	_ core.StatefulSystem = (*CombatSystem)(nil)
+)
@@ -0,0 +1,179 @@
+package simulation
+
+import (
+	"github.com/aiseeq/savanna/internal/constants"
+	"github.com/aiseeq/savanna/internal/core"
+	"github.com/aiseeq/savanna/internal/vec2"
+)
+
+// Параметры разрешения коллизий с ландшафтом
+const (
+	// Проходов выталкивания за тик: в углу между двумя тайлами круг касается нескольких стен
+	TerrainCollisionIterations = 3
+	// Зазор после выталкивания (тайлы), чтобы на следующем тике круг не касался стены из-за округления
+	TerrainCollisionSkin = 0.001
+)
+
+// TerrainCollisionSystem отвечает ТОЛЬКО за коллизии животных с непроходимыми тайлами (SRP)
+// Круг животного выталкивается из воды и кустов, а скорость теряет составляющую в стену -
+// животное скользит вдоль берега, а не останавливается. Мелкие травоядные проходят сквозь кусты
+type TerrainCollisionSystem struct {
+	terrain core.PassabilityProvider // Абстракция проходимости ландшафта (соблюдение DIP)
+	cover   core.CoverProvider       // Кусты-укрытия (nil - кусты непроходимы для всех)
+}
+
+// NewTerrainCollisionSystem создаёт систему коллизий с ландшафтом
+// Если ландшафт реализует core.CoverProvider, мелкие травоядные заходят в кусты
+func NewTerrainCollisionSystem(terrain core.PassabilityProvider) *TerrainCollisionSystem {
+	cover, _ := terrain.(core.CoverProvider)
+	return &TerrainCollisionSystem{terrain: terrain, cover: cover}
+}
+
+// Update выталкивает животных из непроходимых тайлов
+func (tcs *TerrainCollisionSystem) Update(world core.MovementSystemAccess) {
+	world.ForEachWith(core.MaskPosition|core.MaskSize, func(entity core.EntityID) {
+		// Едящие животные не двигаются (как в CollisionSystem и BoundaryConstraintSystem)
+		if world.HasComponent(entity, core.MaskEatingState) {
+			return
+		}
+
+		pos, _ := world.GetPosition(entity)
+		size, _ := world.GetSize(entity)
+		config, _ := world.GetAnimalConfig(entity)
+		throughCover := tcs.cover != nil && canHideInCover(config.Diet, size.Radius)
+
+		centerX, centerY := constants.PositionToTiles(pos.X, pos.Y)
+		center := vec2.New(centerX, centerY)
+		resolved, normals := tcs.resolveCircle(center, size.Radius, throughCover)
+		if len(normals) == 0 {
+			return
+		}
+
+		newPos := core.NewPosition(constants.TilesToPixels(resolved.X), constants.TilesToPixels(resolved.Y))
+		world.SetPosition(entity, newPos)
+		world.UpdateSpatialPosition(entity, newPos)
+
+		if vel, hasVel := world.GetVelocity(entity); hasVel {
+			world.SetVelocity(entity, slideAlongWalls(vel, normals))
+		}
+	})
+}
+
+// resolveCircle выталкивает круг (тайлы) из непроходимых тайлов
+// Возвращает новый центр и нормали стен, от которых круг был вытолкнут
+// throughCover - кусты проходимы (мелкое травоядное)
+func (tcs *TerrainCollisionSystem) resolveCircle(
+	center vec2.Vec2, radius float32, throughCover bool,
+) (vec2.Vec2, []vec2.Vec2) {
+	var normals []vec2.Vec2
+
+	for iteration := 0; iteration < TerrainCollisionIterations; iteration++ {
+		normal, depth, hit := tcs.deepestContact(center, radius, throughCover)
+		if !hit {
+			break
+		}
+
+		center = center.Add(normal.Scale(depth + TerrainCollisionSkin))
+		normals = append(normals, normal)
+	}
+
+	return center, normals
+}
+
+// deepestContact находит непроходимый тайл с наибольшим пересечением круга
+// Возвращает нормаль выталкивания (от тайла к центру) и глубину проникновения
+func (tcs *TerrainCollisionSystem) deepestContact(
+	center vec2.Vec2, radius float32, throughCover bool,
+) (vec2.Vec2, float32, bool) {
+	var bestNormal vec2.Vec2
+	var bestDepth float32
+	found := false
+
+	minX, maxX := constants.TileIndex(center.X-radius), constants.TileIndex(center.X+radius)
+	minY, maxY := constants.TileIndex(center.Y-radius), constants.TileIndex(center.Y+radius)
+
+	for tileY := minY; tileY <= maxY; tileY++ {
+		for tileX := minX; tileX <= maxX; tileX++ {
+			if tcs.isPassable(tileX, tileY, throughCover) {
+				continue
+			}
+
+			normal, depth, hit := tcs.tileContact(center, radius, tileX, tileY, throughCover)
+			if hit && (!found || depth > bestDepth) {
+				bestNormal, bestDepth, found = normal, depth, true
+			}
+		}
+	}
+
+	return bestNormal, bestDepth, found
+}
+
+// tileContact вычисляет пересечение круга с квадратом тайла
+func (tcs *TerrainCollisionSystem) tileContact(
+	center vec2.Vec2, radius float32, tileX, tileY int, throughCover bool,
+) (vec2.Vec2, float32, bool) {
+	left, top := float32(tileX), float32(tileY)
+	right, bottom := left+1, top+1
+
+	// Ближайшая к центру точка тайла
+	closest := vec2.New(clampFloat32(center.X, left, right), clampFloat32(center.Y, top, bottom))
+	offset := center.Sub(closest)
+	distance := offset.Length()
+
+	if distance > 0 {
+		if distance >= radius {
+			return vec2.Vec2{}, 0, false
+		}
+		return offset.Scale(1 / distance), radius - distance, true
+	}
+
+	// Центр внутри тайла: выталкиваем через ближайшую грань, за которой проходимый тайл
+	return tcs.insideTileContact(center, radius, tileX, tileY, throughCover)
+}
+
+// insideTileContact выбирает грань выхода для центра внутри непроходимого тайла
+// Грани с непроходимым соседом пропускаются, если есть хоть одна с проходимым
+func (tcs *TerrainCollisionSystem) insideTileContact(
+	center vec2.Vec2, radius float32, tileX, tileY int, throughCover bool,
+) (vec2.Vec2, float32, bool) {
+	left, top := float32(tileX), float32(tileY)
+
+	exits := []struct {
+		normal   vec2.Vec2
+		distance float32
+		passable bool
+	}{
+		{vec2.New(-1, 0), center.X - left, tcs.isPassable(tileX-1, tileY, throughCover)},
+		{vec2.New(1, 0), left + 1 - center.X, tcs.isPassable(tileX+1, tileY, throughCover)},
+		{vec2.New(0, -1), center.Y - top, tcs.isPassable(tileX, tileY-1, throughCover)},
+		{vec2.New(0, 1), top + 1 - center.Y, tcs.isPassable(tileX, tileY+1, throughCover)},
+	}
+
+	best := -1
+	for i, exit := range exits {
+		if best < 0 ||
+			(exit.passable && !exits[best].passable) ||
+			(exit.passable == exits[best].passable && exit.distance < exits[best].distance) {
+			best = i
+		}
+	}
+
+	return exits[best].normal, exits[best].distance + radius, true
+}
+
+// isPassable проверяет проходимость тайла с учётом кустов для мелких травоядных
+func (tcs *TerrainCollisionSystem) isPassable(tileX, tileY int, throughCover bool) bool {
+	return tcs.terrain.IsPassable(tileX, tileY) || (throughCover && tcs.cover.IsCover(tileX, tileY))
+}
+
+// slideAlongWalls убирает из скорости составляющие, направленные в стены
+// Касательная составляющая сохраняется - животное скользит вдоль края тайла
+func slideAlongWalls(velocity core.Velocity, normals []vec2.Vec2) core.Velocity {
+	v := vec2.New(velocity.X, velocity.Y)
+	for _, normal := range normals {
+		if into := v.Dot(normal); into < 0 {
+			v = v.Sub(normal.Scale(into))
+		}
+	}
+	return core.NewVelocity(v.X, v.Y)
+}
@@ -0,0 +1,79 @@
+package simulation
+
+import (
+	"testing"
+
+	"github.com/aiseeq/savanna/internal/constants"
+	"github.com/aiseeq/savanna/internal/core"
+	"github.com/aiseeq/savanna/internal/generator"
+	"github.com/aiseeq/savanna/internal/vec2"
+)
+
+// newRiverTerrain создаёт травяную карту с вертикальной рекой в столбце riverX
+func newRiverTerrain(size, riverX int) *generator.Terrain {
+	terrain := newPondTerrain(size, riverX, 0)
+	for y := 0; y < size; y++ {
+		terrain.SetTileType(riverX, y, generator.TileWater)
+	}
+	return terrain
+}
+
+func TestTerrainCollision_PushesOutOfWater(t *testing.T) {
+	world := core.NewWorld(640, 640, 12345)
+	system := NewTerrainCollisionSystem(NewVegetationSystem(newPondTerrain(20, 10, 10)))
+
+	// Центр зайца чуть правее середины тайла воды - выталкивается через правую грань
+	x, y := tileCenter(10, 10)
+	rabbit := CreateAnimal(world, core.TypeRabbit, x+4, y)
+
+	system.Update(world)
+
+	pos, _ := world.GetPosition(rabbit)
+	size, _ := world.GetSize(rabbit)
+	tileX, _ := constants.PositionToTiles(pos.X, pos.Y)
+	if tileX-size.Radius < 11 {
+		t.Errorf("Rabbit circle should be outside the water tile, left edge at %.3f tiles", tileX-size.Radius)
+	}
+}
+
+func TestTerrainCollision_SlidesAlongShore(t *testing.T) {
+	world := core.NewWorld(640, 640, 12345)
+	system := NewTerrainCollisionSystem(NewVegetationSystem(newRiverTerrain(20, 10)))
+
+	// Заяц вплотную к реке бежит по диагонали в воду
+	rabbit := CreateAnimal(world, core.TypeRabbit, 0, 0)
+	size, _ := world.GetSize(rabbit)
+	start := core.NewPosition(constants.TilesToPixels(10-size.Radius/2), constants.TilesToPixels(5.5))
+	world.SetPosition(rabbit, start)
+	world.SetVelocity(rabbit, core.NewVelocity(10, 10))
+
+	system.Update(world)
+
+	vel, _ := world.GetVelocity(rabbit)
+	if vel.X > 0.001 {
+		t.Errorf("Velocity into the river should be removed, got X=%.3f", vel.X)
+	}
+	if vel.Y != 10 {
+		t.Errorf("Velocity along the shore should be kept, got Y=%.3f", vel.Y)
+	}
+}
+
+func TestTerrainAvoidance_FleeTurnsAwayFromWater(t *testing.T) {
+	avoidance := terrainAvoidance{terrain: NewVegetationSystem(newRiverTerrain(20, 10))}
+
+	pos := core.NewPosition(constants.TilesToPixels(9.2), constants.TilesToPixels(5.5))
+	direction := avoidance.avoidImpassable(pos, vec2.New(1, 0), RabbitBaseRadius)
+
+	if !avoidance.isDirectionClear(pos, direction, RabbitBaseRadius) {
+		t.Errorf("Steered direction %+v should not lead into water", direction)
+	}
+	if direction.X < -0.9 {
+		t.Errorf("Steering should not turn straight back, got %+v", direction)
+	}
+
+	// Без воды на пути направление не меняется
+	open := avoidance.avoidImpassable(pos, vec2.New(-1, 0), RabbitBaseRadius)
+	if open != vec2.New(-1, 0) {
+		t.Errorf("Clear direction should stay unchanged, got %+v", open)
+	}
+}
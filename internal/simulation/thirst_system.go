@@ -0,0 +1,134 @@
+package simulation
+
+import (
+	"github.com/aiseeq/savanna/internal/core"
+)
+
+// ThirstSystem управляет жаждой животных (SRP)
+// Единственная ответственность: гидратация - её потеря, питьё у водоёмов и урон от обезвоживания
+type ThirstSystem struct {
+	balanced                             // Профиль баланса: потеря воды, питьё и обезвоживание
+	water             core.WaterProvider // Интерфейс для работы с водоёмами (соблюдение DIP)
+	healthDamageTimer float32            // Таймер для нанесения урона здоровью (раз в секунду)
+}
+
+// NewThirstSystem создаёт новую систему жажды
+func NewThirstSystem(water core.WaterProvider) *ThirstSystem {
+	return &ThirstSystem{
+		water: water,
+	}
+}
+
+// Update обновляет гидратацию, состояния питья и урон от обезвоживания
+// ISP Улучшение: использует узкоспециализированный интерфейс
+func (ts *ThirstSystem) Update(world core.ThirstSystemAccess, deltaTime float32) {
+	var startDrinking, stopDrinking []core.EntityID
+
+	world.ForEachWith(core.MaskHydration|core.MaskPosition, func(entity core.EntityID) {
+		// Трупы не пьют
+		if world.HasComponent(entity, core.MaskCorpse) {
+			return
+		}
+
+		if world.HasComponent(entity, core.MaskDrinkingState) {
+			if !ts.updateDrinking(world, entity, deltaTime) {
+				stopDrinking = append(stopDrinking, entity)
+			}
+			return
+		}
+
+		ts.decreaseHydration(world, entity, deltaTime)
+		if ts.canStartDrinking(world, entity) {
+			startDrinking = append(startDrinking, entity)
+		}
+	})
+
+	// Состояния меняются после обхода (не модифицируем маски во время ForEachWith)
+	for _, entity := range stopDrinking {
+		world.RemoveDrinkingState(entity)
+	}
+	for _, entity := range startDrinking {
+		world.AddDrinkingState(entity, core.DrinkingState{})
+	}
+
+	ts.healthDamageTimer += deltaTime
+	if ts.healthDamageTimer >= 1.0 {
+		ts.damageDehydratedAnimals(world)
+		ts.healthDamageTimer = 0
+	}
+}
+
+// decreaseHydration уменьшает гидратацию животного со временем
+func (ts *ThirstSystem) decreaseHydration(world core.ThirstSystemAccess, entity core.EntityID, deltaTime float32) {
+	hydration, _ := world.GetHydration(entity)
+
+	balance := ts.profile()
+	rate := balance.Thirst.DecreaseRate
+	if size, hasSize := world.GetSize(entity); hasSize && size.Radius > balance.Satiation.LargeAnimalSizeThreshold {
+		rate *= balance.Thirst.LargeAnimalRate
+	}
+
+	hydration.Value = max(hydration.Value-rate*deltaTime, 0)
+	world.SetHydration(entity, hydration)
+}
+
+// updateDrinking восстанавливает гидратацию пьющего животного
+// Возвращает false когда питьё закончено (напился или отошёл от воды)
+func (ts *ThirstSystem) updateDrinking(world core.ThirstSystemAccess, entity core.EntityID, deltaTime float32) bool {
+	pos, _ := world.GetPosition(entity)
+	if ts.water == nil || !ts.water.CanDrinkAt(pos.X, pos.Y) {
+		return false // Животное оттолкнули от воды
+	}
+
+	hydration, _ := world.GetHydration(entity)
+	hydration.Value = min(hydration.Value+ts.profile().Thirst.DrinkRate*deltaTime, MaxHydration)
+	world.SetHydration(entity, hydration)
+
+	drinking, _ := world.GetDrinkingState(entity)
+	drinking.Duration += deltaTime
+	world.SetDrinkingState(entity, drinking)
+
+	return hydration.Value < MaxHydration
+}
+
+// canStartDrinking проверяет что животное хочет пить и стоит рядом с водой
+func (ts *ThirstSystem) canStartDrinking(world core.ThirstSystemAccess, entity core.EntityID) bool {
+	if ts.water == nil {
+		return false
+	}
+
+	hydration, _ := world.GetHydration(entity)
+	if hydration.Value >= ts.profile().Thirst.Threshold {
+		return false
+	}
+
+	// Едящие и атакующие животные заняты - пьют после
+	if world.HasComponent(entity, core.MaskEatingState) || world.HasComponent(entity, core.MaskAttackState) {
+		return false
+	}
+
+	pos, _ := world.GetPosition(entity)
+	return ts.water.CanDrinkAt(pos.X, pos.Y)
+}
+
+// damageDehydratedAnimals наносит урон здоровью животным без воды
+func (ts *ThirstSystem) damageDehydratedAnimals(world core.ThirstSystemAccess) {
+	damage := ts.profile().Thirst.DehydrationDamagePerSecond
+
+	world.ForEachWith(core.MaskHydration|core.MaskHealth, func(entity core.EntityID) {
+		if world.HasComponent(entity, core.MaskCorpse) {
+			return
+		}
+
+		hydration, _ := world.GetHydration(entity)
+		if hydration.Value > 0 {
+			return
+		}
+
+		health, _ := world.GetHealth(entity)
+		previous := health.Current
+		health.Current = max(health.Current-damage, 0)
+		world.SetHealth(entity, health)
+		reportDeath(world, entity, previous, health.Current, core.DeathDehydration, 0)
+	})
+}
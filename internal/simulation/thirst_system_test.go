@@ -0,0 +1,143 @@
+package simulation
+
+import (
+	"testing"
+
+	"github.com/aiseeq/savanna/internal/core"
+	"github.com/aiseeq/savanna/internal/generator"
+)
+
+const thirstTestDeltaTime = float32(1.0 / 60.0)
+
+// newPondTerrain создаёт травяную карту с одним тайлом воды
+func newPondTerrain(size, waterX, waterY int) *generator.Terrain {
+	terrain := &generator.Terrain{
+		Width:  size,
+		Height: size,
+		Size:   size,
+		Tiles:  make([][]generator.TileType, size),
+		Grass:  make([][]float32, size),
+	}
+	for y := 0; y < size; y++ {
+		terrain.Tiles[y] = make([]generator.TileType, size)
+		terrain.Grass[y] = make([]float32, size)
+	}
+	terrain.SetTileType(waterX, waterY, generator.TileWater)
+
+	return terrain
+}
+
+// tileCenter возвращает центр тайла в пикселях
+func tileCenter(tileX, tileY int) (x, y float32) {
+	x = float32(tileX*TileSizeVegetation + TileSizeVegetation/2)
+	y = float32(tileY*TileSizeVegetation + TileSizeVegetation/2)
+	return x, y
+}
+
+func TestThirst_DehydrationDamagesHealth(t *testing.T) {
+	world := core.NewWorld(640, 640, 12345)
+	vegetation := NewVegetationSystem(newPondTerrain(20, 15, 15))
+	system := NewThirstSystem(vegetation)
+
+	rabbit := CreateAnimal(world, core.TypeRabbit, 100, 100)
+
+	system.Update(world, 1.0)
+	hydration, _ := world.GetHydration(rabbit)
+	if hydration.Value != MaxHydration-BaseHydrationDecreaseRate {
+		t.Errorf("Hydration should decrease by %v per second, got %f", BaseHydrationDecreaseRate, hydration.Value)
+	}
+
+	world.SetHydration(rabbit, core.Hydration{Value: 0})
+	before, _ := world.GetHealth(rabbit)
+	system.Update(world, 1.0)
+	after, _ := world.GetHealth(rabbit)
+
+	if after.Current != before.Current-DehydrationDamagePerSecond {
+		t.Errorf("Dehydrated rabbit should lose %d HP, health %d -> %d",
+			DehydrationDamagePerSecond, before.Current, after.Current)
+	}
+}
+
+func TestThirst_DrinksNextToWater(t *testing.T) {
+	world := core.NewWorld(640, 640, 12345)
+	vegetation := NewVegetationSystem(newPondTerrain(20, 10, 10))
+	system := NewThirstSystem(vegetation)
+
+	x, y := tileCenter(11, 10)
+	rabbit := CreateAnimal(world, core.TypeRabbit, x, y)
+	world.SetHydration(rabbit, core.Hydration{Value: ThirstThreshold - 10})
+
+	system.Update(world, thirstTestDeltaTime)
+	if !world.HasComponent(rabbit, core.MaskDrinkingState) {
+		t.Fatal("Thirsty rabbit next to water should start drinking")
+	}
+
+	for i := 0; i < 600 && world.HasComponent(rabbit, core.MaskDrinkingState); i++ {
+		system.Update(world, thirstTestDeltaTime)
+	}
+
+	hydration, _ := world.GetHydration(rabbit)
+	if hydration.Value != MaxHydration {
+		t.Errorf("Rabbit should drink until full, got %f", hydration.Value)
+	}
+	if world.HasComponent(rabbit, core.MaskDrinkingState) {
+		t.Error("Drinking should stop when hydration is full")
+	}
+}
+
+func TestThirst_NoDrinkingAwayFromWater(t *testing.T) {
+	world := core.NewWorld(640, 640, 12345)
+	vegetation := NewVegetationSystem(newPondTerrain(20, 10, 10))
+	system := NewThirstSystem(vegetation)
+
+	x, y := tileCenter(13, 10)
+	rabbit := CreateAnimal(world, core.TypeRabbit, x, y)
+	world.SetHydration(rabbit, core.Hydration{Value: ThirstThreshold - 10})
+
+	system.Update(world, thirstTestDeltaTime)
+	if world.HasComponent(rabbit, core.MaskDrinkingState) {
+		t.Error("Rabbit two tiles away from water should not drink")
+	}
+}
+
+func TestVegetation_FindNearestDrinkableTile(t *testing.T) {
+	vegetation := NewVegetationSystem(newPondTerrain(20, 10, 10))
+
+	fromX, fromY := tileCenter(15, 10)
+	waterX, waterY, found := vegetation.FindNearestDrinkableTile(fromX, fromY, 10*TileSizeVegetation)
+	if !found {
+		t.Fatal("Drinkable tile should be found within search radius")
+	}
+	if expectedX, expectedY := tileCenter(11, 10); waterX != expectedX || waterY != expectedY {
+		t.Errorf("Expected nearest drinkable tile at (%f, %f), got (%f, %f)", expectedX, expectedY, waterX, waterY)
+	}
+	if !vegetation.CanDrinkAt(waterX, waterY) {
+		t.Error("Found tile should be drinkable")
+	}
+
+	if _, _, found := vegetation.FindNearestDrinkableTile(fromX, fromY, 2*TileSizeVegetation); found {
+		t.Error("Water outside search radius should not be found")
+	}
+}
+
+func TestThirst_ThirstyAnimalsWalkToWater(t *testing.T) {
+	for _, animalType := range []core.AnimalType{core.TypeRabbit, core.TypeWolf} {
+		t.Run(animalType.String(), func(t *testing.T) {
+			world := core.NewWorld(640, 640, 12345)
+			vegetation := NewVegetationSystem(newPondTerrain(20, 10, 10))
+			behavior := NewAnimalBehaviorSystem(vegetation)
+
+			x, y := tileCenter(16, 10)
+			animal := CreateAnimal(world, animalType, x, y)
+			world.SetHydration(animal, core.Hydration{Value: ThirstThreshold - 10})
+			world.SetSatiation(animal, core.Satiation{Value: MaxSatiationLimit}) // Не голоден
+
+			behavior.Update(world, thirstTestDeltaTime)
+
+			velocity, _ := world.GetVelocity(animal)
+			if velocity.X >= 0 {
+				t.Errorf("Thirsty %s should walk towards water (negative X), got velocity %+v", animalType, velocity)
+			}
+		})
+	}
+}
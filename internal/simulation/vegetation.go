@@ -20,8 +20,17 @@ const (
 
 // VegetationSystem управляет ростом и распределением травы
 type VegetationSystem struct {
+	balanced  // Профиль баланса: скорость роста травы
 	terrain   generator.TerrainInterface
 	worldSize int // Размер мира в тайлах
+
+	growth [core.SectorCount][]grassGrowth // Буферы роста по секторам карты (полосам тайлов)
+}
+
+// grassGrowth новое количество травы на тайле, посчитанное в секторе
+type grassGrowth struct {
+	x, y   int
+	amount float32
 }
 
 // NewVegetationSystem создаёт новую систему растительности
@@ -33,21 +42,34 @@ func NewVegetationSystem(terrain generator.TerrainInterface) *VegetationSystem {
 }
 
 // Update обновляет рост травы на всех тайлах
+// Полосы тайлов считаются параллельно (world.RunSectors), рост применяется по порядку полос
 func (vs *VegetationSystem) Update(world *core.World, deltaTime float32) {
 	if vs.terrain == nil {
 		return
 	}
 
-	// Проходим по всем тайлам и обновляем рост травы
-	for y := 0; y < vs.worldSize; y++ {
-		for x := 0; x < vs.worldSize; x++ {
-			vs.updateGrassTile(x, y, deltaTime)
+	world.RunSectors(core.SectorCount, func(sector int) {
+		growth := vs.growth[sector][:0]
+		for y := sector * vs.worldSize / core.SectorCount; y < (sector+1)*vs.worldSize/core.SectorCount; y++ {
+			for x := 0; x < vs.worldSize; x++ {
+				if amount, grows := vs.updateGrassTile(x, y, deltaTime); grows {
+					growth = append(growth, grassGrowth{x: x, y: y, amount: amount})
+				}
+			}
+		}
+		vs.growth[sector] = growth
+	})
+
+	for _, growth := range vs.growth {
+		for _, tile := range growth {
+			vs.terrain.SetGrassAmount(tile.x, tile.y, tile.amount)
 		}
 	}
 }
 
-// updateGrassTile обновляет рост травы на одном тайле
-func (vs *VegetationSystem) updateGrassTile(x, y int, deltaTime float32) {
+// updateGrassTile вычисляет рост травы на одном тайле (только чтение ландшафта)
+// false - трава на тайле не растёт
+func (vs *VegetationSystem) updateGrassTile(x, y int, deltaTime float32) (float32, bool) {
 	tileType := vs.terrain.GetTileType(x, y)
 
 	// Трава растёт только на подходящих тайлах
@@ -62,34 +84,35 @@ func (vs *VegetationSystem) updateGrassTile(x, y int, deltaTime float32) {
 	}
 
 	if !canGrow {
-		return
+		return 0, false
 	}
 
+	balance := vs.profile().Vegetation
 	currentGrass := vs.terrain.GetGrassAmount(x, y)
-	if currentGrass >= GrassMaxAmount {
-		return // Уже максимум
+	if currentGrass >= balance.GrassMaxAmount {
+		return 0, false // Уже максимум
 	}
 
 	// Вычисляем скорость роста
-	growthRate := GrassGrowthRate * deltaTime
+	growthRate := balance.GrassGrowthRate * deltaTime
 
 	// На влажной земле трава растёт быстрее
 	if tileType == generator.TileWetland {
-		growthRate *= WetlandGrowthMultiplier
+		growthRate *= balance.WetlandGrowthMultiplier
 	}
 
 	// Не растёт рядом с водой (кроме влажной земли)
 	if tileType == generator.TileGrass && vs.isNearWater(x, y) {
-		growthRate *= NearWaterGrowthPenalty
+		growthRate *= balance.NearWaterGrowthPenalty
 	}
 
 	// Увеличиваем количество травы
 	newAmount := currentGrass + growthRate
-	if newAmount > GrassMaxAmount {
-		newAmount = GrassMaxAmount
+	if newAmount > balance.GrassMaxAmount {
+		newAmount = balance.GrassMaxAmount
 	}
 
-	vs.terrain.SetGrassAmount(x, y, newAmount)
+	return newAmount, true
 }
 
 // isNearWater проверяет есть ли вода в радиусе 1 тайла (исключая влажную землю)
@@ -234,7 +257,7 @@ func (vs *VegetationSystem) collectGrassData() grassStatistics {
 		totalGrass:     0,
 		tilesWithGrass: 0,
 		maxGrass:       0,
-		minGrass:       GrassMaxAmount,
+		minGrass:       vs.profile().Vegetation.GrassMaxAmount,
 	}
 
 	for y := 0; y < vs.worldSize; y++ {
@@ -345,11 +368,12 @@ func (vs *VegetationSystem) UpdateGrassAt(worldX, worldY, delta float32) {
 	currentGrass := vs.terrain.GetGrassAmount(tileX, tileY)
 	newAmount := currentGrass + delta
 
-	// Ограничиваем в пределах [0, GrassMaxAmount]
+	// Ограничиваем в пределах [0, максимум травы из профиля баланса]
+	maxAmount := vs.profile().Vegetation.GrassMaxAmount
 	if newAmount < 0 {
 		newAmount = 0
-	} else if newAmount > GrassMaxAmount {
-		newAmount = GrassMaxAmount
+	} else if newAmount > maxAmount {
+		newAmount = maxAmount
 	}
 
 	vs.terrain.SetGrassAmount(tileX, tileY, newAmount)
@@ -361,9 +385,109 @@ func (vs *VegetationSystem) IsPassable(tileX, tileY int) bool {
 		return false
 	}
 
+	// Вода и кусты непроходимы (как generator.Terrain.IsPassable)
+	tileType := vs.terrain.GetTileType(tileX, tileY)
+	return tileType == generator.TileGrass || tileType == generator.TileWetland
+}
+
+// CanDrinkAt проверяет можно ли пить в указанной позиции (реализация интерфейса WaterProvider)
+func (vs *VegetationSystem) CanDrinkAt(worldX, worldY float32) bool {
+	tileX := int(worldX / TileSizeVegetation)
+	tileY := int(worldY / TileSizeVegetation)
+
+	return vs.IsDrinkableTile(tileX, tileY)
+}
+
+// FindNearestDrinkableTile ищет ближайший проходимый тайл рядом с водой (реализация интерфейса WaterProvider)
+func (vs *VegetationSystem) FindNearestDrinkableTile(
+	worldX, worldY, searchRadius float32,
+) (tileX, tileY float32, found bool) {
+	centerTileX := int(worldX / TileSizeVegetation)
+	centerTileY := int(worldY / TileSizeVegetation)
+	searchRadiusTiles := int(searchRadius / TileSizeVegetation)
+
+	bestDistance := float32(LargeDistanceValue)
+
+	// Ищем по спирали от центра (как FindNearestGrass)
+	for radius := 0; radius <= searchRadiusTiles; radius++ {
+		for _, tile := range vs.getSpiralRingTiles(centerTileX, centerTileY, radius) {
+			if !vs.IsDrinkableTile(tile.x, tile.y) {
+				continue
+			}
+
+			waterX, waterY := vs.tileToWorldCenter(tile.x, tile.y)
+			distanceSquared := vs.calculateDistanceSquared(waterX, waterY, worldX, worldY)
+
+			if distanceSquared < bestDistance {
+				bestDistance = distanceSquared
+				tileX, tileY = waterX, waterY
+				found = true
+			}
+		}
+
+		if found {
+			return tileX, tileY, true
+		}
+	}
+
+	return 0, 0, false
+}
+
+// IsCover проверяет что тайл - куст (реализация интерфейса CoverProvider)
+func (vs *VegetationSystem) IsCover(tileX, tileY int) bool {
+	if !vs.isValidTile(tileX, tileY) {
+		return false
+	}
+	return vs.terrain.GetTileType(tileX, tileY) == generator.TileBush
+}
+
+// FindNearestCover ищет центр ближайшего куста (реализация интерфейса CoverProvider)
+func (vs *VegetationSystem) FindNearestCover(
+	worldX, worldY, searchRadius float32,
+) (coverX, coverY float32, found bool) {
+	centerTileX := int(worldX / TileSizeVegetation)
+	centerTileY := int(worldY / TileSizeVegetation)
+	searchRadiusTiles := int(searchRadius / TileSizeVegetation)
+
+	bestDistance := float32(LargeDistanceValue)
+
+	// Ищем по спирали от центра (как FindNearestDrinkableTile)
+	for radius := 0; radius <= searchRadiusTiles; radius++ {
+		for _, tile := range vs.getSpiralRingTiles(centerTileX, centerTileY, radius) {
+			if !vs.IsCover(tile.x, tile.y) {
+				continue
+			}
+
+			bushX, bushY := vs.tileToWorldCenter(tile.x, tile.y)
+			distanceSquared := vs.calculateDistanceSquared(bushX, bushY, worldX, worldY)
+
+			if distanceSquared < bestDistance {
+				bestDistance = distanceSquared
+				coverX, coverY = bushX, bushY
+				found = true
+			}
+		}
+
+		if found {
+			return coverX, coverY, true
+		}
+	}
+
+	return 0, 0, false
+}
+
+// IsDrinkableTile проверяет что на тайле можно стоять и рядом есть вода (цели поля потока к водопою)
+func (vs *VegetationSystem) IsDrinkableTile(tileX, tileY int) bool {
+	if !vs.isValidTile(tileX, tileY) {
+		return false
+	}
+
 	tileType := vs.terrain.GetTileType(tileX, tileY)
-	// Проходимы все типы кроме воды
-	return tileType != generator.TileWater
+	if tileType != generator.TileGrass && tileType != generator.TileWetland {
+		return false // С воды и кустов пить нельзя
+	}
+
+	return vs.isNearWater(tileX, tileY)
 }
 
 // abs возвращает абсолютное значение integer
@@ -26,6 +26,7 @@ func (f *WolfConfigFactory) CreateConfig() core.AnimalConfig {
 		VisionRange:     WolfBaseRadius * WolfVisionMultiplier,
 
 		// Поведение хищника
+		Diet:               core.BehaviorPredator,
 		SatiationThreshold: WolfSatiationThreshold,
 		FleeThreshold:      PacifistAttackDamage, // Волк не убегает (используем 0.0)
 
@@ -42,5 +43,12 @@ func (f *WolfConfigFactory) CreateConfig() core.AnimalConfig {
 		AttackDamage:   WolfAttackDamageDefault,
 		AttackCooldown: WolfAttackCooldown,
 		HitChance:      WolfHitChance,
+
+		// Размножение
+		ReproductionCooldown: WolfReproductionCooldown,
+		GestationTime:        WolfGestationTime,
+		MaturityAge:          WolfMaturityAge,
+		ElderAge:             WolfElderAge,
+		MaxAge:               WolfMaxAge,
 	}
 }
@@ -0,0 +1,239 @@
+// Package snapshot сохраняет и загружает полные снапшоты симуляции (мир + ландшафт + состояние систем)
+package snapshot
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aiseeq/savanna/internal/core"
+	"github.com/aiseeq/savanna/internal/generator"
+	"github.com/aiseeq/savanna/internal/simulation"
+)
+
+// Константы формата
+const (
+	// FormatVersion текущая версия формата снапшота
+	// Увеличивать при любом несовместимом изменении структуры (новые компоненты и т.п.)
+	FormatVersion uint32 = 2
+
+	// Magic сигнатура бинарного файла снапшота
+	Magic = "SAVSNAP\x00"
+
+	// JSONExtension расширение, по которому выбирается JSON формат
+	JSONExtension = ".json"
+
+	// FilePermissions права доступа к файлам снапшотов
+	FilePermissions = 0o600
+)
+
+// Ошибки формата
+var (
+	ErrBadMagic           = errors.New("not a savanna snapshot")
+	ErrUnsupportedVersion = errors.New("unsupported snapshot version")
+	ErrIncomplete         = errors.New("snapshot is missing world or terrain")
+)
+
+// Snapshot полный слепок симуляции
+type Snapshot struct {
+	Version uint32              `json:"version"`
+	World   *core.WorldSnapshot `json:"world"`
+	Terrain *generator.Terrain  `json:"terrain"`
+	Systems []core.SystemState  `json:"systems,omitempty"`
+
+	// Профиль баланса прогона (nil - значения по умолчанию)
+	// Без него продолжение с другим балансом разошлось бы с исходным прогоном
+	Balance *simulation.BalanceProfile `json:"balance,omitempty"`
+}
+
+// Capture снимает снапшот с мира, ландшафта, (опционально) систем и профиля баланса
+// Ландшафт копируется: дальнейшее изменение травы не влияет на снапшот
+func Capture(
+	world *core.World,
+	terrain *generator.Terrain,
+	systems *core.SystemManager,
+	balance *simulation.BalanceProfile,
+) (*Snapshot, error) {
+	worldSnapshot, err := world.CreateSnapshot()
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &Snapshot{
+		Version: FormatVersion,
+		World:   worldSnapshot,
+		Terrain: copyTerrain(terrain),
+		Balance: balance,
+	}
+
+	if systems != nil {
+		snapshot.Systems = systems.SaveStates()
+	}
+
+	return snapshot, nil
+}
+
+// Restore восстанавливает мир и копию ландшафта
+// Системы нужно создать заново поверх восстановленного ландшафта с профилем Balance и вызвать RestoreSystems
+func (s *Snapshot) Restore() (*core.World, *generator.Terrain, error) {
+	if s.World == nil || s.Terrain == nil {
+		return nil, nil, ErrIncomplete
+	}
+
+	world, err := core.NewWorldFromSnapshot(s.World)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return world, copyTerrain(s.Terrain), nil
+}
+
+// RestoreSystems загружает внутреннее состояние систем
+// Менеджер должен содержать тот же набор систем в том же порядке, что и при сохранении
+func (s *Snapshot) RestoreSystems(systems *core.SystemManager) error {
+	return systems.LoadStates(s.Systems)
+}
+
+// Encode записывает снапшот в бинарном формате: Magic, версия (uint32 LE), gob-тело
+func Encode(w io.Writer, s *Snapshot) error {
+	if _, err := io.WriteString(w, Magic); err != nil {
+		return fmt.Errorf("failed to write snapshot header: %w", err)
+	}
+	if err := binary.Write(w, binary.LittleEndian, s.Version); err != nil {
+		return fmt.Errorf("failed to write snapshot version: %w", err)
+	}
+	if err := gob.NewEncoder(w).Encode(s); err != nil {
+		return fmt.Errorf("failed to encode snapshot: %w", err)
+	}
+	return nil
+}
+
+// Decode читает снапшот в бинарном формате
+func Decode(r io.Reader) (*Snapshot, error) {
+	header := make([]byte, len(Magic))
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, fmt.Errorf("failed to read snapshot header: %w", err)
+	}
+	if string(header) != Magic {
+		return nil, ErrBadMagic
+	}
+
+	var version uint32
+	if err := binary.Read(r, binary.LittleEndian, &version); err != nil {
+		return nil, fmt.Errorf("failed to read snapshot version: %w", err)
+	}
+	if err := checkVersion(version); err != nil {
+		return nil, err
+	}
+
+	var s Snapshot
+	if err := gob.NewDecoder(r).Decode(&s); err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot: %w", err)
+	}
+	return &s, nil
+}
+
+// EncodeJSON записывает снапшот в JSON (для отладки и ручного редактирования)
+func EncodeJSON(w io.Writer, s *Snapshot) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(s); err != nil {
+		return fmt.Errorf("failed to encode snapshot json: %w", err)
+	}
+	return nil
+}
+
+// DecodeJSON читает снапшот из JSON
+func DecodeJSON(r io.Reader) (*Snapshot, error) {
+	var s Snapshot
+	if err := json.NewDecoder(r).Decode(&s); err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot json: %w", err)
+	}
+	if err := checkVersion(s.Version); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// SaveToFile сохраняет снапшот в файл (формат выбирается по расширению: .json или бинарный)
+func SaveToFile(filename string, s *Snapshot) error {
+	file, err := os.OpenFile(filename, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, FilePermissions)
+	if err != nil {
+		return fmt.Errorf("failed to create snapshot file: %w", err)
+	}
+	defer file.Close()
+
+	writer := bufio.NewWriter(file)
+	if isJSONFile(filename) {
+		err = EncodeJSON(writer, s)
+	} else {
+		err = Encode(writer, s)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := writer.Flush(); err != nil {
+		return fmt.Errorf("failed to write snapshot file: %w", err)
+	}
+	return nil
+}
+
+// LoadFromFile загружает снапшот из файла (формат выбирается по расширению)
+func LoadFromFile(filename string) (*Snapshot, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open snapshot file: %w", err)
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	if isJSONFile(filename) {
+		return DecodeJSON(reader)
+	}
+	return Decode(reader)
+}
+
+// checkVersion проверяет что версия формата поддерживается
+func checkVersion(version uint32) error {
+	if version != FormatVersion {
+		return fmt.Errorf("%w: got %d, expected %d", ErrUnsupportedVersion, version, FormatVersion)
+	}
+	return nil
+}
+
+// isJSONFile определяет формат по расширению файла
+func isJSONFile(filename string) bool {
+	return strings.EqualFold(filepath.Ext(filename), JSONExtension)
+}
+
+// copyTerrain создаёт глубокую копию ландшафта
+func copyTerrain(terrain *generator.Terrain) *generator.Terrain {
+	if terrain == nil {
+		return nil
+	}
+
+	result := &generator.Terrain{
+		Width:  terrain.Width,
+		Height: terrain.Height,
+		Size:   terrain.Size,
+		Tiles:  make([][]generator.TileType, len(terrain.Tiles)),
+		Grass:  make([][]float32, len(terrain.Grass)),
+	}
+
+	for y := range terrain.Tiles {
+		result.Tiles[y] = append([]generator.TileType(nil), terrain.Tiles[y]...)
+	}
+	for y := range terrain.Grass {
+		result.Grass[y] = append([]float32(nil), terrain.Grass[y]...)
+	}
+
+	return result
+}
@@ -0,0 +1,146 @@
+package sweep
+
+import (
+	"math"
+
+	"github.com/aiseeq/savanna/internal/batch"
+)
+
+// Параметры обнаружения циклов хищник-жертва
+const (
+	// Гистерезис пересечения среднего в долях стандартного отклонения:
+	// шум численности на ±1 особь не считается новым циклом
+	CrossingHysteresis = 0.5
+	// Минимум замеров для анализа колебаний
+	minOscillationSamples = 4
+)
+
+// SpeciesMetrics показатели одного вида в прогоне
+type SpeciesMetrics struct {
+	Initial        int     `json:"initial"`
+	Final          int     `json:"final"`
+	Min            int     `json:"min"`
+	Max            int     `json:"max"`
+	Extinct        bool    `json:"extinct"`
+	ExtinctionTime float32 `json:"extinction_time,omitempty"` // Время вымирания (секунды)
+	Cycles         int     `json:"cycles"`                    // Полных циклов колебаний
+	Period         float32 `json:"period,omitempty"`          // Средний период цикла (секунды)
+	Amplitude      float32 `json:"amplitude,omitempty"`       // Средняя полуамплитуда цикла (особи)
+}
+
+// RunMetrics показатели одного прогона
+type RunMetrics struct {
+	Seed     int64          `json:"seed"`
+	Survived bool           `json:"survived"` // Оба вида живы на конец прогона
+	Rabbits  SpeciesMetrics `json:"rabbits"`
+	Wolves   SpeciesMetrics `json:"wolves"`
+}
+
+// populationSample численность вида в момент замера
+type populationSample struct {
+	time  float32
+	count int
+}
+
+// AnalyzeRun вычисляет показатели прогона по статистике интервалов
+func AnalyzeRun(result batch.RunResult, prey, predator string) RunMetrics {
+	metrics := RunMetrics{
+		Seed:    result.Seed,
+		Rabbits: analyzeSpecies(speciesSeries(result, prey)),
+		Wolves:  analyzeSpecies(speciesSeries(result, predator)),
+	}
+	metrics.Survived = !metrics.Rabbits.Extinct && !metrics.Wolves.Extinct
+	return metrics
+}
+
+// speciesSeries извлекает ряд численности одного вида
+func speciesSeries(result batch.RunResult, species string) []populationSample {
+	series := make([]populationSample, len(result.Intervals))
+	for i, stats := range result.Intervals {
+		series[i] = populationSample{time: stats.Time, count: stats.Population[species]}
+	}
+	return series
+}
+
+// analyzeSpecies вычисляет вымирание и колебания по ряду численности
+// Колебания анализируются до момента вымирания: нули после него не образуют циклов
+func analyzeSpecies(series []populationSample) SpeciesMetrics {
+	if len(series) == 0 {
+		return SpeciesMetrics{}
+	}
+
+	metrics := SpeciesMetrics{
+		Initial: series[0].count,
+		Final:   series[len(series)-1].count,
+		Min:     series[0].count,
+		Max:     series[0].count,
+	}
+
+	living := series
+	for i, sample := range series {
+		metrics.Min = min(metrics.Min, sample.count)
+		metrics.Max = max(metrics.Max, sample.count)
+
+		if sample.count == 0 && !metrics.Extinct {
+			metrics.Extinct = true
+			metrics.ExtinctionTime = sample.time
+			living = series[:i]
+		}
+	}
+
+	metrics.Cycles, metrics.Period, metrics.Amplitude = oscillation(living)
+	return metrics
+}
+
+// oscillation находит циклы по пересечениям среднего снизу вверх с гистерезисом
+// Период - среднее время между пересечениями, амплитуда - средняя (max-min)/2 внутри циклов
+func oscillation(series []populationSample) (cycles int, period, amplitude float32) {
+	if len(series) < minOscillationSamples {
+		return 0, 0, 0
+	}
+
+	var sum, sumSquares float64
+	for _, sample := range series {
+		sum += float64(sample.count)
+		sumSquares += float64(sample.count) * float64(sample.count)
+	}
+	mean := sum / float64(len(series))
+	deviation := math.Sqrt(math.Max(sumSquares/float64(len(series))-mean*mean, 0))
+	if deviation == 0 {
+		return 0, 0, 0
+	}
+
+	band := deviation * CrossingHysteresis
+	low, high := mean-band, mean+band
+
+	var crossings []int // Индексы замеров с пересечением вверх
+	below := false
+	for i, sample := range series {
+		value := float64(sample.count)
+		switch {
+		case value < low:
+			below = true
+		case value > high && below:
+			crossings = append(crossings, i)
+			below = false
+		}
+	}
+
+	if len(crossings) < 2 {
+		return 0, 0, 0
+	}
+
+	cycles = len(crossings) - 1
+	var totalAmplitude float64
+	for c := 0; c < cycles; c++ {
+		lowest, highest := series[crossings[c]].count, series[crossings[c]].count
+		for _, sample := range series[crossings[c]:crossings[c+1]] {
+			lowest = min(lowest, sample.count)
+			highest = max(highest, sample.count)
+		}
+		totalAmplitude += float64(highest-lowest) / 2
+	}
+
+	first, last := series[crossings[0]].time, series[crossings[cycles]].time
+	return cycles, (last - first) / float32(cycles), float32(totalAmplitude / float64(cycles))
+}
@@ -0,0 +1,74 @@
+package sweep
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// WriteJSON записывает отчёт в JSON (машиночитаемый формат для дальнейшего анализа)
+func WriteJSON(w io.Writer, report *Report) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
+
+// WriteSummary записывает сводную таблицу: сначала комбинации с наибольшей долей выживания
+func WriteSummary(w io.Writer, report *Report) error {
+	order := make([]int, len(report.Combinations))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return report.Combinations[order[i]].SurvivalRate > report.Combinations[order[j]].SurvivalRate
+	})
+
+	table := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+
+	header := append([]string{}, report.Parameters...)
+	header = append(header, "survived", "stable",
+		"rabbit extinct", "rabbit t(ext)", "rabbit period", "rabbit amp",
+		"wolf extinct", "wolf t(ext)", "wolf period", "wolf amp")
+	fmt.Fprintln(table, strings.Join(header, "\t")+"\t")
+
+	runs := len(report.Seeds)
+	for _, index := range order {
+		result := report.Combinations[index]
+
+		row := make([]string, 0, len(header))
+		for _, name := range report.Parameters {
+			row = append(row, strconv.FormatFloat(result.Parameters[name], 'g', -1, 64))
+		}
+		row = append(row, fmt.Sprintf("%d/%d", result.Survived, runs), yesNo(result.Stable))
+		row = append(row, speciesColumns(result.Rabbits, runs)...)
+		row = append(row, speciesColumns(result.Wolves, runs)...)
+		fmt.Fprintln(table, strings.Join(row, "\t")+"\t")
+	}
+
+	return table.Flush()
+}
+
+// speciesColumns колонки вида в сводной таблице ("-" - нет данных)
+func speciesColumns(summary SpeciesSummary, runs int) []string {
+	columns := []string{fmt.Sprintf("%d/%d", summary.ExtinctRuns, runs), "-", "-", "-"}
+	if summary.ExtinctRuns > 0 {
+		columns[1] = fmt.Sprintf("%.0fs", summary.MeanExtinctionTime)
+	}
+	if summary.OscillatingRuns > 0 {
+		columns[2] = fmt.Sprintf("%.0fs", summary.MeanPeriod)
+		columns[3] = fmt.Sprintf("%.1f", summary.MeanAmplitude)
+	}
+	return columns
+}
+
+// yesNo форматирует признак для таблицы
+func yesNo(value bool) string {
+	if value {
+		return "yes"
+	}
+	return "no"
+}
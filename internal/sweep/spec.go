@@ -0,0 +1,306 @@
+package sweep
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/aiseeq/savanna/config"
+	"github.com/aiseeq/savanna/internal/simulation"
+)
+
+// Префиксы имён параметров: конфигурация мира или профиль баланса
+const (
+	PopulationPrefix = "population."
+	BalancePrefix    = "balance."
+)
+
+// Параметры перебора по умолчанию
+const (
+	DefaultDuration = 600.0 // Целевая длительность выживания (секунды симуляции)
+	DefaultInterval = 60    // Период замера численности (тики) - раз в секунду при 60 TPS
+	DefaultSeeds    = "1-5"
+
+	stepEpsilon      = 1e-9 // Допуск при переборе дробных шагов
+	appliedTolerance = 1e-6 // Допуск сравнения применённого значения (поля float32)
+)
+
+// Spec описание перебора параметров
+//
+//	seeds: "1-10"
+//	duration: 600
+//	parameters:
+//	  - name: population.rabbits
+//	    from: 20
+//	    to: 60
+//	    step: 20
+//	  - name: balance.animals.wolf.attack_damage
+//	    values: [25, 35]
+type Spec struct {
+	Seeds      string           `yaml:"seeds"`    // Seed каждой комбинации: список и диапазоны
+	Duration   float64          `yaml:"duration"` // Целевая длительность (секунды)
+	Interval   int              `yaml:"interval"` // Период замера численности (тики)
+	Parameters []ParameterRange `yaml:"parameters"`
+}
+
+// ParameterRange диапазон одного параметра
+// Имя - путь YAML ключей: population.<ключ> для config.PopulationConfig,
+// balance.<раздел>.<ключ> для профиля баланса (например balance.vegetation.grass_growth_rate)
+type ParameterRange struct {
+	Name   string    `yaml:"name"`
+	From   float64   `yaml:"from"`
+	To     float64   `yaml:"to"`
+	Step   float64   `yaml:"step"`
+	Values []float64 `yaml:"values"` // Явный список значений (вместо from/to/step)
+}
+
+// Combination значения всех параметров одного прогона по имени
+type Combination map[string]float64
+
+// LoadSpec загружает описание перебора из YAML файла
+func LoadSpec(filename string) (*Spec, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read sweep spec: %w", err)
+	}
+
+	spec := &Spec{Seeds: DefaultSeeds, Duration: DefaultDuration, Interval: DefaultInterval}
+	if err := yaml.Unmarshal(data, spec); err != nil {
+		return nil, fmt.Errorf("failed to parse sweep spec %s: %w", filename, err)
+	}
+
+	if err := spec.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid sweep spec %s: %w", filename, err)
+	}
+
+	return spec, nil
+}
+
+// Validate проверяет описание перебора
+func (s *Spec) Validate() error {
+	if s.Duration <= 0 {
+		return fmt.Errorf("duration must be positive, got %f", s.Duration)
+	}
+	if s.Interval <= 0 {
+		return fmt.Errorf("interval must be positive, got %d", s.Interval)
+	}
+
+	names := make(map[string]bool, len(s.Parameters))
+	for _, parameter := range s.Parameters {
+		if names[parameter.Name] {
+			return fmt.Errorf("parameter %s listed twice", parameter.Name)
+		}
+		names[parameter.Name] = true
+
+		if _, err := parameter.Points(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Points возвращает значения параметра в порядке перебора
+func (p ParameterRange) Points() ([]float64, error) {
+	if !strings.HasPrefix(p.Name, PopulationPrefix) && !strings.HasPrefix(p.Name, BalancePrefix) {
+		return nil, fmt.Errorf("parameter %q must start with %s or %s", p.Name, PopulationPrefix, BalancePrefix)
+	}
+
+	if len(p.Values) > 0 {
+		return p.Values, nil
+	}
+
+	if p.Step <= 0 {
+		return nil, fmt.Errorf("parameter %s: step must be positive, got %f", p.Name, p.Step)
+	}
+	if p.To < p.From {
+		return nil, fmt.Errorf("parameter %s: range end %f before start %f", p.Name, p.To, p.From)
+	}
+
+	count := int(math.Floor((p.To-p.From)/p.Step+stepEpsilon)) + 1
+	points := make([]float64, count)
+	for i := range points {
+		points[i] = p.From + float64(i)*p.Step
+	}
+	return points, nil
+}
+
+// Combinations возвращает декартово произведение значений параметров
+// Последний параметр меняется быстрее всех; без параметров - одна пустая комбинация (базовая точка)
+func (s *Spec) Combinations() ([]Combination, error) {
+	combinations := []Combination{{}}
+
+	for _, parameter := range s.Parameters {
+		points, err := parameter.Points()
+		if err != nil {
+			return nil, err
+		}
+
+		expanded := make([]Combination, 0, len(combinations)*len(points))
+		for _, base := range combinations {
+			for _, value := range points {
+				combination := make(Combination, len(base)+1)
+				for name, baseValue := range base {
+					combination[name] = baseValue
+				}
+				combination[parameter.Name] = value
+				expanded = append(expanded, combination)
+			}
+		}
+		combinations = expanded
+	}
+
+	return combinations, nil
+}
+
+// Apply создаёт копии конфигурации и профиля баланса со значениями комбинации
+// Исходные cfg и balance не меняются (nil balance - профиль по умолчанию)
+func (c Combination) Apply(
+	cfg *config.Config,
+	balance *simulation.BalanceProfile,
+) (*config.Config, *simulation.BalanceProfile, error) {
+	if balance == nil {
+		balance = simulation.DefaultBalanceProfile()
+	}
+
+	population := make(map[string]float64)
+	balanceValues := make(map[string]float64)
+	for name, value := range c {
+		switch {
+		case strings.HasPrefix(name, PopulationPrefix):
+			population[strings.TrimPrefix(name, PopulationPrefix)] = value
+		case strings.HasPrefix(name, BalancePrefix):
+			balanceValues[strings.TrimPrefix(name, BalancePrefix)] = value
+		default:
+			return nil, nil, fmt.Errorf("unknown parameter %q", name)
+		}
+	}
+
+	appliedConfig := &config.Config{}
+	if err := overrideYAML(cfg, appliedConfig, "population", population); err != nil {
+		return nil, nil, err
+	}
+	if err := appliedConfig.Validate(); err != nil {
+		return nil, nil, fmt.Errorf("invalid config for %v: %w", c, err)
+	}
+
+	appliedBalance := &simulation.BalanceProfile{}
+	if err := overrideYAML(balance, appliedBalance, "", balanceValues); err != nil {
+		return nil, nil, err
+	}
+	if err := appliedBalance.Validate(); err != nil {
+		return nil, nil, fmt.Errorf("invalid balance for %v: %w", c, err)
+	}
+
+	return appliedConfig, appliedBalance, nil
+}
+
+// overrideYAML копирует source в target через YAML, подменяя значения по путям ключей
+// Путь задаётся теми же ключами, что в файлах конфигурации, поэтому новые параметры не требуют кода
+func overrideYAML(source, target interface{}, root string, values map[string]float64) error {
+	data, err := yaml.Marshal(source)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %T: %w", source, err)
+	}
+
+	var tree map[string]interface{}
+	if err := yaml.Unmarshal(data, &tree); err != nil {
+		return fmt.Errorf("failed to decode %T: %w", source, err)
+	}
+
+	for path, value := range values {
+		keys := strings.Split(path, ".")
+		if root != "" {
+			keys = append([]string{root}, keys...)
+		}
+		if err := setPath(tree, keys, value); err != nil {
+			return err
+		}
+	}
+
+	if data, err = yaml.Marshal(tree); err != nil {
+		return fmt.Errorf("failed to marshal %T: %w", target, err)
+	}
+	if err := yaml.Unmarshal(data, target); err != nil {
+		return fmt.Errorf("failed to apply %v: %w", values, err)
+	}
+
+	return verifyApplied(target, root, values)
+}
+
+// verifyApplied проверяет что значения дошли до полей без искажений
+// YAML молча отбрасывает дробную часть для целых полей, поэтому 2.5 для population.rabbits - ошибка
+func verifyApplied(target interface{}, root string, values map[string]float64) error {
+	data, err := yaml.Marshal(target)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %T: %w", target, err)
+	}
+
+	var tree map[string]interface{}
+	if err := yaml.Unmarshal(data, &tree); err != nil {
+		return fmt.Errorf("failed to decode %T: %w", target, err)
+	}
+
+	for path, value := range values {
+		var node interface{} = tree
+		keys := strings.Split(path, ".")
+		if root != "" {
+			keys = append([]string{root}, keys...)
+		}
+		for _, key := range keys {
+			section, _ := node.(map[string]interface{})
+			node = section[key]
+		}
+
+		var applied float64
+		switch number := node.(type) {
+		case int:
+			applied = float64(number)
+		case float64:
+			applied = number
+		}
+		if math.Abs(applied-value) > appliedTolerance*math.Max(1, math.Abs(value)) {
+			return fmt.Errorf("parameter %s cannot hold value %g (got %g)", strings.Join(keys, "."), value, applied)
+		}
+	}
+
+	return nil
+}
+
+// setPath заменяет существующее значение по пути ключей (неизвестный путь - ошибка, а не новый ключ)
+func setPath(tree map[string]interface{}, keys []string, value float64) error {
+	path := strings.Join(keys, ".")
+	node := tree
+
+	for i, key := range keys {
+		current, exists := node[key]
+		if !exists {
+			return fmt.Errorf("unknown parameter %s", path)
+		}
+
+		if i == len(keys)-1 {
+			switch current.(type) {
+			case map[string]interface{}, []interface{}:
+				return fmt.Errorf("parameter %s is a section, not a value", path)
+			}
+			// Целые значения записываются целыми: их принимают и int, и float поля
+			if value == math.Trunc(value) {
+				node[key] = int64(value)
+			} else {
+				node[key] = value
+			}
+			return nil
+		}
+
+		next, isSection := current.(map[string]interface{})
+		if !isSection {
+			return fmt.Errorf("unknown parameter %s", path)
+		}
+		node = next
+	}
+
+	return fmt.Errorf("empty parameter name")
+}
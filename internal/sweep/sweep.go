@@ -0,0 +1,197 @@
+package sweep
+
+import (
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/aiseeq/savanna/config"
+	"github.com/aiseeq/savanna/internal/batch"
+	"github.com/aiseeq/savanna/internal/core"
+	"github.com/aiseeq/savanna/internal/simulation"
+)
+
+// Options базовые настройки перебора
+type Options struct {
+	Config   *config.Config             // Базовая конфигурация мира (комбинации меняют population)
+	Balance  *simulation.BalanceProfile // Базовый профиль баланса (nil - значения по умолчанию)
+	TimeStep float32                    // Фиксированный шаг (секунды)
+	Parallel int                        // Количество параллельных прогонов
+}
+
+// Report машиночитаемый отчёт перебора
+type Report struct {
+	Duration     float64             `json:"duration"` // Целевая длительность (секунды)
+	Seeds        []int64             `json:"seeds"`
+	Parameters   []string            `json:"parameters"`
+	Combinations []CombinationResult `json:"combinations"`
+}
+
+// CombinationResult итоги одной комбинации параметров по всем seed
+type CombinationResult struct {
+	Parameters   Combination    `json:"parameters"`
+	Survived     int            `json:"survived"`      // Прогонов где оба вида дожили до конца
+	SurvivalRate float64        `json:"survival_rate"` // Доля выживших прогонов
+	Stable       bool           `json:"stable"`        // Оба вида выжили во всех прогонах
+	Rabbits      SpeciesSummary `json:"rabbits"`
+	Wolves       SpeciesSummary `json:"wolves"`
+	Runs         []RunMetrics   `json:"runs"`
+}
+
+// SpeciesSummary средние показатели вида по прогонам комбинации
+type SpeciesSummary struct {
+	ExtinctRuns        int     `json:"extinct_runs"`
+	MeanExtinctionTime float32 `json:"mean_extinction_time,omitempty"` // Среди вымерших прогонов
+	MeanFinal          float64 `json:"mean_final"`
+	OscillatingRuns    int     `json:"oscillating_runs"`         // Прогонов с хотя бы одним полным циклом
+	MeanPeriod         float32 `json:"mean_period,omitempty"`    // Среди колеблющихся прогонов
+	MeanAmplitude      float32 `json:"mean_amplitude,omitempty"` // Среди колеблющихся прогонов
+}
+
+// job один прогон перебора
+type job struct {
+	combination, seed int
+}
+
+// Run выполняет прогоны всех комбинаций и seed в общем пуле горутин
+// Результаты упорядочены как Combinations() и seeds независимо от порядка завершения
+func Run(spec *Spec, seeds []int64, opts Options) (*Report, error) {
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+	if opts.Config == nil {
+		return nil, fmt.Errorf("config is required")
+	}
+	if opts.TimeStep <= 0 {
+		opts.TimeStep = batch.DefaultTimeStep
+	}
+	if opts.Parallel < 1 {
+		opts.Parallel = 1
+	}
+
+	combinations, err := spec.Combinations()
+	if err != nil {
+		return nil, err
+	}
+
+	// Конфигурации готовятся заранее: ошибка параметров обнаруживается до долгих прогонов
+	runConfigs := make([]batch.RunConfig, len(combinations))
+	for i, combination := range combinations {
+		cfg, balance, err := combination.Apply(opts.Config, opts.Balance)
+		if err != nil {
+			return nil, err
+		}
+		runConfigs[i] = batch.RunConfig{
+			Config:   cfg,
+			Balance:  balance,
+			Ticks:    int(math.Round(spec.Duration / float64(opts.TimeStep))),
+			Interval: spec.Interval,
+			TimeStep: opts.TimeStep,
+		}
+	}
+
+	prey, predator := batch.SpeciesName(core.TypeRabbit), batch.SpeciesName(core.TypeWolf)
+	metrics := make([][]RunMetrics, len(combinations))
+	errs := make([][]error, len(combinations))
+	for i := range combinations {
+		metrics[i] = make([]RunMetrics, len(seeds))
+		errs[i] = make([]error, len(seeds))
+	}
+
+	jobs := make(chan job)
+	var wg sync.WaitGroup
+	for worker := 0; worker < min(opts.Parallel, len(combinations)*len(seeds)); worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				result, err := batch.Run(seeds[j.seed], runConfigs[j.combination])
+				metrics[j.combination][j.seed] = AnalyzeRun(result, prey, predator)
+				errs[j.combination][j.seed] = err
+			}
+		}()
+	}
+
+	for c := range combinations {
+		for s := range seeds {
+			jobs <- job{combination: c, seed: s}
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	report := &Report{
+		Duration:     spec.Duration,
+		Seeds:        seeds,
+		Parameters:   make([]string, 0, len(spec.Parameters)),
+		Combinations: make([]CombinationResult, len(combinations)),
+	}
+	for _, parameter := range spec.Parameters {
+		report.Parameters = append(report.Parameters, parameter.Name)
+	}
+
+	for c, combination := range combinations {
+		for s, err := range errs[c] {
+			if err != nil {
+				return nil, fmt.Errorf("combination %v, seed %d: %w", combination, seeds[s], err)
+			}
+		}
+		report.Combinations[c] = summarize(combination, metrics[c])
+	}
+
+	return report, nil
+}
+
+// summarize сводит показатели прогонов одной комбинации
+func summarize(combination Combination, runs []RunMetrics) CombinationResult {
+	result := CombinationResult{Parameters: combination, Runs: runs}
+
+	rabbits := make([]SpeciesMetrics, len(runs))
+	wolves := make([]SpeciesMetrics, len(runs))
+	for i, run := range runs {
+		if run.Survived {
+			result.Survived++
+		}
+		rabbits[i], wolves[i] = run.Rabbits, run.Wolves
+	}
+
+	if len(runs) > 0 {
+		result.SurvivalRate = float64(result.Survived) / float64(len(runs))
+		result.Stable = result.Survived == len(runs)
+	}
+	result.Rabbits = summarizeSpecies(rabbits)
+	result.Wolves = summarizeSpecies(wolves)
+	return result
+}
+
+// summarizeSpecies усредняет показатели вида (времена вымирания и циклы - только где они есть)
+func summarizeSpecies(runs []SpeciesMetrics) SpeciesSummary {
+	var summary SpeciesSummary
+	var extinctionTotal, periodTotal, amplitudeTotal float32
+	var finalTotal int
+
+	for _, run := range runs {
+		finalTotal += run.Final
+		if run.Extinct {
+			summary.ExtinctRuns++
+			extinctionTotal += run.ExtinctionTime
+		}
+		if run.Cycles > 0 {
+			summary.OscillatingRuns++
+			periodTotal += run.Period
+			amplitudeTotal += run.Amplitude
+		}
+	}
+
+	if len(runs) > 0 {
+		summary.MeanFinal = float64(finalTotal) / float64(len(runs))
+	}
+	if summary.ExtinctRuns > 0 {
+		summary.MeanExtinctionTime = extinctionTotal / float32(summary.ExtinctRuns)
+	}
+	if summary.OscillatingRuns > 0 {
+		summary.MeanPeriod = periodTotal / float32(summary.OscillatingRuns)
+		summary.MeanAmplitude = amplitudeTotal / float32(summary.OscillatingRuns)
+	}
+	return summary
+}
@@ -0,0 +1,155 @@
+package sweep
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aiseeq/savanna/config"
+)
+
+func TestSpec_Combinations(t *testing.T) {
+	spec := &Spec{Duration: 10, Interval: 60, Parameters: []ParameterRange{
+		{Name: "population.rabbits", From: 10, To: 30, Step: 10},
+		{Name: "balance.vegetation.grass_growth_rate", Values: []float64{0.5, 1}},
+	}}
+
+	combinations, err := spec.Combinations()
+	if err != nil {
+		t.Fatalf("Combinations failed: %v", err)
+	}
+	if len(combinations) != 6 {
+		t.Fatalf("Expected 3x2 combinations, got %d", len(combinations))
+	}
+
+	expected := Combination{"population.rabbits": 20, "balance.vegetation.grass_growth_rate": 1}
+	if !reflect.DeepEqual(combinations[3], expected) {
+		t.Errorf("Last parameter should change fastest: got %v", combinations[3])
+	}
+
+	invalid := []ParameterRange{
+		{Name: "rabbits", Values: []float64{1}},
+		{Name: "population.rabbits", From: 10, To: 5, Step: 1},
+		{Name: "population.rabbits", From: 1, To: 5},
+	}
+	for _, parameter := range invalid {
+		spec := &Spec{Duration: 10, Interval: 60, Parameters: []ParameterRange{parameter}}
+		if err := spec.Validate(); err == nil {
+			t.Errorf("Expected validation error for %+v", parameter)
+		}
+	}
+}
+
+func TestCombination_Apply(t *testing.T) {
+	cfg := config.LoadDefaultConfig()
+
+	applied, balance, err := Combination{
+		"population.wolves":                    2,
+		"balance.animals.wolf.attack_damage":   20,
+		"balance.vegetation.grass_growth_rate": 0.75,
+	}.Apply(cfg, nil)
+	if err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+
+	if applied.Population.Wolves != 2 || applied.Population.Rabbits != cfg.Population.Rabbits {
+		t.Errorf("Only wolves should change, got %+v", applied.Population)
+	}
+	if *balance.Animals["wolf"].AttackDamage != 20 || balance.Vegetation.GrassGrowthRate != 0.75 {
+		t.Errorf("Balance values should be applied, got %d, %+v", *balance.Animals["wolf"].AttackDamage, balance.Vegetation)
+	}
+	if cfg.Population.Wolves != config.DefaultWolves {
+		t.Error("Apply should not modify the base config")
+	}
+
+	for _, unknown := range []string{"population.dragons", "balance.vegetation", "balance.animals.wolf.wings"} {
+		if _, _, err := (Combination{unknown: 1}).Apply(cfg, nil); err == nil {
+			t.Errorf("Expected error for %s", unknown)
+		}
+	}
+	if _, _, err := (Combination{"population.rabbits": 2.5}).Apply(cfg, nil); err == nil {
+		t.Error("Fractional value for an integer parameter should fail")
+	}
+}
+
+func TestAnalyzeSpecies_Oscillation(t *testing.T) {
+	// Синусоида с периодом 20 секунд вокруг 30 особей, замер раз в секунду
+	// Первый подъём не считается пересечением: ряд начинается на среднем, а не ниже него
+	series := make([]populationSample, 101)
+	for i := range series {
+		count := 30 + 10*math.Sin(2*math.Pi*float64(i)/20)
+		series[i] = populationSample{time: float32(i), count: int(math.Round(count))}
+	}
+
+	metrics := analyzeSpecies(series)
+	if metrics.Extinct {
+		t.Error("Species should not be extinct")
+	}
+	if metrics.Cycles != 3 || math.Abs(float64(metrics.Period)-20) > 0.5 {
+		t.Errorf("Expected 3 cycles with 20s period, got %d cycles, %.2fs", metrics.Cycles, metrics.Period)
+	}
+	if math.Abs(float64(metrics.Amplitude)-10) > 0.5 {
+		t.Errorf("Expected amplitude ~10, got %.2f", metrics.Amplitude)
+	}
+}
+
+func TestAnalyzeSpecies_Extinction(t *testing.T) {
+	series := []populationSample{{0, 5}, {1, 3}, {2, 1}, {3, 0}, {4, 0}}
+
+	metrics := analyzeSpecies(series)
+	if !metrics.Extinct || metrics.ExtinctionTime != 3 {
+		t.Errorf("Expected extinction at 3s, got %+v", metrics)
+	}
+	if metrics.Cycles != 0 || metrics.Min != 0 || metrics.Max != 5 {
+		t.Errorf("Unexpected metrics: %+v", metrics)
+	}
+}
+
+func TestRun_ParallelMatchesSerial(t *testing.T) {
+	cfg := config.LoadDefaultConfig()
+	cfg.World.Size = 24
+	cfg.Population.Rabbits = 12
+	cfg.Species.Dir = ""
+
+	spec := &Spec{Duration: 5, Interval: 60, Parameters: []ParameterRange{
+		{Name: "population.wolves", Values: []float64{0, 2}},
+	}}
+	seeds := []int64{1, 2}
+
+	serial, err := Run(spec, seeds, Options{Config: cfg, Parallel: 1})
+	if err != nil {
+		t.Fatalf("Serial sweep failed: %v", err)
+	}
+	parallel, err := Run(spec, seeds, Options{Config: cfg, Parallel: 4})
+	if err != nil {
+		t.Fatalf("Parallel sweep failed: %v", err)
+	}
+	if !reflect.DeepEqual(serial, parallel) {
+		t.Error("Parallel sweep should match serial sweep")
+	}
+
+	// Без волков комбинация не может быть устойчивой
+	withoutWolves := serial.Combinations[0]
+	if withoutWolves.Stable || withoutWolves.Wolves.ExtinctRuns != len(seeds) || withoutWolves.Wolves.MeanExtinctionTime != 0 {
+		t.Errorf("Combination without wolves should report wolves extinct at start, got %+v", withoutWolves.Wolves)
+	}
+
+	var jsonOut, table bytes.Buffer
+	if err := WriteJSON(&jsonOut, serial); err != nil {
+		t.Fatalf("WriteJSON failed: %v", err)
+	}
+	var decoded Report
+	if err := json.Unmarshal(jsonOut.Bytes(), &decoded); err != nil || len(decoded.Combinations) != 2 {
+		t.Errorf("JSON report should round-trip, got %d combinations (err %v)", len(decoded.Combinations), err)
+	}
+
+	if err := WriteSummary(&table, serial); err != nil {
+		t.Fatalf("WriteSummary failed: %v", err)
+	}
+	if lines := strings.Split(strings.TrimSpace(table.String()), "\n"); len(lines) != 3 {
+		t.Errorf("Summary should have header and a row per combination, got %d lines", len(lines))
+	}
+}
@@ -144,7 +144,11 @@ func populateDebugWorld(
 ) (wolves, rabbits []core.EntityID) {
 	// Размещаем животных
 	popGen := generator.NewPopulationGenerator(cfg, terrain)
-	placements := popGen.Generate()
+	placements, err := popGen.Generate()
+	if err != nil {
+		fmt.Printf("Ошибка размещения животных: %v\n", err)
+		return nil, nil
+	}
 
 	for _, placement := range placements {
 		switch placement.Type {
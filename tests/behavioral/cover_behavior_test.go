@@ -0,0 +1,161 @@
+package behavioral
+
+import (
+	"testing"
+
+	"github.com/aiseeq/savanna/internal/constants"
+	"github.com/aiseeq/savanna/internal/core"
+	"github.com/aiseeq/savanna/internal/generator"
+	"github.com/aiseeq/savanna/internal/simulation"
+)
+
+// CoverScenario сценарий укрытия зайца в кустах от волка (Given-When-Then)
+type CoverScenario struct {
+	world          *core.World
+	terrain        *generator.Terrain
+	coverSystem    *simulation.CoverSystem
+	behaviorSystem *simulation.AnimalBehaviorSystem
+	movementSystem *simulation.MovementSystem
+	rabbit         core.EntityID
+	wolf           core.EntityID
+	t              *testing.T
+}
+
+// newCoverScenario создаёт сценарий на травяной равнине с кустами в указанных тайлах
+func newCoverScenario(t *testing.T, bushes ...[2]int) *CoverScenario {
+	t.Helper()
+
+	terrain := newGrassPlain(t, scenarioPlainSize)
+	for _, bush := range bushes {
+		terrain.SetTileType(bush[0], bush[1], generator.TileBush)
+	}
+
+	vegetationSystem := simulation.NewVegetationSystem(terrain)
+	s := &CoverScenario{
+		world:          newScenarioWorld(),
+		terrain:        terrain,
+		coverSystem:    simulation.NewCoverSystem(vegetationSystem),
+		behaviorSystem: simulation.NewAnimalBehaviorSystem(vegetationSystem),
+		movementSystem: simulation.NewMovementSystem(scenarioWorldSize, scenarioWorldSize),
+		t:              t,
+	}
+	s.movementSystem.SetTerrain(vegetationSystem)
+	return s
+}
+
+// Given методы настраивают начальное состояние
+
+func (s *CoverScenario) GivenRabbitAtTile(tileX, tileY float32) *CoverScenario {
+	x, y := tileCenter(tileX, tileY)
+	s.rabbit = simulation.CreateAnimal(s.world, core.TypeRabbit, x, y)
+	return s
+}
+
+func (s *CoverScenario) GivenWolfAtTile(tileX, tileY float32) *CoverScenario {
+	x, y := tileCenter(tileX, tileY)
+	if s.wolf == 0 {
+		s.wolf = simulation.CreateAnimal(s.world, core.TypeWolf, x, y)
+		s.world.SetSatiation(s.wolf, core.Satiation{Value: 100}) // Сытый волк стоит на месте и не охотится
+		return s
+	}
+	s.world.SetPosition(s.wolf, core.NewPosition(x, y))
+	return s
+}
+
+// When методы выполняют действия
+
+func (s *CoverScenario) WhenOneTick() *CoverScenario {
+	s.world.Update(scenarioTimeStep)
+	s.coverSystem.Update(s.world, scenarioTimeStep)
+	s.world.Sync() // Скрытность меняется отложенными командами, как в SystemManager
+	s.behaviorSystem.Update(s.world, scenarioTimeStep)
+	return s
+}
+
+func (s *CoverScenario) WhenTimePassesFor(seconds float32) *CoverScenario {
+	runFor(seconds, func() {
+		s.WhenOneTick()
+		s.movementSystem.Update(s.world, scenarioTimeStep)
+	})
+	return s
+}
+
+// Then методы проверяют результат
+
+func (s *CoverScenario) rabbitTile() (tileX, tileY int) {
+	pos, _ := s.world.GetPosition(s.rabbit)
+	x, y := constants.PositionToTiles(pos.X, pos.Y)
+	return int(x), int(y)
+}
+
+func (s *CoverScenario) ThenRabbitHidesInBush() *CoverScenario {
+	s.t.Helper()
+	tileX, tileY := s.rabbitTile()
+	if s.terrain.GetTileType(tileX, tileY) != generator.TileBush {
+		s.t.Errorf("Rabbit should hide in a bush, it is on tile (%d, %d)", tileX, tileY)
+	}
+	if concealment, hidden := s.world.GetConcealment(s.rabbit); !hidden || concealment.Visibility != simulation.CoverVisibilityInside {
+		s.t.Errorf("Rabbit in a bush should be concealed, got %+v (hidden %v)", concealment, hidden)
+	}
+	return s
+}
+
+func (s *CoverScenario) ThenRabbitFreezes() *CoverScenario {
+	s.t.Helper()
+	if velocity, _ := s.world.GetVelocity(s.rabbit); velocity.X != 0 || velocity.Y != 0 {
+		s.t.Errorf("Hidden rabbit should freeze, velocity %+v", velocity)
+	}
+	return s
+}
+
+func (s *CoverScenario) ThenRunsAwayFromWolf() *CoverScenario {
+	s.t.Helper()
+	rabbitPos, _ := s.world.GetPosition(s.rabbit)
+	wolfPos, _ := s.world.GetPosition(s.wolf)
+	velocity, _ := s.world.GetVelocity(s.rabbit)
+	away := rabbitPos.Sub(wolfPos).Normalize()
+	if speed := velocity.Length(); speed == 0 || away.Dot(velocity)/speed < 0.9 {
+		s.t.Errorf("Rabbit should run straight away from the wolf, velocity %+v", velocity)
+	}
+	return s
+}
+
+func TestCover_RabbitFleesToNearbyBushAndHides(t *testing.T) {
+	t.Parallel()
+
+	// Волк слева, куст в двух тайлах над зайцем: заяц бежит не прочь от волка, а в куст
+	newCoverScenario(t, [2]int{8, 8}).
+		GivenRabbitAtTile(8, 10).
+		GivenWolfAtTile(5.5, 10).
+		WhenTimePassesFor(2).
+		ThenRabbitHidesInBush().
+		ThenRabbitFreezes()
+}
+
+func TestCover_RabbitDoesNotRunToBushPastPredator(t *testing.T) {
+	t.Parallel()
+
+	// Куст за спиной волка - заяц к нему не бежит
+	newCoverScenario(t, [2]int{5, 10}).
+		GivenRabbitAtTile(8, 10).
+		GivenWolfAtTile(6, 10).
+		WhenOneTick().
+		ThenRunsAwayFromWolf()
+}
+
+func TestCover_HiddenRabbitBoltsWhenDiscovered(t *testing.T) {
+	t.Parallel()
+
+	// Волк в 2.5 тайлах видит спрятавшегося зайца только с 1.5 тайлов (30% зрения) - заяц замирает
+	s := newCoverScenario(t, [2]int{10, 10}).
+		GivenRabbitAtTile(10, 10).
+		GivenWolfAtTile(7.5, 10).
+		WhenOneTick().
+		WhenOneTick().
+		ThenRabbitFreezes()
+
+	// Волк подошёл вплотную и заметил зайца - тот убегает сквозь куст
+	s.GivenWolfAtTile(9, 10).
+		WhenOneTick().
+		ThenRunsAwayFromWolf()
+}
@@ -0,0 +1,183 @@
+package behavioral
+
+import (
+	"math"
+	"testing"
+
+	"github.com/aiseeq/savanna/internal/core"
+	"github.com/aiseeq/savanna/internal/simulation"
+)
+
+// HerdScenario сценарий стадного поведения зайцев (Given-When-Then)
+type HerdScenario struct {
+	world          *core.World
+	behaviorSystem *simulation.AnimalBehaviorSystem
+	movementSystem *simulation.MovementSystem
+	rabbits        []core.EntityID
+	wolf           core.EntityID
+	fleeing        map[core.EntityID]bool
+	t              *testing.T
+}
+
+// newHerdScenario создаёт сценарий на травяной равнине без препятствий
+func newHerdScenario(t *testing.T) *HerdScenario {
+	t.Helper()
+
+	terrain := newGrassPlain(t, scenarioPlainSize)
+
+	s := &HerdScenario{
+		world:          newScenarioWorld(),
+		behaviorSystem: simulation.NewAnimalBehaviorSystem(simulation.NewVegetationSystem(terrain)),
+		movementSystem: simulation.NewMovementSystem(scenarioWorldSize, scenarioWorldSize),
+		fleeing:        make(map[core.EntityID]bool),
+		t:              t,
+	}
+	core.Subscribe(s.world.Events(), func(event core.Fleeing) {
+		s.fleeing[event.Entity] = true
+	})
+	return s
+}
+
+// Given методы настраивают начальное состояние
+
+func (s *HerdScenario) GivenCalmRabbitsAt(positions ...core.Position) *HerdScenario {
+	for _, pos := range positions {
+		s.rabbits = append(s.rabbits, simulation.CreateAnimal(s.world, core.TypeRabbit, pos.X, pos.Y))
+	}
+	return s
+}
+
+func (s *HerdScenario) GivenWolfAt(x, y float32) *HerdScenario {
+	s.wolf = simulation.CreateAnimal(s.world, core.TypeWolf, x, y)
+	s.world.SetSatiation(s.wolf, core.Satiation{Value: 100}) // Сытый волк стоит на месте и не охотится
+	return s
+}
+
+func (s *HerdScenario) GivenRabbitsWithoutHerd() *HerdScenario {
+	for _, rabbit := range s.rabbits {
+		config, _ := s.world.GetAnimalConfig(rabbit)
+		config.HerdRadius, config.HearingRange = 0, 0
+		s.world.SetAnimalConfig(rabbit, config)
+	}
+	return s
+}
+
+// When методы выполняют действия
+
+func (s *HerdScenario) WhenOneTick() *HerdScenario {
+	s.world.Update(scenarioTimeStep)
+	s.behaviorSystem.Update(s.world, scenarioTimeStep)
+	s.world.DispatchEvents()
+	return s
+}
+
+func (s *HerdScenario) WhenTimePassesFor(seconds float32) *HerdScenario {
+	runFor(seconds, func() {
+		s.WhenOneTick()
+		s.movementSystem.Update(s.world, scenarioTimeStep)
+	})
+	return s
+}
+
+// Then методы проверяют результат
+
+func (s *HerdScenario) ThenFleeing(expected ...bool) *HerdScenario {
+	s.t.Helper()
+	for i, rabbit := range s.rabbits {
+		if s.fleeing[rabbit] != expected[i] {
+			s.t.Errorf("Rabbit %d: fleeing %v, expected %v", i, s.fleeing[rabbit], expected[i])
+		}
+	}
+	return s
+}
+
+func (s *HerdScenario) ThenRunsAwayFromWolf(index int) *HerdScenario {
+	s.t.Helper()
+	rabbitPos, _ := s.world.GetPosition(s.rabbits[index])
+	wolfPos, _ := s.world.GetPosition(s.wolf)
+	velocity, _ := s.world.GetVelocity(s.rabbits[index])
+	if away := rabbitPos.Sub(wolfPos); away.Dot(velocity) <= 0 {
+		s.t.Errorf("Rabbit %d should run away from the wolf, velocity %+v", index, velocity)
+	}
+	return s
+}
+
+// spread средняя дистанция зайцев до центра группы (пиксели)
+func (s *HerdScenario) spread() float32 {
+	var centerX, centerY float32
+	for _, rabbit := range s.rabbits {
+		pos, _ := s.world.GetPosition(rabbit)
+		centerX += pos.X
+		centerY += pos.Y
+	}
+	n := float32(len(s.rabbits))
+	center := core.NewPosition(centerX/n, centerY/n)
+
+	var total float32
+	for _, rabbit := range s.rabbits {
+		pos, _ := s.world.GetPosition(rabbit)
+		total += pos.DistanceTo(center)
+	}
+	return total / n
+}
+
+// herdPositions кольцо зайцев радиусом 2 тайла вокруг центра карты
+func herdPositions(count int) []core.Position {
+	positions := make([]core.Position, count)
+	for i := range positions {
+		angle := 2 * math.Pi * float64(i) / float64(count)
+		positions[i] = core.NewPosition(320+64*float32(math.Cos(angle)), 320+64*float32(math.Sin(angle)))
+	}
+	return positions
+}
+
+func TestHerd_AlarmSpreadsToHerdMatesWithinHearing(t *testing.T) {
+	t.Parallel()
+
+	// Заяц 0 видит волка (зрение 3 тайла), заяц 1 слышит зайца 0 (слух 4 тайла), но волка не видит.
+	// Заяц 2 слышит только зайца 1 - услышавшие тревогу её не передают. Заяц 3 далеко от всех
+	newHerdScenario(t).
+		GivenWolfAt(360, 320).
+		GivenCalmRabbitsAt(
+			core.NewPosition(280, 320), core.NewPosition(180, 320),
+			core.NewPosition(80, 320), core.NewPosition(280, 40),
+		).
+		WhenOneTick().
+		ThenFleeing(true, true, false, false).
+		ThenRunsAwayFromWolf(0).
+		ThenRunsAwayFromWolf(1)
+}
+
+func TestHerd_SpeciesWithoutHearingIgnoresAlarm(t *testing.T) {
+	t.Parallel()
+
+	newHerdScenario(t).
+		GivenWolfAt(360, 320).
+		GivenCalmRabbitsAt(core.NewPosition(280, 320), core.NewPosition(180, 320)).
+		GivenRabbitsWithoutHerd().
+		WhenOneTick().
+		ThenFleeing(true, false)
+}
+
+func TestHerd_RabbitsStayTogether(t *testing.T) {
+	t.Parallel()
+
+	// Одинаковый старт: со стадным поведением группа остаётся плотнее, чем без него
+	herd := newHerdScenario(t).
+		GivenCalmRabbitsAt(herdPositions(8)...).
+		WhenTimePassesFor(30)
+	loners := newHerdScenario(t).
+		GivenCalmRabbitsAt(herdPositions(8)...).
+		GivenRabbitsWithoutHerd().
+		WhenTimePassesFor(30)
+
+	herdSpread, lonerSpread := herd.spread(), loners.spread()
+	if herdSpread >= lonerSpread {
+		t.Errorf("Herd should stay tighter than loners: herd spread %.1f, loners %.1f", herdSpread, lonerSpread)
+	}
+
+	// Отталкивание не даёт стаду слипнуться в точку
+	if herdSpread < 16 {
+		t.Errorf("Herd should not collapse, spread %.1f", herdSpread)
+	}
+}
@@ -0,0 +1,186 @@
+package behavioral
+
+import (
+	"testing"
+
+	"github.com/aiseeq/savanna/internal/core"
+	"github.com/aiseeq/savanna/internal/generator"
+	"github.com/aiseeq/savanna/internal/pipeline"
+	"github.com/aiseeq/savanna/internal/simulation"
+)
+
+// OmnivoreScenario сценарий бородавочника, выбирающего между травой и тушей (Given-When-Then)
+// Использует полный конвейер: поедание травы и туш идёт по кадрам анимации
+type OmnivoreScenario struct {
+	world     *core.World
+	terrain   *generator.Terrain
+	pipeline  *pipeline.Pipeline
+	warthog   core.EntityID
+	carcass   core.EntityID
+	wolf      core.EntityID
+	satiation float32
+	t         *testing.T
+}
+
+// newOmnivoreScenario создаёт сценарий на равнине без травы с видами из config/species
+func newOmnivoreScenario(t *testing.T) *OmnivoreScenario {
+	t.Helper()
+
+	loadSpecies(t)
+
+	terrain := newGrassPlain(t, scenarioPlainSize)
+	for y := 0; y < terrain.Size; y++ {
+		for x := 0; x < terrain.Size; x++ {
+			terrain.SetGrassAmount(x, y, 0)
+		}
+	}
+
+	return &OmnivoreScenario{
+		world:    newScenarioWorld(),
+		terrain:  terrain,
+		pipeline: pipeline.New(terrain, scenarioWorldSize, scenarioWorldSize),
+		t:        t,
+	}
+}
+
+// Given методы настраивают начальное состояние
+
+func (s *OmnivoreScenario) GivenHungryWarthogAtTile(tileX, tileY, satiation float32) *OmnivoreScenario {
+	warthog, ok := core.AnimalTypeByName("warthog")
+	if !ok {
+		s.t.Fatal("Warthog species should be registered")
+	}
+	x, y := tileCenter(tileX, tileY)
+	s.warthog = simulation.CreateAnimal(s.world, warthog, x, y)
+	s.world.SetSatiation(s.warthog, core.Satiation{Value: satiation})
+	s.satiation = satiation
+	return s
+}
+
+func (s *OmnivoreScenario) GivenGrassAround(tileX, tileY, radius int) *OmnivoreScenario {
+	for y := tileY - radius; y <= tileY+radius; y++ {
+		for x := tileX - radius; x <= tileX+radius; x++ {
+			s.terrain.SetGrassAmount(x, y, simulation.GrassMaxAmount)
+		}
+	}
+	return s
+}
+
+func (s *OmnivoreScenario) GivenRabbitCorpseAtTile(tileX, tileY float32) *OmnivoreScenario {
+	x, y := tileCenter(tileX, tileY)
+	rabbit := simulation.CreateAnimal(s.world, core.TypeRabbit, x, y)
+	s.carcass = simulation.CreateCorpseAndGetID(s.world, rabbit)
+	return s
+}
+
+func (s *OmnivoreScenario) GivenHungryWolfAtTile(tileX, tileY float32) *OmnivoreScenario {
+	x, y := tileCenter(tileX, tileY)
+	s.wolf = simulation.CreateAnimal(s.world, core.TypeWolf, x, y)
+	s.world.SetSatiation(s.wolf, core.Satiation{Value: 10})
+	return s
+}
+
+// When методы выполняют действия
+
+func (s *OmnivoreScenario) tick() {
+	s.pipeline.Update(s.world, scenarioTimeStep)
+}
+
+// WhenWarthogStartsEating прогоняет симуляцию, пока бородавочник не начнёт есть (не дольше maxSeconds)
+func (s *OmnivoreScenario) WhenWarthogStartsEating(maxSeconds float32) *OmnivoreScenario {
+	runUntil(maxSeconds, func() bool { return s.world.HasComponent(s.warthog, core.MaskEatingState) }, s.tick)
+	return s
+}
+
+func (s *OmnivoreScenario) WhenTimePassesFor(seconds float32) *OmnivoreScenario {
+	runFor(seconds, s.tick)
+	return s
+}
+
+// WhenWarthogDiesWithin прогоняет симуляцию, пока бородавочник не станет трупом (не дольше maxSeconds)
+func (s *OmnivoreScenario) WhenWarthogDiesWithin(maxSeconds float32) *OmnivoreScenario {
+	runUntil(maxSeconds, func() bool { return s.world.HasComponent(s.warthog, core.MaskCorpse) }, s.tick)
+	return s
+}
+
+// Then методы проверяют результат
+
+func (s *OmnivoreScenario) ThenWarthogKilledByWolf() *OmnivoreScenario {
+	s.t.Helper()
+	if !s.world.HasComponent(s.warthog, core.MaskCorpse) {
+		health, _ := s.world.GetHealth(s.warthog)
+		s.t.Fatalf("Wolf should kill the warthog, its health is %d", health.Current)
+	}
+	if state, eating := s.world.GetEatingState(s.wolf); eating && state.Target != s.warthog {
+		s.t.Errorf("Wolf should feed on the warthog it killed, eats %d", state.Target)
+	}
+	return s
+}
+
+func (s *OmnivoreScenario) ThenWarthogGrazes() *OmnivoreScenario {
+	s.t.Helper()
+	if state, eating := s.world.GetEatingState(s.warthog); !eating || state.TargetType != core.EatingTargetGrass {
+		s.t.Fatalf("Warthog should graze, eating state %+v (eating %v)", state, eating)
+	}
+	return s
+}
+
+func (s *OmnivoreScenario) ThenWarthogEatsCarcass() *OmnivoreScenario {
+	s.t.Helper()
+	state, eating := s.world.GetEatingState(s.warthog)
+	if !eating || state.TargetType != core.EatingTargetAnimal || state.Target != s.carcass {
+		s.t.Fatalf("Warthog should eat carcass %d, eating state %+v (eating %v)", s.carcass, state, eating)
+	}
+	return s
+}
+
+func (s *OmnivoreScenario) ThenWarthogIsFed() *OmnivoreScenario {
+	s.t.Helper()
+	if satiation, _ := s.world.GetSatiation(s.warthog); satiation.Value <= s.satiation {
+		s.t.Errorf("Eating warthog should gain satiation above %.1f, got %.1f", s.satiation, satiation.Value)
+	}
+	return s
+}
+
+func TestOmnivore_GrazesWithoutCarcass(t *testing.T) {
+	// Туш нет - бородавочник идёт к траве и пасётся как травоядное
+	newOmnivoreScenario(t).
+		GivenGrassAround(12, 10, 1).
+		GivenHungryWarthogAtTile(8, 10, 30).
+		WhenWarthogStartsEating(5).
+		WhenTimePassesFor(0.5).
+		ThenWarthogGrazes().
+		WhenTimePassesFor(2).
+		ThenWarthogIsFed()
+}
+
+func TestOmnivore_LeavesPastureForNearbyCarcass(t *testing.T) {
+	// Стоит на траве, но туша в 1.5 тайлах ценнее - бородавочник идёт к ней и ест её
+	newOmnivoreScenario(t).
+		GivenGrassAround(10, 10, 3).
+		GivenRabbitCorpseAtTile(11.5, 10).
+		GivenHungryWarthogAtTile(10, 10, 40).
+		WhenWarthogStartsEating(5).
+		ThenWarthogEatsCarcass().
+		WhenTimePassesFor(2).
+		ThenWarthogIsFed()
+}
+
+func TestOmnivore_KeepsGrazingWhenCarcassFar(t *testing.T) {
+	// Туша в 4 тайлах не стоит того, чтобы бросать траву под ногами
+	newOmnivoreScenario(t).
+		GivenGrassAround(10, 10, 1).
+		GivenRabbitCorpseAtTile(14, 10).
+		GivenHungryWarthogAtTile(10, 10, 40).
+		WhenWarthogStartsEating(2).
+		ThenWarthogGrazes()
+}
+
+func TestOmnivore_WolfHuntsAndKillsWarthog(t *testing.T) {
+	// Всеядное - добыча хищников: голодный волк догоняет сытого бородавочника и убивает его
+	newOmnivoreScenario(t).
+		GivenHungryWarthogAtTile(10, 10, 100).
+		GivenHungryWolfAtTile(7, 10).
+		WhenWarthogDiesWithin(60).
+		ThenWarthogKilledByWolf()
+}
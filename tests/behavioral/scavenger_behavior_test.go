@@ -0,0 +1,204 @@
+package behavioral
+
+import (
+	"testing"
+
+	"github.com/aiseeq/savanna/internal/core"
+	"github.com/aiseeq/savanna/internal/pipeline"
+	"github.com/aiseeq/savanna/internal/simulation"
+)
+
+// ScavengerScenario сценарий гиены у туш (Given-When-Then)
+// Использует полный конвейер: поедание трупов идёт по кадрам анимации
+type ScavengerScenario struct {
+	world    *core.World
+	pipeline *pipeline.Pipeline
+	hyena    core.EntityID
+	wolf     core.EntityID
+	carcass  core.EntityID
+	t        *testing.T
+}
+
+// newScavengerScenario создаёт сценарий на травяной равнине с видами из config/species
+func newScavengerScenario(t *testing.T) *ScavengerScenario {
+	t.Helper()
+
+	loadSpecies(t)
+	terrain := newGrassPlain(t, scenarioPlainSize)
+
+	return &ScavengerScenario{
+		world:    newScenarioWorld(),
+		pipeline: pipeline.New(terrain, scenarioWorldSize, scenarioWorldSize),
+		t:        t,
+	}
+}
+
+// Given методы настраивают начальное состояние
+
+func (s *ScavengerScenario) GivenHungryHyenaAtTile(tileX, tileY float32) *ScavengerScenario {
+	hyena, ok := core.AnimalTypeByName("hyena")
+	if !ok {
+		s.t.Fatal("Hyena species should be registered")
+	}
+	x, y := tileCenter(tileX, tileY)
+	s.hyena = simulation.CreateAnimal(s.world, hyena, x, y)
+	s.world.SetSatiation(s.hyena, core.Satiation{Value: 10})
+	return s
+}
+
+func (s *ScavengerScenario) GivenWolfAtTile(tileX, tileY, satiation float32) *ScavengerScenario {
+	x, y := tileCenter(tileX, tileY)
+	s.wolf = simulation.CreateAnimal(s.world, core.TypeWolf, x, y)
+	s.world.SetSatiation(s.wolf, core.Satiation{Value: satiation})
+	return s
+}
+
+func (s *ScavengerScenario) GivenRabbitCorpseAtTile(tileX, tileY float32) *ScavengerScenario {
+	x, y := tileCenter(tileX, tileY)
+	rabbit := simulation.CreateAnimal(s.world, core.TypeRabbit, x, y)
+	s.carcass = simulation.CreateCorpseAndGetID(s.world, rabbit)
+	return s
+}
+
+func (s *ScavengerScenario) GivenCarrionAtTile(tileX, tileY float32) *ScavengerScenario {
+	s.GivenRabbitCorpseAtTile(tileX, tileY)
+	corpse, _ := s.world.GetCorpse(s.carcass)
+	s.world.RemoveCorpse(s.carcass)
+	s.world.AddCarrion(s.carcass, core.Carrion{
+		NutritionalValue: corpse.NutritionalValue,
+		MaxNutritional:   corpse.MaxNutritional,
+		DecayTimer:       corpse.DecayTimer,
+	})
+	return s
+}
+
+// When методы выполняют действия
+
+func (s *ScavengerScenario) tick() {
+	s.pipeline.Update(s.world, scenarioTimeStep)
+}
+
+func (s *ScavengerScenario) WhenOneTick() *ScavengerScenario {
+	s.tick()
+	return s
+}
+
+func (s *ScavengerScenario) WhenTimePassesFor(seconds float32) *ScavengerScenario {
+	runFor(seconds, s.tick)
+	return s
+}
+
+// WhenHyenaStartsEating прогоняет симуляцию, пока гиена не начнёт есть (не дольше maxSeconds)
+func (s *ScavengerScenario) WhenHyenaStartsEating(maxSeconds float32) *ScavengerScenario {
+	runUntil(maxSeconds, func() bool { return s.world.HasComponent(s.hyena, core.MaskEatingState) }, s.tick)
+	return s
+}
+
+// carcassRemovedAfter прогоняет симуляцию до исчезновения туши и возвращает число тиков (0 - не исчезла)
+func (s *ScavengerScenario) carcassRemovedAfter(maxSeconds float32) int {
+	removed := func() bool { return !s.world.IsAlive(s.carcass) }
+	if ticks := runUntil(maxSeconds, removed, s.tick); removed() {
+		return ticks
+	}
+	return 0
+}
+
+// Then методы проверяют результат
+
+func (s *ScavengerScenario) ThenHyenaEatsCarcass() *ScavengerScenario {
+	s.t.Helper()
+	if state, eating := s.world.GetEatingState(s.hyena); !eating || state.Target != s.carcass {
+		s.t.Fatalf("Hyena should eat carcass %d, eating state %+v (eating %v)", s.carcass, state, eating)
+	}
+	return s
+}
+
+func (s *ScavengerScenario) ThenWolfEatsCarcass() *ScavengerScenario {
+	s.t.Helper()
+	if state, eating := s.world.GetEatingState(s.wolf); !eating || state.Target != s.carcass {
+		s.t.Errorf("Wolf should keep eating carcass %d, eating state %+v (eating %v)", s.carcass, state, eating)
+	}
+	return s
+}
+
+func (s *ScavengerScenario) ThenCarcassEaten() *ScavengerScenario {
+	s.t.Helper()
+	if s.world.IsAlive(s.carcass) {
+		s.t.Error("Carcass should be eaten completely")
+	}
+	return s
+}
+
+func (s *ScavengerScenario) ThenHyenaIsFed() *ScavengerScenario {
+	s.t.Helper()
+	if satiation, _ := s.world.GetSatiation(s.hyena); satiation.Value <= 10 {
+		s.t.Errorf("Eating hyena should gain satiation, got %.1f", satiation.Value)
+	}
+	return s
+}
+
+func (s *ScavengerScenario) ThenHyenaRunsAwayFromWolf() *ScavengerScenario {
+	s.t.Helper()
+	hyenaPos, _ := s.world.GetPosition(s.hyena)
+	wolfPos, _ := s.world.GetPosition(s.wolf)
+	velocity, _ := s.world.GetVelocity(s.hyena)
+	away := hyenaPos.Sub(wolfPos).Normalize()
+	if speed := velocity.Length(); speed == 0 || away.Dot(velocity)/speed < 0.9 {
+		s.t.Errorf("Hyena should run away from the hunting wolf, velocity %+v", velocity)
+	}
+	return s
+}
+
+func TestScavenger_HyenaFindsAndEatsCarrion(t *testing.T) {
+	// Брошенная волком падаль в 4 тайлах - гиена находит её, подходит и ест
+	newScavengerScenario(t).
+		GivenHungryHyenaAtTile(6, 10).
+		GivenCarrionAtTile(10, 10).
+		WhenHyenaStartsEating(5).
+		ThenHyenaEatsCarcass().
+		WhenTimePassesFor(5).
+		ThenCarcassEaten().
+		ThenHyenaIsFed()
+}
+
+func TestScavenger_HyenaSharesKillWithEatingWolf(t *testing.T) {
+	// Волк ест труп - гиена не убегает от едящего хищника и ест ту же тушу
+	newScavengerScenario(t).
+		GivenWolfAtTile(10, 10, 20).
+		GivenRabbitCorpseAtTile(10, 10).
+		GivenHungryHyenaAtTile(7, 10).
+		WhenHyenaStartsEating(3).
+		ThenHyenaEatsCarcass().
+		ThenWolfEatsCarcass()
+}
+
+func TestScavenger_CarcassRemovedFasterWithHyena(t *testing.T) {
+	// Один волк доедает зайца сам
+	alone := newScavengerScenario(t).
+		GivenWolfAtTile(10, 10, 20).
+		GivenRabbitCorpseAtTile(10, 10).
+		carcassRemovedAfter(60)
+
+	// С гиеной, отрывающей вдвое больше за укус, от туши быстро ничего не остаётся
+	shared := newScavengerScenario(t).
+		GivenWolfAtTile(10, 10, 20).
+		GivenRabbitCorpseAtTile(10, 10).
+		GivenHungryHyenaAtTile(9.5, 10).
+		carcassRemovedAfter(60)
+
+	if shared == 0 {
+		t.Fatal("Wolf and hyena together should finish the carcass within a minute")
+	}
+	if alone != 0 && alone <= shared {
+		t.Errorf("Carcass should disappear faster with a hyena: %d ticks with hyena, %d without", shared, alone)
+	}
+}
+
+func TestScavenger_HyenaFleesHuntingWolf(t *testing.T) {
+	// Голодный охотящийся волк в 1.5 тайлах (ближе дистанции бегства 2 тайла) - гиена убегает
+	newScavengerScenario(t).
+		GivenHungryHyenaAtTile(10, 10).
+		GivenWolfAtTile(8.5, 10, 20).
+		WhenOneTick().
+		ThenHyenaRunsAwayFromWolf()
+}
@@ -0,0 +1,72 @@
+package behavioral
+
+import (
+	"testing"
+
+	"github.com/aiseeq/savanna/config"
+	"github.com/aiseeq/savanna/internal/constants"
+	"github.com/aiseeq/savanna/internal/core"
+	"github.com/aiseeq/savanna/internal/generator"
+	"github.com/aiseeq/savanna/internal/simulation"
+)
+
+// Общий мир сценариев: равнина 20x20 тайлов (640x640 пикселей) с фиксированным seed
+const (
+	scenarioPlainSize      = 20
+	scenarioWorldSize      = 640
+	scenarioSeed           = 12345
+	scenarioTicksPerSecond = 60
+	scenarioTimeStep       = 1.0 / scenarioTicksPerSecond
+)
+
+// speciesDir каталог описаний видов в репозитории
+const speciesDir = "../../config/species"
+
+// newGrassPlain создаёт равнину size x size тайлов, целиком покрытую травяными тайлами
+func newGrassPlain(t *testing.T, size int) *generator.Terrain {
+	t.Helper()
+
+	cfg := config.LoadDefaultConfig()
+	cfg.World.Size = size
+	terrain := generator.NewTerrainGenerator(cfg).Generate()
+	for y := 0; y < terrain.Size; y++ {
+		for x := 0; x < terrain.Size; x++ {
+			terrain.SetTileType(x, y, generator.TileGrass)
+		}
+	}
+	return terrain
+}
+
+// newScenarioWorld создаёт пустой мир сценария
+func newScenarioWorld() *core.World {
+	return core.NewWorld(scenarioWorldSize, scenarioWorldSize, scenarioSeed)
+}
+
+// loadSpecies регистрирует виды из config/species
+// Тесты с такими видами не параллельные: виды регистрируются в общем реестре
+func loadSpecies(t *testing.T) {
+	t.Helper()
+
+	if _, err := simulation.LoadSpeciesDir(speciesDir); err != nil {
+		t.Fatalf("Species files should load: %v", err)
+	}
+}
+
+// tileCenter центр тайла в пикселях
+func tileCenter(tileX, tileY float32) (x, y float32) {
+	return constants.TilesToPixels(tileX + 0.5), constants.TilesToPixels(tileY + 0.5)
+}
+
+// runFor выполняет tick на каждом шаге симуляции в течение seconds секунд
+func runFor(seconds float32, tick func()) {
+	runUntil(seconds, func() bool { return false }, tick)
+}
+
+// runUntil выполняет tick, пока не выполнится done (не дольше maxSeconds), и возвращает число шагов
+func runUntil(maxSeconds float32, done func() bool, tick func()) int {
+	ticks := 0
+	for ; ticks < int(maxSeconds*scenarioTicksPerSecond) && !done(); ticks++ {
+		tick()
+	}
+	return ticks
+}
@@ -0,0 +1,278 @@
+package behavioral
+
+import (
+	"math"
+	"testing"
+
+	"github.com/aiseeq/savanna/internal/core"
+	"github.com/aiseeq/savanna/internal/simulation"
+)
+
+// PackScenario сценарий стайной охоты волков (Given-When-Then)
+type PackScenario struct {
+	world          *core.World
+	profile        *simulation.BalanceProfile
+	packSystem     *simulation.PackSystem
+	behaviorSystem *simulation.AnimalBehaviorSystem
+	eatingSystem   *simulation.EatingSystem
+	wolves         []core.EntityID
+	rabbit         core.EntityID
+	t              *testing.T
+}
+
+// newPackScenario создаёт сценарий на травяной равнине без препятствий
+func newPackScenario(t *testing.T) *PackScenario {
+	t.Helper()
+
+	terrain := newGrassPlain(t, scenarioPlainSize)
+
+	vegetationSystem := simulation.NewVegetationSystem(terrain)
+	s := &PackScenario{
+		world:          newScenarioWorld(),
+		profile:        simulation.DefaultBalanceProfile(),
+		packSystem:     simulation.NewPackSystem(),
+		behaviorSystem: simulation.NewAnimalBehaviorSystem(vegetationSystem),
+		eatingSystem:   simulation.NewEatingSystem(),
+		t:              t,
+	}
+	s.applyProfile()
+	return s
+}
+
+func (s *PackScenario) applyProfile() {
+	simulation.ApplyBalanceProfile(s.profile, s.packSystem, s.behaviorSystem, s.eatingSystem)
+}
+
+// Given методы настраивают начальное состояние
+
+func (s *PackScenario) GivenMaxPackSize(size int) *PackScenario {
+	s.profile.Pack.MaxSize = size
+	s.applyProfile()
+	return s
+}
+
+func (s *PackScenario) GivenHungryWolvesAt(positions ...core.Position) *PackScenario {
+	for _, pos := range positions {
+		wolf := simulation.CreateAnimal(s.world, core.TypeWolf, pos.X, pos.Y)
+		s.world.SetSatiation(wolf, core.Satiation{Value: 20})
+		s.wolves = append(s.wolves, wolf)
+	}
+	return s
+}
+
+func (s *PackScenario) GivenRabbitAt(x, y float32) *PackScenario {
+	s.rabbit = simulation.CreateAnimal(s.world, core.TypeRabbit, x, y)
+	return s
+}
+
+func (s *PackScenario) GivenWolfMovedTo(index int, x, y float32) *PackScenario {
+	s.world.SetPosition(s.wolves[index], core.NewPosition(x, y))
+	return s
+}
+
+func (s *PackScenario) GivenRabbitKilled() *PackScenario {
+	simulation.CreateCorpseAndGetID(s.world, s.rabbit)
+	return s
+}
+
+// When методы выполняют действия
+
+// Системы вызываются напрямую: после каждой применяются её отложенные команды, как в SystemManager
+
+func (s *PackScenario) WhenPacksUpdate() *PackScenario {
+	s.packSystem.Update(s.world, scenarioTimeStep)
+	s.world.Sync()
+	return s
+}
+
+func (s *PackScenario) WhenWolvesDecide() *PackScenario {
+	s.WhenPacksUpdate()
+	s.behaviorSystem.Update(s.world, scenarioTimeStep)
+	return s
+}
+
+func (s *PackScenario) WhenWolvesLookForFood() *PackScenario {
+	s.WhenPacksUpdate()
+	s.eatingSystem.Update(s.world, scenarioTimeStep)
+	s.world.Sync()
+	return s
+}
+
+// Then методы проверяют результат
+
+func (s *PackScenario) membership(index int) (core.PackMembership, bool) {
+	return s.world.GetPackMembership(s.wolves[index])
+}
+
+func (s *PackScenario) ThenWolvesFormOnePack() *PackScenario {
+	s.t.Helper()
+	leaders := 0
+	var leader core.EntityID
+	for i, wolf := range s.wolves {
+		membership, inPack := s.membership(i)
+		if !inPack {
+			s.t.Fatalf("Wolf %d should be in a pack", wolf)
+		}
+		if leader != 0 && membership.Leader != leader {
+			s.t.Fatalf("Wolves should share one leader, got %d and %d", leader, membership.Leader)
+		}
+		leader = membership.Leader
+		if membership.IsLeader(wolf) {
+			leaders++
+		}
+	}
+	if leaders != 1 {
+		s.t.Errorf("Pack should have exactly one leader, got %d", leaders)
+	}
+	return s
+}
+
+func (s *PackScenario) ThenWolfIsLoner(index int) *PackScenario {
+	s.t.Helper()
+	if _, inPack := s.membership(index); inPack {
+		s.t.Errorf("Wolf %d should hunt alone", s.wolves[index])
+	}
+	return s
+}
+
+func (s *PackScenario) ThenPackTargetsRabbit() *PackScenario {
+	s.t.Helper()
+	for i := range s.wolves {
+		if membership, _ := s.membership(i); membership.Target != s.rabbit {
+			s.t.Errorf("Wolf %d should target rabbit %d, got %d", s.wolves[i], s.rabbit, membership.Target)
+		}
+	}
+	return s
+}
+
+func (s *PackScenario) ThenWolvesEat(target core.EntityID, count int) *PackScenario {
+	s.t.Helper()
+	eating := 0
+	for _, wolf := range s.wolves {
+		if state, isEating := s.world.GetEatingState(wolf); isEating && state.Target == target {
+			eating++
+		}
+	}
+	if eating != count {
+		s.t.Errorf("Expected %d wolves eating %d, got %d", count, target, eating)
+	}
+	return s
+}
+
+// heading направление скорости волка (нормированное)
+func (s *PackScenario) heading(wolf core.EntityID) core.Velocity {
+	velocity, _ := s.world.GetVelocity(wolf)
+	length := float32(math.Hypot(float64(velocity.X), float64(velocity.Y)))
+	if length == 0 {
+		s.t.Fatalf("Wolf %d should be moving", wolf)
+	}
+	return core.Velocity{X: velocity.X / length, Y: velocity.Y / length}
+}
+
+func TestWolfPack_NearbyWolvesFormPackWithOneLeader(t *testing.T) {
+	t.Parallel()
+
+	newPackScenario(t).
+		GivenHungryWolvesAt(core.NewPosition(200, 320), core.NewPosition(232, 320), core.NewPosition(200, 352)).
+		WhenPacksUpdate().
+		ThenWolvesFormOnePack()
+}
+
+func TestWolfPack_MaxSizeLimitsPack(t *testing.T) {
+	t.Parallel()
+
+	// Стая из одного волка - одиночная охота
+	newPackScenario(t).
+		GivenMaxPackSize(1).
+		GivenHungryWolvesAt(core.NewPosition(200, 320), core.NewPosition(232, 320)).
+		WhenPacksUpdate().
+		ThenWolfIsLoner(0).
+		ThenWolfIsLoner(1)
+
+	// Третий волк не помещается в стаю из двух
+	s := newPackScenario(t).
+		GivenMaxPackSize(2).
+		GivenHungryWolvesAt(core.NewPosition(200, 320), core.NewPosition(232, 320), core.NewPosition(400, 320)).
+		WhenPacksUpdate()
+	if _, inPack := s.membership(2); inPack {
+		t.Error("Distant wolf should not join the pack")
+	}
+	s.GivenWolfMovedTo(2, 216, 352).WhenPacksUpdate()
+	if _, inPack := s.membership(2); inPack {
+		t.Error("Full pack should not accept a third wolf")
+	}
+}
+
+func TestWolfPack_FollowersShareLeaderTargetAndFlank(t *testing.T) {
+	t.Parallel()
+
+	// Вожак и двое загонщиков позади него на одной линии с зайцем
+	s := newPackScenario(t).
+		GivenHungryWolvesAt(core.NewPosition(200, 320), core.NewPosition(168, 320), core.NewPosition(136, 320)).
+		GivenRabbitAt(328, 320).
+		WhenWolvesDecide().
+		ThenWolvesFormOnePack().
+		ThenPackTargetsRabbit()
+
+	leader, _ := s.membership(0)
+	var followers []core.EntityID
+	for _, wolf := range s.wolves {
+		if wolf == leader.Leader {
+			if heading := s.heading(wolf); heading.X < 0.99 {
+				t.Errorf("Leader should chase the rabbit directly, heading %+v", heading)
+			}
+			continue
+		}
+		followers = append(followers, wolf)
+	}
+
+	// Прямой путь к зайцу горизонтален - загонщики уходят на фланги, по разные стороны
+	first, second := s.heading(followers[0]), s.heading(followers[1])
+	if math.Abs(float64(first.Y)) < 0.2 || math.Abs(float64(second.Y)) < 0.2 {
+		t.Errorf("Followers should head to the flanks, headings %+v and %+v", first, second)
+	}
+	if (first.Y > 0) == (second.Y > 0) {
+		t.Errorf("Followers should flank from opposite sides, headings %+v and %+v", first, second)
+	}
+}
+
+func TestWolfPack_StragglerLeavesPack(t *testing.T) {
+	t.Parallel()
+
+	s := newPackScenario(t).
+		GivenHungryWolvesAt(core.NewPosition(200, 320), core.NewPosition(232, 320)).
+		WhenPacksUpdate().
+		ThenWolvesFormOnePack()
+
+	// Отставший дальше сплочённости (10 тайлов) покидает стаю, стая из одного вожака распадается
+	s.GivenWolfMovedTo(1, 600, 320).
+		WhenPacksUpdate().
+		ThenWolfIsLoner(0).
+		ThenWolfIsLoner(1)
+}
+
+func TestWolfPack_PackSharesCorpse(t *testing.T) {
+	t.Parallel()
+
+	// Вся стая у убитого зайца
+	s := newPackScenario(t).
+		GivenHungryWolvesAt(core.NewPosition(200, 320), core.NewPosition(210, 320), core.NewPosition(200, 330)).
+		GivenRabbitAt(205, 325).
+		WhenPacksUpdate().
+		ThenPackTargetsRabbit()
+
+	// Добыча остаётся целью стаи как труп - волки едят его вместе
+	s.GivenRabbitKilled().
+		WhenWolvesLookForFood().
+		ThenPackTargetsRabbit().
+		ThenWolvesEat(s.rabbit, 3)
+
+	// Сородич наелся и бросил недоеденный труп - опоздавший член стаи доедает падаль
+	s.world.RemoveEatingState(s.wolves[2])
+	corpse, _ := s.world.GetCorpse(s.rabbit)
+	s.world.RemoveCorpse(s.rabbit)
+	s.world.AddCarrion(s.rabbit, core.Carrion{
+		NutritionalValue: corpse.NutritionalValue, MaxNutritional: corpse.MaxNutritional, AbandonedBy: s.wolves[0],
+	})
+	s.WhenWolvesLookForFood().ThenWolvesEat(s.rabbit, 3)
+}
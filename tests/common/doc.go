@@ -7,6 +7,7 @@
 // 2. world_builder.go - TestWorldBuilder для создания тестовых миров (Builder Pattern)
 // 3. system_factory.go - Фабрики для создания наборов систем
 // 4. simulation_utils.go - Утилиты для запуска симуляции и проверок
+// 5. snapshot_utils.go - Сохранение/загрузка снапшотов и проверка бит-в-бит совпадения миров
 //
 // Применяемые принципы:
 // - DRY: Вынос дублированного кода в переиспользуемые компоненты
@@ -0,0 +1,79 @@
+package common
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/aiseeq/savanna/internal/core"
+	"github.com/aiseeq/savanna/internal/generator"
+	"github.com/aiseeq/savanna/internal/snapshot"
+)
+
+// SnapshotRoundTrip сохраняет мир в бинарный снапшот и загружает обратно
+// Возвращает восстановленный мир, ландшафт и системы, созданные поверх восстановленного ландшафта
+// (тот же набор, что CreateTestSystemBundleWithTerrain) с загруженным внутренним состоянием
+func SnapshotRoundTrip(
+	t *testing.T,
+	world *core.World,
+	terrain *generator.Terrain,
+	systems *core.SystemManager,
+) (*core.World, *generator.Terrain, *core.SystemManager) {
+	t.Helper()
+
+	captured, err := snapshot.Capture(world, terrain, systems, nil)
+	if err != nil {
+		t.Fatalf("Не удалось снять снапшот: %v", err)
+	}
+
+	var buffer bytes.Buffer
+	if err := snapshot.Encode(&buffer, captured); err != nil {
+		t.Fatalf("Не удалось сохранить снапшот: %v", err)
+	}
+
+	loaded, err := snapshot.Decode(&buffer)
+	if err != nil {
+		t.Fatalf("Не удалось прочитать снапшот: %v", err)
+	}
+
+	restoredWorld, restoredTerrain, err := loaded.Restore()
+	if err != nil {
+		t.Fatalf("Не удалось восстановить мир: %v", err)
+	}
+
+	width, _ := restoredWorld.GetWorldDimensions()
+	restoredSystems := CreateTestSystemManagerWithTerrain(width, restoredTerrain)
+	if err := loaded.RestoreSystems(restoredSystems); err != nil {
+		t.Fatalf("Не удалось восстановить состояние систем: %v", err)
+	}
+
+	return restoredWorld, restoredTerrain, restoredSystems
+}
+
+// AssertWorldsIdentical проверяет что два мира совпадают бит-в-бит
+// (компоненты, free-list, время, состояние RNG, раскладка spatial grid)
+func AssertWorldsIdentical(t *testing.T, expected, actual *core.World, message string) {
+	t.Helper()
+
+	expectedSnapshot, expectedErr := expected.CreateSnapshot()
+	actualSnapshot, actualErr := actual.CreateSnapshot()
+	if expectedErr != nil || actualErr != nil {
+		t.Fatalf("%s: не удалось снять снапшоты: %v, %v", message, expectedErr, actualErr)
+	}
+
+	if !reflect.DeepEqual(expectedSnapshot, actualSnapshot) {
+		t.Errorf("%s: состояния миров различаются", message)
+	}
+}
+
+// AssertTerrainsIdentical проверяет что тайлы и трава двух ландшафтов совпадают
+func AssertTerrainsIdentical(t *testing.T, expected, actual *generator.Terrain, message string) {
+	t.Helper()
+
+	if !reflect.DeepEqual(expected.Tiles, actual.Tiles) {
+		t.Errorf("%s: тайлы ландшафта различаются", message)
+	}
+	if !reflect.DeepEqual(expected.Grass, actual.Grass) {
+		t.Errorf("%s: количество травы различается", message)
+	}
+}
@@ -21,8 +21,25 @@ type TestSystemBundle struct {
 	AnimationAdapter *AnimationSystemAdapter
 }
 
+// testAnimationSpec объявление анимаций в наборах, где они идут системой: кадры обновляются первыми, как в GUI
+var testAnimationSpec = core.SystemSpec{Name: "animation", Phase: core.PhaseInput}
+
+// testSystem система с объявлением её фазы и зависимостей из simulation/system_specs.go
+type testSystem struct {
+	system core.System
+	spec   core.SystemSpec
+}
+
+// newTestSystemManager регистрирует системы по объявлениям - порядок строит SystemManager, как в конвейере
+func newTestSystemManager(systems ...testSystem) *core.SystemManager {
+	systemManager := core.NewSystemManager()
+	for _, s := range systems {
+		systemManager.MustRegister(s.system, s.spec)
+	}
+	return systemManager
+}
+
 // CreateTestSystemManager создает стандартный набор систем для интеграционных тестов
-// ИСПРАВЛЕНО: Порядок систем согласно CLAUDE.md для правильной работы AttackState
 func CreateTestSystemManager(worldSize float32) *core.SystemManager {
 	bundle := CreateTestSystemBundle(worldSize)
 	return bundle.SystemManager
@@ -31,66 +48,7 @@ func CreateTestSystemManager(worldSize float32) *core.SystemManager {
 // CreateTestSystemBundle создает системы и анимации отдельно для правильного порядка обновления
 // ИСПРАВЛЕНИЕ: Анимации должны обновляться ПЕРЕД системами, как в GUI режиме
 func CreateTestSystemBundle(worldSize float32) *TestSystemBundle {
-	systemManager := core.NewSystemManager()
-
-	// 1. Vegetation система (рост травы)
-	vegetationSystem := CreateTestVegetationSystem(worldSize)
-	systemManager.AddSystem(vegetationSystem)
-
-	// 2. Satiation система (управление сытостью) - ПОСЛЕ GrassEatingSystem согласно CLAUDE.md
-	satiationSystem := simulation.NewSatiationSystem()
-	satiationSystemAdapter := &adapters.SatiationSystemAdapter{System: satiationSystem}
-
-	// 3. GrassSearch система (поиск травы и создание EatingState)
-	grassSearchSystem := simulation.NewGrassSearchSystem(vegetationSystem)
-	systemManager.AddSystem(&adapters.GrassSearchSystemAdapter{System: grassSearchSystem})
-
-	// 4. GrassEating система (дискретное поедание травы)
-	grassEatingSystem := simulation.NewGrassEatingSystem(vegetationSystem)
-	systemManager.AddSystem(&adapters.GrassEatingSystemAdapter{System: grassEatingSystem})
-
-	// 2. ТЕПЕРЬ добавляем SatiationSystem - ПОСЛЕ GrassEatingSystem
-	systemManager.AddSystem(satiationSystemAdapter)
-
-	// 5. Behavior система (поведение - проверяет EatingState) - ПЕРЕД движением!
-	animalBehaviorSystem := simulation.NewAnimalBehaviorSystem(vegetationSystem)
-	systemManager.AddSystem(&adapters.BehaviorSystemAdapter{System: animalBehaviorSystem})
-
-	// 6. SatiationSpeed система (влияние сытости на скорость)
-	satiationSpeedModifier := simulation.NewSatiationSpeedModifierSystem()
-	systemManager.AddSystem(&adapters.SatiationSpeedModifierSystemAdapter{System: satiationSpeedModifier})
-
-	// 7. Movement система (движение - сбрасывает скорость едящих)
-	movementSystem := simulation.NewMovementSystem(worldSize, worldSize)
-	systemManager.AddSystem(&adapters.MovementSystemAdapter{System: movementSystem})
-
-	// 8. Combat система (бой и урон) - ПОСЛЕ движения согласно CLAUDE.md!
-	combatSystem := simulation.NewCombatSystem()
-	systemManager.AddSystem(combatSystem)
-
-	// 9. Starvation система (урон от голода)
-	starvationDamage := simulation.NewStarvationDamageSystem()
-	systemManager.AddSystem(&adapters.StarvationDamageSystemAdapter{System: starvationDamage})
-
-	// ДОПОЛНИТЕЛЬНЫЕ системы (порядок не критичен)
-	// ИСПРАВЛЕНИЕ: Анимационная система НЕ добавляется в systemManager
-	// Она должна обновляться ПЕРЕД системами, как в GUI режиме
-	animationAdapter := NewAnimationSystemAdapter()
-
-	// Системы урона и трупов
-	damageSystem := simulation.NewDamageSystem()
-	systemManager.AddSystem(damageSystem)
-
-	corpseSystem := simulation.NewCorpseSystem()
-	systemManager.AddSystem(corpseSystem)
-
-	eatingSystem := simulation.NewEatingSystem()
-	systemManager.AddSystem(eatingSystem)
-
-	return &TestSystemBundle{
-		SystemManager:    systemManager,
-		AnimationAdapter: animationAdapter,
-	}
+	return CreateTestSystemBundleWithTerrain(worldSize, createTestTerrain(worldSize))
 }
 
 // CreateTestSystemManagerWithTerrain создает системный менеджер с кастомным terrain
@@ -101,130 +59,95 @@ func CreateTestSystemManagerWithTerrain(worldSize float32, terrain generator.Ter
 }
 
 // CreateTestSystemBundleWithTerrain создает системы и анимации отдельно с кастомным terrain
-// ИСПРАВЛЕНИЕ: Анимации должны обновляться ПЕРЕД системами, как в GUI режиме
+// Порядок систем задают их объявления (фазы и зависимости), а не порядок в списке
+// ИСПРАВЛЕНИЕ: Анимационная система НЕ добавляется в systemManager -
+// она должна обновляться ПЕРЕД системами, как в GUI режиме
 func CreateTestSystemBundleWithTerrain(worldSize float32, terrain generator.TerrainInterface) *TestSystemBundle {
-	systemManager := core.NewSystemManager()
-
-	// 1. Vegetation система с кастомным terrain
 	vegetationSystem := simulation.NewVegetationSystem(terrain)
-	systemManager.AddSystem(vegetationSystem)
 
-	// 2. Satiation система (управление сытостью) - ПОСЛЕ GrassEatingSystem согласно CLAUDE.md
-	satiationSystem := simulation.NewSatiationSystem()
-	satiationSystemAdapter := &adapters.SatiationSystemAdapter{System: satiationSystem}
-
-	// 3. GrassSearch система (поиск травы и создание EatingState)
-	grassSearchSystem := simulation.NewGrassSearchSystem(vegetationSystem)
-	systemManager.AddSystem(&adapters.GrassSearchSystemAdapter{System: grassSearchSystem})
-
-	// 4. GrassEating система (дискретное поедание травы) - КЛЮЧЕВАЯ ДЛЯ ТЕСТОВ!
-	grassEatingSystem := simulation.NewGrassEatingSystem(vegetationSystem)
-	systemManager.AddSystem(&adapters.GrassEatingSystemAdapter{System: grassEatingSystem})
-
-	// 2. ТЕПЕРЬ добавляем SatiationSystem - ПОСЛЕ GrassEatingSystem
-	systemManager.AddSystem(satiationSystemAdapter)
-
-	// 5. Behavior система (поведение - проверяет EatingState) - ПЕРЕД движением!
-	animalBehaviorSystem := simulation.NewAnimalBehaviorSystem(vegetationSystem)
-	systemManager.AddSystem(&adapters.BehaviorSystemAdapter{System: animalBehaviorSystem})
-
-	// 6. SatiationSpeed система (влияние сытости на скорость)
-	satiationSpeedModifier := simulation.NewSatiationSpeedModifierSystem()
-	systemManager.AddSystem(&adapters.SatiationSpeedModifierSystemAdapter{System: satiationSpeedModifier})
-
-	// 7. Movement система (движение - сбрасывает скорость едящих)
-	movementSystem := simulation.NewMovementSystem(worldSize, worldSize)
-	systemManager.AddSystem(&adapters.MovementSystemAdapter{System: movementSystem})
-
-	// 8. Combat система (бой и урон) - ПОСЛЕ движения согласно CLAUDE.md!
-	combatSystem := simulation.NewCombatSystem()
-	systemManager.AddSystem(combatSystem)
-
-	// 9. Starvation система (урон от голода)
-	starvationDamage := simulation.NewStarvationDamageSystem()
-	systemManager.AddSystem(&adapters.StarvationDamageSystemAdapter{System: starvationDamage})
-
-	// ДОПОЛНИТЕЛЬНЫЕ системы (порядок не критичен)
-	// ИСПРАВЛЕНИЕ: Анимационная система НЕ добавляется в systemManager
-	// Она должна обновляться ПЕРЕД системами, как в GUI режиме
-	animationAdapter := NewAnimationSystemAdapter()
-
-	// Системы урона и трупов
-	damageSystem := simulation.NewDamageSystem()
-	systemManager.AddSystem(damageSystem)
-
-	corpseSystem := simulation.NewCorpseSystem()
-	systemManager.AddSystem(corpseSystem)
-
-	eatingSystem := simulation.NewEatingSystem()
-	systemManager.AddSystem(eatingSystem)
+	systemManager := newTestSystemManager(
+		testSystem{vegetationSystem, simulation.VegetationSystemSpec},
+		testSystem{&adapters.SatiationSystemAdapter{System: simulation.NewSatiationSystem()}, simulation.SatiationSystemSpec},
+		testSystem{
+			&adapters.GrassSearchSystemAdapter{System: simulation.NewGrassSearchSystem(vegetationSystem)},
+			simulation.GrassSearchSystemSpec,
+		},
+		testSystem{
+			&adapters.GrassEatingSystemAdapter{System: simulation.NewGrassEatingSystem(vegetationSystem)},
+			simulation.GrassEatingSystemSpec,
+		},
+		testSystem{
+			&adapters.BehaviorSystemAdapter{System: simulation.NewAnimalBehaviorSystem(vegetationSystem)},
+			simulation.BehaviorSystemSpec,
+		},
+		testSystem{
+			&adapters.SatiationSpeedModifierSystemAdapter{System: simulation.NewSatiationSpeedModifierSystem()},
+			simulation.SatiationSpeedSystemSpec,
+		},
+		testSystem{
+			&adapters.MovementSystemAdapter{System: simulation.NewMovementSystem(worldSize, worldSize)},
+			simulation.MovementSystemSpec,
+		},
+		testSystem{simulation.NewCombatSystem(), simulation.CombatSystemSpec},
+		testSystem{
+			&adapters.StarvationDamageSystemAdapter{System: simulation.NewStarvationDamageSystem()},
+			simulation.StarvationSystemSpec,
+		},
+		testSystem{simulation.NewDamageSystem(), simulation.DamageSystemSpec},
+		testSystem{simulation.NewCorpseSystem(), simulation.CorpseSystemSpec},
+		testSystem{simulation.NewEatingSystem(), simulation.EatingSystemSpec},
+	)
 
 	return &TestSystemBundle{
 		SystemManager:    systemManager,
-		AnimationAdapter: animationAdapter,
+		AnimationAdapter: NewAnimationSystemAdapter(),
 	}
 }
 
 // CreateMinimalSystemManager создает минимальный набор систем (для простых тестов)
 func CreateMinimalSystemManager(worldSize float32) *core.SystemManager {
-	systemManager := core.NewSystemManager()
-
 	// Создаем vegetation систему для behavior системы
 	vegetationSystem := CreateTestVegetationSystem(worldSize)
-	systemManager.AddSystem(vegetationSystem)
 
 	// КРИТИЧЕСКИ ВАЖНО: Анимационная система даже в минимальном наборе
-	animationAdapter := NewAnimationSystemAdapter()
-	systemManager.AddSystem(animationAdapter)
-
-	// Только основные системы
-	movementSystem := simulation.NewMovementSystem(worldSize, worldSize)
-	systemManager.AddSystem(&adapters.MovementSystemAdapter{System: movementSystem})
-
-	animalBehaviorSystem := simulation.NewAnimalBehaviorSystem(vegetationSystem)
-	systemManager.AddSystem(&adapters.BehaviorSystemAdapter{System: animalBehaviorSystem})
-
-	return systemManager
+	return newTestSystemManager(
+		testSystem{vegetationSystem, simulation.VegetationSystemSpec},
+		testSystem{NewAnimationSystemAdapter(), testAnimationSpec},
+		testSystem{
+			&adapters.BehaviorSystemAdapter{System: simulation.NewAnimalBehaviorSystem(vegetationSystem)},
+			simulation.BehaviorSystemSpec,
+		},
+		testSystem{
+			&adapters.MovementSystemAdapter{System: simulation.NewMovementSystem(worldSize, worldSize)},
+			simulation.MovementSystemSpec,
+		},
+	)
 }
 
 // CreateCombatSystemManager создает системы для тестов боя
 func CreateCombatSystemManager(worldSize float32) *core.SystemManager {
-	systemManager := core.NewSystemManager()
-
 	// КРИТИЧЕСКИ ВАЖНО: Анимационная система для тестов боя
-	animationAdapter := NewAnimationSystemAdapter()
-	systemManager.AddSystem(animationAdapter)
-
-	// Боевые системы - используем новую CombatSystem
-	combatSystem := simulation.NewCombatSystem()
-	systemManager.AddSystem(combatSystem)
-
-	// Системы урона и трупов
-	damageSystem := simulation.NewDamageSystem()
-	systemManager.AddSystem(damageSystem)
-
-	corpseSystem := simulation.NewCorpseSystem()
-	systemManager.AddSystem(corpseSystem)
-
-	eatingSystem := simulation.NewEatingSystem()
-	systemManager.AddSystem(eatingSystem)
-
-	return systemManager
+	return newTestSystemManager(
+		testSystem{NewAnimationSystemAdapter(), testAnimationSpec},
+		testSystem{simulation.NewCombatSystem(), simulation.CombatSystemSpec},
+		testSystem{simulation.NewDamageSystem(), simulation.DamageSystemSpec},
+		testSystem{simulation.NewCorpseSystem(), simulation.CorpseSystemSpec},
+		testSystem{simulation.NewEatingSystem(), simulation.EatingSystemSpec},
+	)
 }
 
 // CreateTestVegetationSystem создает систему растительности для тестов
 // Устраняет дублирование создания vegetation в 35+ тестах
 func CreateTestVegetationSystem(worldSize float32) *simulation.VegetationSystem {
-	// Создаем конфигурацию для теста
+	return simulation.NewVegetationSystem(createTestTerrain(worldSize))
+}
+
+// createTestTerrain генерирует ландшафт по умолчанию для мира заданного размера в пикселях
+func createTestTerrain(worldSize float32) *generator.Terrain {
 	cfg := config.LoadDefaultConfig()
 	cfg.World.Size = int(worldSize / constants.TileSizePixels) // Конвертируем пиксели в тайлы
 
-	// Генерируем terrain
-	terrainGen := generator.NewTerrainGenerator(cfg)
-	terrain := terrainGen.Generate()
-
-	// Создаем vegetation систему
-	return simulation.NewVegetationSystem(terrain)
+	return generator.NewTerrainGenerator(cfg).Generate()
 }
 
 // CreateMockVegetationSystem создает mock vegetation систему для unit тестов
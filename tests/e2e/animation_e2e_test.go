@@ -1,6 +1,7 @@
 package e2e
 
 import (
+	"image"
 	"testing"
 
 	"github.com/hajimehoshi/ebiten/v2"
@@ -148,7 +149,7 @@ func TestAnimationE2E(t *testing.T) {
 			}
 
 			// Получаем кадр как в GUI
-			var frameImg *ebiten.Image
+			var frameImg image.Image
 			switch animalType {
 			case core.TypeWolf:
 				frameImg = wolfAnimationSystem.GetFrameImage(&animComponent)
@@ -2,6 +2,7 @@ package e2e
 
 import (
 	"fmt"
+	"image"
 	"testing"
 
 	"github.com/hajimehoshi/ebiten/v2"
@@ -66,7 +67,10 @@ func TestSeed6Debug(t *testing.T) {
 	// Размещаем животных ТОЧНО как в игре
 	t.Logf("\nРазмещение животных...")
 	popGen := generator.NewPopulationGenerator(cfg, terrain)
-	placements := popGen.Generate()
+	placements, err := popGen.Generate()
+	if err != nil {
+		t.Fatalf("Population generation failed: %v", err)
+	}
 
 	// Создаём животных на основе сгенерированных позиций
 	rabbits := []core.EntityID{}
@@ -200,7 +204,7 @@ func TestSeed6Debug(t *testing.T) {
 			}
 
 			// Получаем кадр как в GUI
-			var frameImg *ebiten.Image
+			var frameImg image.Image
 			switch animalType {
 			case core.TypeWolf:
 				frameImg = wolfAnimationSystem.GetFrameImage(&animComponent)
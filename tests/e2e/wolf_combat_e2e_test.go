@@ -2,6 +2,7 @@ package e2e
 
 import (
 	"fmt"
+	"image"
 	"testing"
 	"time"
 
@@ -169,7 +170,7 @@ func TestWolfCombatE2E(t *testing.T) {
 			}
 
 			// Имитируем получение кадра как в GUI
-			var frameImg *ebiten.Image
+			var frameImg image.Image
 			switch animalType {
 			case core.TypeWolf:
 				frameImg = wolfAnimationSystem.GetFrameImage(&animComponent)
@@ -67,7 +67,10 @@ func TestCenterAnimalScreenshot(t *testing.T) {
 
 	// Размещаем животных
 	popGen := generator.NewPopulationGenerator(cfg, terrain)
-	placements := popGen.Generate()
+	placements, err := popGen.Generate()
+	if err != nil {
+		t.Fatalf("Population generation failed: %v", err)
+	}
 
 	for _, placement := range placements {
 		// Преобразуем координаты из пикселей в тайлы
@@ -35,7 +35,10 @@ func TestEcosystemSurvival(t *testing.T) {
 
 	// Размещаем животных
 	popGen := generator.NewPopulationGenerator(cfg, terrain)
-	placements := popGen.Generate()
+	placements, err := popGen.Generate()
+	if err != nil {
+		t.Fatalf("Population generation failed: %v", err)
+	}
 
 	var rabbits []core.EntityID
 	var wolves []core.EntityID
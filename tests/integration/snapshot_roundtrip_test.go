@@ -0,0 +1,39 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/aiseeq/savanna/config"
+	"github.com/aiseeq/savanna/internal/constants"
+	"github.com/aiseeq/savanna/internal/generator"
+	"github.com/aiseeq/savanna/tests/common"
+)
+
+// TestSnapshotRoundTripMidCombat сохраняет мир в разгар охоты (кулдауны атак, трупы,
+// поедание травы) и проверяет что загруженная копия продолжает симуляцию бит-в-бит
+func TestSnapshotRoundTripMidCombat(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.LoadDefaultConfig()
+	cfg.World.Size = int(common.MediumWorldSize / constants.TileSizePixels)
+	terrain := generator.NewTerrainGenerator(cfg).Generate()
+
+	world, _, _ := common.NewTestWorld().
+		WithoutSystems().
+		AddHungryRabbit().
+		AddRabbit(common.RabbitStartX+60, common.RabbitStartY+40, common.HungryPercentage, common.RabbitMaxHealth).
+		AddHungryWolf().
+		Build()
+	systems := common.CreateTestSystemManagerWithTerrain(common.MediumWorldSize, terrain)
+
+	common.RunSimulation(world, systems, common.OneSecondTicks)
+
+	restoredWorld, restoredTerrain, restoredSystems := common.SnapshotRoundTrip(t, world, terrain, systems)
+	common.AssertWorldsIdentical(t, world, restoredWorld, "Сразу после загрузки")
+
+	common.RunSimulation(world, systems, common.FiveSecondTicks)
+	common.RunSimulation(restoredWorld, restoredSystems, common.FiveSecondTicks)
+
+	common.AssertWorldsIdentical(t, world, restoredWorld, "После 5 секунд продолжения")
+	common.AssertTerrainsIdentical(t, terrain, restoredTerrain, "После 5 секунд продолжения")
+}
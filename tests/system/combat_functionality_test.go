@@ -93,7 +93,10 @@ func createTestAnimals(
 ) (rabbits, wolves []core.EntityID) {
 	// Размещаем животных ТОЧНО как в реальной игре
 	popGen := generator.NewPopulationGenerator(cfg, terrain)
-	placements := popGen.Generate()
+	placements, err := popGen.Generate()
+	if err != nil {
+		t.Fatalf("Population generation failed: %v", err)
+	}
 
 	for _, placement := range placements {
 		switch placement.Type {
@@ -0,0 +1,95 @@
+package unit
+
+import (
+	"testing"
+
+	"github.com/aiseeq/savanna/internal/core"
+)
+
+// TestCommandBufferDefersStructuralChanges проверяет что изменения применяются только при Playback и по порядку
+func TestCommandBufferDefersStructuralChanges(t *testing.T) {
+	t.Parallel()
+
+	world := core.NewWorld(640, 640, 1)
+	for i := 0; i < 5; i++ {
+		entity := world.CreateEntity()
+		world.AddHealth(entity, core.Health{Current: int16(i), Max: 10})
+	}
+
+	commands := world.Commands()
+	visited := 0
+	world.ForEachWith(core.MaskHealth, func(entity core.EntityID) {
+		visited++
+		health, _ := world.GetHealth(entity)
+		if health.Current%2 == 0 {
+			commands.DestroyEntity(entity)
+			commands.DestroyEntity(entity) // Повторное уничтожение пропускается
+			commands.Modify(entity, func(w *core.World, e core.EntityID) {
+				w.AddVelocity(e, core.NewVelocity(1, 1)) // Сущность уже уничтожена - команда пропускается
+			})
+		} else {
+			commands.Modify(entity, func(w *core.World, e core.EntityID) {
+				w.RemoveHealth(e)
+			})
+		}
+	})
+	commands.CreateEntity(func(w *core.World, e core.EntityID) {
+		w.AddHealth(e, core.Health{Current: 100, Max: 100})
+		w.Commands().CreateEntity(nil) // Команда из команды выполняется в этом же Playback
+	})
+
+	if visited != 5 || world.GetEntityCount() != 5 {
+		t.Fatalf("Iteration should see all 5 entities unchanged, visited %d, count %d", visited, world.GetEntityCount())
+	}
+
+	// 3 уничтожения + 2 удаления Health + 2 создания; повторные уничтожения и Modify мёртвых не считаются
+	if applied := world.FlushCommands(); applied != 7 {
+		t.Errorf("Expected 7 applied commands, got %d", applied)
+	}
+	if commands.Len() != 0 {
+		t.Errorf("Buffer should be empty after playback, got %d", commands.Len())
+	}
+	if world.GetEntityCount() != 4 || world.CountEntitiesWith(core.MaskHealth) != 1 || world.CountEntitiesWith(core.MaskVelocity) != 0 {
+		t.Errorf("Unexpected world after playback: %d entities, %d with Health, %d with Velocity",
+			world.GetEntityCount(), world.CountEntitiesWith(core.MaskHealth), world.CountEntitiesWith(core.MaskVelocity))
+	}
+}
+
+// queueingSystem ставит команду в очередь; следующая система проверяет что она применена
+type queueingSystem struct {
+	apply func(world *core.World)
+}
+
+func (s *queueingSystem) Update(world *core.World, _ float32) {
+	s.apply(world)
+}
+
+// TestSystemManagerFlushesCommandsAfterEachSystem проверяет точку синхронизации после каждой системы
+func TestSystemManagerFlushesCommandsAfterEachSystem(t *testing.T) {
+	t.Parallel()
+
+	world := core.NewWorld(640, 640, 1)
+	wet, _ := core.RegisterComponent[wetness](world, "wetness")
+	entity := world.CreateEntity()
+
+	seenByNext := false
+	manager := core.NewSystemManager()
+	manager.AddSystem(&queueingSystem{apply: func(w *core.World) {
+		core.DeferAdd(w.Commands(), wet, entity, wetness{Level: 1})
+		if wet.Has(entity) {
+			t.Error("Deferred component should not be visible inside the same system")
+		}
+	}})
+	manager.AddSystem(&queueingSystem{apply: func(w *core.World) {
+		seenByNext = wet.Has(entity)
+		core.DeferRemove(w.Commands(), wet, entity)
+	}})
+	manager.Update(world, 1.0/60.0)
+
+	if !seenByNext {
+		t.Error("Next system should see the component added by the previous one")
+	}
+	if wet.Has(entity) || world.Commands().Len() != 0 {
+		t.Error("Commands of the last system should be applied by the end of the tick")
+	}
+}
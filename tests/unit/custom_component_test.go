@@ -0,0 +1,184 @@
+package unit
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/aiseeq/savanna/internal/core"
+)
+
+// wetness тестовый пользовательский компонент
+type wetness struct {
+	Level float32
+}
+
+// scent тестовый компонент, который нельзя закодировать в JSON
+type scent struct {
+	Fade func() float32
+}
+
+// TestCustomComponentLifecycle проверяет Add/Get/Set/Remove и участие компонента в запросах
+func TestCustomComponentLifecycle(t *testing.T) {
+	t.Parallel()
+
+	world := core.NewWorld(640, 640, 1)
+	wet, err := core.RegisterComponent[wetness](world, "wetness")
+	if err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+	if again, _ := core.RegisterComponent[wetness](world, "wetness"); again.Mask() != wet.Mask() {
+		t.Error("Repeated registration should return the same component")
+	}
+
+	dry := world.CreateEntity()
+	world.AddPosition(dry, core.NewPosition(10, 10))
+	soaked := world.CreateEntity()
+	world.AddPosition(soaked, core.NewPosition(20, 20))
+
+	world.Query(core.MaskPosition | wet.Mask()) // Запрос зарегистрирован до добавления - обновляется инкрементально
+	if !wet.Add(soaked, wetness{Level: 0.5}) {
+		t.Fatal("Add should succeed for live entity")
+	}
+
+	if value, ok := wet.Get(soaked); !ok || value.Level != 0.5 {
+		t.Errorf("Expected level 0.5, got %+v (ok=%v)", value, ok)
+	}
+	if _, ok := wet.Get(dry); ok || wet.Set(dry, wetness{Level: 1}) {
+		t.Error("Entity without the component should not expose it")
+	}
+	if !world.HasComponents(soaked, core.MaskPosition|wet.Mask()) || world.HasComponents(dry, core.MaskPosition|wet.Mask()) {
+		t.Error("HasComponents should account for custom component")
+	}
+	if found := world.GetEntitiesWith(core.MaskPosition | wet.Mask()); len(found) != 1 || found[0] != soaked {
+		t.Errorf("Query should return only soaked entity, got %v", found)
+	}
+
+	wet.Set(soaked, wetness{Level: 1})
+	if value, _ := wet.Get(soaked); value.Level != 1 {
+		t.Errorf("Set should update value, got %+v", value)
+	}
+	if !wet.Remove(soaked) || wet.Has(soaked) || world.CountEntitiesWith(wet.Mask()) != 0 {
+		t.Error("Remove should drop the component from entity and queries")
+	}
+}
+
+// TestCustomComponentStaleHandlesAndClear проверяет очистку при уничтожении сущности и World.Clear
+func TestCustomComponentStaleHandlesAndClear(t *testing.T) {
+	t.Parallel()
+
+	world := core.NewWorld(640, 640, 1)
+	wet, _ := core.RegisterComponent[wetness](world, "wetness")
+
+	stale := world.CreateEntity()
+	wet.Add(stale, wetness{Level: 1})
+	world.DestroyEntity(stale)
+
+	fresh := world.CreateEntity()
+	if fresh.Index() != stale.Index() {
+		t.Fatalf("Test expects slot reuse, got slots %d and %d", stale.Index(), fresh.Index())
+	}
+	if wet.Has(fresh) {
+		t.Error("Destroyed entity's component should not leak to the reused slot")
+	}
+	if wet.Add(stale, wetness{Level: 2}) || wet.Has(fresh) {
+		t.Error("Stale handle should not add component")
+	}
+
+	// Компонент за пределами первого блока хранилищ
+	var far core.EntityID
+	for i := 0; i < core.EntityChunkSize*2; i++ {
+		far = world.CreateEntity()
+	}
+	if !wet.Add(far, wetness{Level: 3}) {
+		t.Fatal("Add should grow custom storage")
+	}
+
+	world.Clear()
+	entity := world.CreateEntity()
+	if wet.Has(entity) || world.CountEntitiesWith(wet.Mask()) != 0 {
+		t.Error("Clear should remove custom component values")
+	}
+	if !wet.Add(entity, wetness{Level: 4}) {
+		t.Error("Registration should survive Clear")
+	}
+}
+
+// TestCustomComponentSnapshot проверяет что значения пользовательского компонента переживают снапшот
+func TestCustomComponentSnapshot(t *testing.T) {
+	t.Parallel()
+
+	world := core.NewWorld(640, 640, 1)
+	wet, _ := core.RegisterComponent[wetness](world, "wetness")
+	soaked := world.CreateEntity()
+	wet.Add(soaked, wetness{Level: 0.25})
+	gone := world.CreateEntity()
+	wet.Add(gone, wetness{Level: 1})
+	world.DestroyEntity(gone)
+	dry := world.CreateEntity()
+
+	saved, err := world.CreateSnapshot()
+	if err != nil {
+		t.Fatalf("CreateSnapshot: %v", err)
+	}
+
+	var buffer bytes.Buffer
+	var decoded core.WorldSnapshot
+	if err := gob.NewEncoder(&buffer).Encode(saved); err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	if err := gob.NewDecoder(&buffer).Decode(&decoded); err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+
+	restored, err := core.NewWorldFromSnapshot(&decoded)
+	if err != nil {
+		t.Fatalf("NewWorldFromSnapshot: %v", err)
+	}
+
+	// Компонент ещё не зарегистрирован - повторное сохранение не теряет его значения
+	if resaved, err := restored.CreateSnapshot(); err != nil || !reflect.DeepEqual(resaved.Custom, saved.Custom) {
+		t.Errorf("Unregistered component values should be kept, got %+v (err %v)", resaved, err)
+	}
+
+	restoredWet, err := core.RegisterComponent[wetness](restored, "wetness")
+	if err != nil {
+		t.Fatalf("Register after restore failed: %v", err)
+	}
+	if value, ok := restoredWet.Get(soaked); !ok || value.Level != 0.25 {
+		t.Errorf("Restored value should be 0.25, got %+v (ok=%v)", value, ok)
+	}
+	if restoredWet.Has(dry) || restored.CountEntitiesWith(restoredWet.Mask()) != 1 {
+		t.Error("Only the entity that had the component should get it back")
+	}
+}
+
+// TestCustomComponentNamesAndEncoding проверяет ошибки имён регистрации и некодируемых значений
+func TestCustomComponentNamesAndEncoding(t *testing.T) {
+	t.Parallel()
+
+	world := core.NewWorld(640, 640, 1)
+	if _, err := core.RegisterComponent[wetness](world, ""); !errors.Is(err, core.ErrComponentName) {
+		t.Errorf("Empty name should be rejected, got %v", err)
+	}
+	if _, err := core.RegisterComponent[wetness](world, "wetness"); err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+	if _, err := core.RegisterComponent[wetness](world, "damp"); !errors.Is(err, core.ErrComponentName) {
+		t.Errorf("Same type under another name should be rejected, got %v", err)
+	}
+	if _, err := core.RegisterComponent[scent](world, "wetness"); !errors.Is(err, core.ErrComponentName) {
+		t.Errorf("Name of another type should be rejected, got %v", err)
+	}
+
+	smell, err := core.RegisterComponent[scent](world, "scent")
+	if err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+	smell.Add(world.CreateEntity(), scent{Fade: func() float32 { return 0 }})
+	if _, err := world.CreateSnapshot(); err == nil {
+		t.Error("Snapshot should fail instead of dropping a value it cannot encode")
+	}
+}
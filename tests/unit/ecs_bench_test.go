@@ -1,6 +1,7 @@
 package unit
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/aiseeq/savanna/internal/core"
@@ -14,7 +15,7 @@ func BenchmarkEntityCreation(b *testing.B) {
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
-		// Ограничиваем количество сущностей, чтобы не превысить MaxEntities
+		// Периодически очищаем мир, чтобы измерять создание, а не рост хранилищ
 		if i%500 == 0 && i > 0 {
 			world.Clear() // Очищаем каждые 500 итераций
 		}
@@ -315,6 +316,156 @@ func BenchmarkFullGameLoop(b *testing.B) {
 	}
 }
 
+// largeWorldSizes размеры мира для бенчмарков без лимита сущностей
+var largeWorldSizes = []int{10000, 50000}
+
+// populateLargeWorld создаёт count движущихся сущностей, половина с Health
+func populateLargeWorld(count int) *core.World {
+	world := core.NewWorld(1000, 1000, 42)
+	for i := 0; i < count; i++ {
+		entity := world.CreateEntity()
+		world.AddPosition(entity, core.Position{X: float32(i % 1000), Y: float32(i / 1000 % 1000)})
+		world.AddVelocity(entity, core.Velocity{X: float32(i%10 - 5), Y: float32(i%7 - 3)})
+		if i%2 == 0 {
+			world.AddHealth(entity, core.Health{Current: 100, Max: 100})
+		}
+	}
+	return world
+}
+
+// BenchmarkLargeWorldCreate бенчмарк создания 10k и 50k сущностей с компонентами (включая рост хранилищ)
+func BenchmarkLargeWorldCreate(b *testing.B) {
+	for _, count := range largeWorldSizes {
+		b.Run(fmt.Sprintf("%d", count), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				world := populateLargeWorld(count)
+				if world.GetEntityCount() != count {
+					b.Fatalf("Expected %d entities, got %d", count, world.GetEntityCount())
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkLargeWorldHasComponent бенчмарк проверки наличия компонента на 10k и 50k сущностях
+func BenchmarkLargeWorldHasComponent(b *testing.B) {
+	for _, count := range largeWorldSizes {
+		b.Run(fmt.Sprintf("%d", count), func(b *testing.B) {
+			world := populateLargeWorld(count)
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				_ = world.HasComponent(core.EntityID(i%count+1), core.MaskHealth)
+			}
+		})
+	}
+}
+
+// BenchmarkLargeWorldMovement бенчмарк прохода системы движения по 10k и 50k сущностям
+func BenchmarkLargeWorldMovement(b *testing.B) {
+	for _, count := range largeWorldSizes {
+		b.Run(fmt.Sprintf("%d", count), func(b *testing.B) {
+			world := populateLargeWorld(count)
+			deltaTime := float32(1.0 / 60.0)
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				world.ForEachWith(core.MaskPosition|core.MaskVelocity, func(entity core.EntityID) {
+					pos, _ := world.GetPosition(entity)
+					vel, _ := world.GetVelocity(entity)
+					pos.X += vel.X * deltaTime
+					pos.Y += vel.Y * deltaTime
+					world.SetPosition(entity, pos)
+				})
+			}
+		})
+	}
+}
+
+// populateLargeWorldWithCorpses добавляет компонент Corpse каждой сотой сущности (редкий компонент)
+func populateLargeWorldWithCorpses(count int) *core.World {
+	world := populateLargeWorld(count)
+	for i := 1; i <= count; i += 100 {
+		world.AddCorpse(core.EntityID(i), core.Corpse{NutritionalValue: 50})
+	}
+	return world
+}
+
+// BenchmarkLargeWorldQueryScan полный перебор сущностей с проверкой масок (как до кэширования запросов)
+func BenchmarkLargeWorldQueryScan(b *testing.B) {
+	for _, count := range largeWorldSizes {
+		b.Run(fmt.Sprintf("%d", count), func(b *testing.B) {
+			world := populateLargeWorldWithCorpses(count)
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				found := 0
+				world.ForEach(func(entity core.EntityID) {
+					if world.HasComponents(entity, core.MaskCorpse|core.MaskPosition) {
+						found++
+					}
+				})
+				if found != (count+99)/100 {
+					b.Fatalf("Expected %d corpses, got %d", (count+99)/100, found)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkLargeWorldQueryCached тот же запрос через зарегистрированный Query
+func BenchmarkLargeWorldQueryCached(b *testing.B) {
+	for _, count := range largeWorldSizes {
+		b.Run(fmt.Sprintf("%d", count), func(b *testing.B) {
+			world := populateLargeWorldWithCorpses(count)
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				found := 0
+				world.ForEachWith(core.MaskCorpse|core.MaskPosition, func(entity core.EntityID) {
+					found++
+				})
+				if found != (count+99)/100 {
+					b.Fatalf("Expected %d corpses, got %d", (count+99)/100, found)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkLargeWorldCountCached подсчёт через запрос - O(1) вместо перебора
+func BenchmarkLargeWorldCountCached(b *testing.B) {
+	for _, count := range largeWorldSizes {
+		b.Run(fmt.Sprintf("%d", count), func(b *testing.B) {
+			world := populateLargeWorldWithCorpses(count)
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				_ = world.CountEntitiesWith(core.MaskHealth)
+			}
+		})
+	}
+}
+
+// BenchmarkLargeWorldQueryChurn стоимость инкрементального обновления запросов при смене компонентов
+func BenchmarkLargeWorldQueryChurn(b *testing.B) {
+	for _, count := range largeWorldSizes {
+		b.Run(fmt.Sprintf("%d", count), func(b *testing.B) {
+			world := populateLargeWorldWithCorpses(count)
+			world.Query(core.MaskCorpse | core.MaskPosition)
+			world.Query(core.MaskPosition | core.MaskVelocity)
+			world.Query(core.MaskHealth)
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				entity := core.EntityID(i%count + 1)
+				world.AddEatingState(entity, core.EatingState{})
+				world.RemoveEatingState(entity)
+			}
+		})
+	}
+}
+
 // BenchmarkEntityManager бенчмарки для EntityManager
 func BenchmarkEntityManagerCreate1000(b *testing.B) {
 	for i := 0; i < b.N; i++ {
@@ -209,3 +209,45 @@ func TestDestroyEntityCleanup(t *testing.T) {
 		t.Errorf("Expected 0 entities with position after destruction, got %d", len(entities))
 	}
 }
+
+// TestWorldComponentsBeyondChunk тестирует компоненты сущностей за пределами начального блока хранилищ
+func TestWorldComponentsBeyondChunk(t *testing.T) {
+	t.Parallel()
+
+	world := core.NewWorld(1000, 1000, 42)
+	const count = 2*core.EntityChunkSize + 100
+
+	entities := make([]core.EntityID, count)
+	for i := range entities {
+		entities[i] = world.CreateEntity()
+		world.AddPosition(entities[i], core.Position{X: float32(i % 1000), Y: float32(i / 1000)})
+		if i%2 == 0 {
+			world.AddHealth(entities[i], core.Health{Current: int16(i % 100), Max: 100})
+		}
+	}
+
+	// Первые сущности сохраняют данные после роста хранилищ
+	if pos, ok := world.GetPosition(entities[1]); !ok || pos.X != 1 {
+		t.Errorf("First entities should keep components after growth, got %+v", pos)
+	}
+
+	last := entities[count-1]
+	if pos, ok := world.GetPosition(last); !ok || pos.X != float32((count-1)%1000) {
+		t.Errorf("Last entity position mismatch: %+v", pos)
+	}
+	if world.HasComponent(last, core.MaskHealth) {
+		t.Error("Odd entity should not have Health")
+	}
+	if world.CountEntitiesWith(core.MaskHealth) != count/2 {
+		t.Errorf("Expected %d entities with Health, got %d", count/2, world.CountEntitiesWith(core.MaskHealth))
+	}
+
+	// Проверки несуществующих ID за пределами хранилищ безопасны
+	beyond := core.EntityID(count * 10)
+	if world.HasComponent(beyond, core.MaskPosition) || world.IsAlive(beyond) {
+		t.Error("Entity beyond storage should have no components")
+	}
+	if world.DestroyEntity(beyond) {
+		t.Error("Destroying entity beyond storage should fail")
+	}
+}
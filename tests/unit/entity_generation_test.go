@@ -0,0 +1,176 @@
+package unit
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/aiseeq/savanna/internal/core"
+	"github.com/aiseeq/savanna/internal/simulation"
+)
+
+// TestWorldRejectsStaleHandles проверяет что устаревший дескриптор не видит и не меняет новую сущность слота
+func TestWorldRejectsStaleHandles(t *testing.T) {
+	t.Parallel()
+
+	world := core.NewWorld(640, 640, 1)
+	stale := world.CreateEntity()
+	world.AddPosition(stale, core.NewPosition(10, 10))
+	world.DestroyEntity(stale)
+
+	fresh := world.CreateEntity()
+	world.AddPosition(fresh, core.NewPosition(50, 50))
+	world.AddHealth(fresh, core.Health{Current: 100, Max: 100})
+	if fresh.Index() != stale.Index() {
+		t.Fatalf("Test expects slot reuse, got slots %d and %d", stale.Index(), fresh.Index())
+	}
+
+	if world.IsAlive(stale) || world.HasComponent(stale, core.MaskPosition) || world.HasComponents(stale, 0) {
+		t.Error("Stale handle should not be alive or see components")
+	}
+	if _, ok := world.GetPosition(stale); ok {
+		t.Error("GetPosition should reject stale handle")
+	}
+	if world.SetHealth(stale, core.Health{Current: 1, Max: 100}) || world.AddVelocity(stale, core.NewVelocity(1, 1)) {
+		t.Error("Set and Add should reject stale handle")
+	}
+	if world.RemovePosition(stale) || world.DestroyEntity(stale) {
+		t.Error("Remove and DestroyEntity should reject stale handle")
+	}
+
+	health, _ := world.GetHealth(fresh)
+	if !world.IsAlive(fresh) || health.Current != 100 || world.HasComponent(fresh, core.MaskVelocity) {
+		t.Errorf("Fresh entity should be untouched by stale handle, health %+v", health)
+	}
+	if found := world.QueryInRadius(50, 50, 5); len(found) != 1 || found[0] != fresh {
+		t.Errorf("Spatial query should return fresh handle, got %v", found)
+	}
+
+	// Поколения переживают снапшот: устаревший дескриптор остаётся мёртвым, free-list выдаёт новое поколение
+	world.DestroyEntity(fresh)
+	restored, err := core.NewWorldFromSnapshot(captureWorld(t, world))
+	if err != nil {
+		t.Fatalf("Restore failed: %v", err)
+	}
+	if restored.IsAlive(stale) || restored.IsAlive(fresh) {
+		t.Error("Destroyed handles should stay dead after restore")
+	}
+	if next := restored.CreateEntity(); next.Index() != fresh.Index() || next.Generation() != fresh.Generation()+1 {
+		t.Errorf("Restored free-list should continue generations, got slot %d gen %d", next.Index(), next.Generation())
+	}
+}
+
+// TestEntityChurnReferencesNeverAlias массово создаёт и удаляет сущности со ссылками друг на друга
+// Каждая ссылка должна либо указывать на исходную цель, либо считаться мёртвой - но не на чужую сущность
+func TestEntityChurnReferencesNeverAlias(t *testing.T) {
+	t.Parallel()
+
+	const steps = 20000
+	world := core.NewWorld(6400, 6400, 1)
+	rng := rand.New(rand.NewSource(42))
+
+	serials := make(map[core.EntityID]float32) // Живая сущность -> уникальный серийный номер в Position.X
+	var live []core.EntityID
+	var dead []core.EntityID
+	targets := make(map[core.EntityID]core.EntityID) // Кто на кого ссылается через AttackState.Target
+	targetSerials := make(map[core.EntityID]float32)
+	serial := float32(0)
+
+	for step := 0; step < steps; step++ {
+		if len(live) == 0 || rng.Intn(100) < 55 {
+			serial++
+			entity := world.CreateEntity()
+			world.AddPosition(entity, core.NewPosition(serial, 0))
+			serials[entity] = serial
+
+			if len(live) > 0 {
+				target := live[rng.Intn(len(live))]
+				world.AddAttackState(entity, core.AttackState{Target: target})
+				targets[entity] = target
+				targetSerials[entity] = serials[target]
+			}
+			live = append(live, entity)
+		} else {
+			i := rng.Intn(len(live))
+			entity := live[i]
+			if !world.DestroyEntity(entity) {
+				t.Fatalf("Step %d: failed to destroy live entity %d", step, entity)
+			}
+			live[i] = live[len(live)-1]
+			live = live[:len(live)-1]
+			dead = append(dead, entity)
+			delete(serials, entity)
+			delete(targets, entity)
+		}
+	}
+
+	for _, entity := range dead {
+		if world.IsAlive(entity) || world.HasComponent(entity, core.MaskPosition) {
+			t.Fatalf("Destroyed handle %d (slot %d) is still visible", entity, entity.Index())
+		}
+	}
+
+	liveSlots := make(map[uint32]bool, len(live))
+	for _, entity := range live {
+		liveSlots[entity.Index()] = true
+	}
+
+	reused := 0
+	for entity, target := range targets {
+		attack, _ := world.GetAttackState(entity)
+		if attack.Target != target {
+			t.Fatalf("Reference of %d changed", entity)
+		}
+
+		pos, alive := world.GetPosition(target)
+		if alive && pos.X != targetSerials[entity] {
+			t.Fatalf("Reference of %d aliases another entity: serial %.0f, expected %.0f",
+				entity, pos.X, targetSerials[entity])
+		}
+		if !alive && world.IsAlive(target) {
+			t.Fatalf("Dead target %d reported alive", target)
+		}
+		if !alive && liveSlots[target.Index()] {
+			reused++ // Слот мёртвой цели занят другой сущностью - именно здесь раньше был алиасинг
+		}
+	}
+
+	if reused == 0 {
+		t.Error("Churn should reuse slots of referenced entities, otherwise the test proves nothing")
+	}
+	if world.GetEntityCount() != len(live) {
+		t.Errorf("Expected %d live entities, got %d", len(live), world.GetEntityCount())
+	}
+}
+
+// TestEatingIgnoresRecycledCorpse проверяет что волк не доедает новый труп в слоте съеденного
+func TestEatingIgnoresRecycledCorpse(t *testing.T) {
+	t.Parallel()
+
+	world := core.NewWorld(640, 640, 1)
+	eating := simulation.NewEatingSystem()
+
+	wolf := simulation.CreateAnimal(world, core.TypeWolf, 100, 100)
+	world.SetSatiation(wolf, core.Satiation{Value: 10})
+
+	rabbit := simulation.CreateAnimal(world, core.TypeRabbit, 500, 500)
+	eaten := simulation.CreateCorpseAndGetID(world, rabbit)
+	world.AddEatingState(wolf, core.EatingState{Target: eaten, TargetType: core.EatingTargetAnimal})
+	world.DestroyEntity(eaten)
+
+	// Новый труп далеко от волка занимает тот же слот
+	other := simulation.CreateAnimal(world, core.TypeRabbit, 600, 600)
+	recycled := simulation.CreateCorpseAndGetID(world, other)
+	if recycled.Index() != eaten.Index() {
+		t.Fatalf("Test expects slot reuse, got slots %d and %d", eaten.Index(), recycled.Index())
+	}
+	before, _ := world.GetCorpse(recycled)
+
+	eating.Update(world, 1.0/60.0)
+
+	if state, isEating := world.GetEatingState(wolf); isEating && state.Target == eaten {
+		t.Error("Wolf should stop eating the destroyed corpse")
+	}
+	if after, _ := world.GetCorpse(recycled); after.NutritionalValue != before.NutritionalValue {
+		t.Errorf("Recycled corpse should not be eaten: %.1f -> %.1f", before.NutritionalValue, after.NutritionalValue)
+	}
+}
@@ -100,7 +100,7 @@ func TestEntityManagerDestroyEntity(t *testing.T) {
 	}
 }
 
-// TestEntityManagerReuseIDs тестирует переиспользование ID
+// TestEntityManagerReuseIDs тестирует переиспользование слотов с новым поколением
 func TestEntityManagerReuseIDs(t *testing.T) {
 	t.Parallel()
 
@@ -110,10 +110,16 @@ func TestEntityManagerReuseIDs(t *testing.T) {
 	entity1 := em.CreateEntity()
 	em.DestroyEntity(entity1)
 
-	// Создаём новую сущность - должна переиспользовать ID
+	// Создаём новую сущность - слот переиспользуется, но дескриптор другой
 	entity2 := em.CreateEntity()
-	if entity2 != entity1 {
-		t.Error("Expected ID reuse")
+	if entity2.Index() != entity1.Index() {
+		t.Error("Expected slot reuse")
+	}
+	if entity2 == entity1 || entity2.Generation() != entity1.Generation()+1 {
+		t.Errorf("Reused slot should get next generation: %d -> %d", entity1.Generation(), entity2.Generation())
+	}
+	if em.IsAlive(entity1) {
+		t.Error("Stale handle must not be alive after slot reuse")
 	}
 
 	if em.Count() != 1 {
@@ -121,23 +127,27 @@ func TestEntityManagerReuseIDs(t *testing.T) {
 	}
 }
 
-// TestEntityManagerEntityLimit тестирует лимит сущностей
-func TestEntityManagerEntityLimit(t *testing.T) {
+// TestEntityManagerGrowsBeyondChunk тестирует рост хранилища сущностей без фиксированного лимита
+func TestEntityManagerGrowsBeyondChunk(t *testing.T) {
 	t.Parallel()
 
 	em := core.NewEntityManager()
+	const count = 3*core.EntityChunkSize + 17
 
-	// Создаём максимальное количество сущностей
-	for i := 0; i < core.MaxEntities-1; i++ {
+	for i := 0; i < count; i++ {
 		entity := em.CreateEntity()
 		if entity == core.InvalidEntity {
 			t.Fatalf("Failed to create entity %d", i)
 		}
 	}
 
-	// Следующая сущность должна вернуть InvalidEntity
-	entity := em.CreateEntity()
-	if entity != core.InvalidEntity {
-		t.Error("Expected InvalidEntity when hitting limit")
+	if em.Count() != count {
+		t.Errorf("Expected %d entities, got %d", count, em.Count())
+	}
+	if alive := em.GetAliveEntities(nil); len(alive) != count || alive[count-1] != core.EntityID(count) {
+		t.Errorf("Expected %d alive entities ending with ID %d, got %d", count, count, len(alive))
+	}
+	if em.IsAlive(core.EntityID(count + 1)) {
+		t.Error("Entity beyond the issued range should not be alive")
 	}
 }
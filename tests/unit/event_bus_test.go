@@ -0,0 +1,145 @@
+package unit
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aiseeq/savanna/config"
+	"github.com/aiseeq/savanna/internal/core"
+	"github.com/aiseeq/savanna/internal/generator"
+	"github.com/aiseeq/savanna/internal/pipeline"
+)
+
+// TestEventBusDeliversTypedEvents проверяет порядок доставки, вложенные события и отписку
+func TestEventBusDeliversTypedEvents(t *testing.T) {
+	t.Parallel()
+
+	world := core.NewWorld(640, 640, 1)
+	world.EmitEvent(core.Birth{Child: 1}) // Подписчиков нет - событие отбрасывается
+	if world.Events().Pending() != 0 {
+		t.Fatal("Events without subscribers should be dropped")
+	}
+
+	var log []string
+	unsubscribeBirth := core.Subscribe(world.Events(), func(event core.Birth) {
+		log = append(log, "birth")
+		if event.Child == 2 {
+			world.EmitEvent(core.GrassDepleted{TileX: 3}) // Доставляется в том же Dispatch
+		}
+	})
+	core.Subscribe(world.Events(), func(event core.GrassDepleted) {
+		log = append(log, "grass")
+	})
+	world.Events().SubscribeAll(func(event core.Event) {
+		log = append(log, "all:"+reflect.TypeOf(event).Name())
+	})
+
+	world.EmitEvent(core.Birth{Child: 2})
+	world.EmitEvent(core.AttackLanded{Damage: 5})
+	if delivered := world.DispatchEvents(); delivered != 3 {
+		t.Errorf("Expected 3 delivered events, got %d", delivered)
+	}
+
+	expected := []string{"birth", "all:Birth", "all:AttackLanded", "grass", "all:GrassDepleted"}
+	if !reflect.DeepEqual(log, expected) {
+		t.Errorf("Unexpected delivery order %v", log)
+	}
+
+	log = nil
+	unsubscribeBirth()
+	world.EmitEvent(core.Birth{Child: 4})
+	world.DispatchEvents()
+	if !reflect.DeepEqual(log, []string{"all:Birth"}) {
+		t.Errorf("Unsubscribed handler should not be called, got %v", log)
+	}
+}
+
+// TestEatingStateEmitsEvents проверяет парность EatingStarted/EatingFinished, включая уничтожение едящего
+func TestEatingStateEmitsEvents(t *testing.T) {
+	t.Parallel()
+
+	world := core.NewWorld(640, 640, 1)
+	var started, finished []core.EntityID
+	core.Subscribe(world.Events(), func(event core.EatingStarted) { started = append(started, event.Target) })
+	core.Subscribe(world.Events(), func(event core.EatingFinished) { finished = append(finished, event.Target) })
+
+	eater := world.CreateEntity()
+	world.AddEatingState(eater, core.EatingState{Target: 7, TargetType: core.EatingTargetAnimal})
+	world.AddEatingState(eater, core.EatingState{Target: 8, TargetType: core.EatingTargetAnimal}) // Смена цели
+	world.RemoveEatingState(eater)
+	world.RemoveEatingState(eater) // Уже не ест - события нет
+	world.AddEatingState(eater, core.EatingState{TargetType: core.EatingTargetGrass})
+	world.DestroyEntity(eater)
+	world.DispatchEvents()
+
+	if !reflect.DeepEqual(started, []core.EntityID{7, 8, 0}) || !reflect.DeepEqual(finished, []core.EntityID{7, 8, 0}) {
+		t.Errorf("Unexpected eating events: started %v, finished %v", started, finished)
+	}
+}
+
+// TestPipelineEmitsSimulationEvents проверяет события настоящего прогона: согласованность и доставку в том же тике
+func TestPipelineEmitsSimulationEvents(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.LoadDefaultConfig()
+	cfg.World.Size = 24
+	cfg.Population.Rabbits = 20
+	cfg.Population.Wolves = 3
+	cfg.Species.Dir = ""
+
+	terrain := generator.NewTerrainGenerator(cfg).Generate()
+	world := core.NewWorld(float32(terrain.Width), float32(terrain.Height), cfg.World.Seed)
+	worldWidth, worldHeight := world.GetWorldDimensions()
+	simPipeline := pipeline.New(terrain, worldWidth, worldHeight)
+	if _, _, err := pipeline.Populate(world, terrain, cfg, nil); err != nil {
+		t.Fatalf("Population should be placed: %v", err)
+	}
+
+	counts := make(map[string]int)
+	hits := make(map[core.EntityID]bool) // Цели, получившие удар
+	eating := make(map[core.EntityID]bool)
+	world.Events().SubscribeAll(func(event core.Event) {
+		counts[reflect.TypeOf(event).Name()]++
+	})
+	core.Subscribe(world.Events(), func(event core.AttackLanded) {
+		hits[event.Target] = true
+	})
+	core.Subscribe(world.Events(), func(event core.AnimalDied) {
+		// Событие доставляется до удаления: подписчик ещё видит мёртвое животное
+		health, ok := world.GetHealth(event.Entity)
+		if !world.IsAlive(event.Entity) || !ok || health.Current != 0 {
+			t.Errorf("Died animal %d should still be readable with zero health", event.Entity)
+		}
+		if event.Cause == core.DeathKilled && !hits[event.Entity] {
+			t.Errorf("Killed animal %d should have been hit by %d", event.Entity, event.Killer)
+		}
+	})
+	core.Subscribe(world.Events(), func(event core.EatingStarted) {
+		if eating[event.Eater] {
+			t.Errorf("Eater %d started eating twice without finishing", event.Eater)
+		}
+		eating[event.Eater] = true
+	})
+	core.Subscribe(world.Events(), func(event core.EatingFinished) {
+		if !eating[event.Eater] {
+			t.Errorf("Eater %d finished eating without starting", event.Eater)
+		}
+		delete(eating, event.Eater)
+	})
+
+	for tick := 0; tick < 3600; tick++ {
+		simPipeline.Update(world, 1.0/60.0)
+		if pending := world.Events().Pending(); pending != 0 {
+			t.Fatalf("All events should be delivered within the tick, %d pending", pending)
+		}
+	}
+
+	for _, name := range []string{"AttackLanded", "AnimalDied", "CorpseCreated", "EatingStarted", "EatingFinished", "GrassDepleted"} {
+		if counts[name] == 0 {
+			t.Errorf("Expected %s events during the run, got counts %v", name, counts)
+		}
+	}
+	if counts["CorpseCreated"] > counts["AnimalDied"] {
+		t.Errorf("Every corpse should come from a death: %v", counts)
+	}
+}
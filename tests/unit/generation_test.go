@@ -1,6 +1,7 @@
 package unit
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/aiseeq/savanna/config"
@@ -108,7 +109,10 @@ func TestPopulationGeneration(t *testing.T) {
 
 	// Генерируем популяцию
 	popGen := generator.NewPopulationGenerator(cfg, terrain)
-	placements := popGen.Generate()
+	placements, err := popGen.Generate()
+	if err != nil {
+		t.Fatalf("Population generation failed: %v", err)
+	}
 
 	// Проверяем количество животных
 	rabbits := 0
@@ -137,6 +141,48 @@ func TestPopulationGeneration(t *testing.T) {
 	}
 }
 
+// TestSpeciesPopulationGeneration проверяет размещение видов из файлов описаний по количеству особей
+// Не параллельный: регистрирует вид в общем реестре, от которого зависят типы видов в других тестах
+func TestSpeciesPopulationGeneration(t *testing.T) {
+	gazelle, err := core.RegisterAnimalType("gazelle")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := config.LoadDefaultConfig()
+	cfg.World.Size = 20
+	cfg.Population.Rabbits = 0
+	cfg.Population.Wolves = 0
+	cfg.Population.Species = []config.SpeciesPopulation{
+		{Name: "gazelle", Count: 7, GroupSize: 3},
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Species population config should be valid: %v", err)
+	}
+
+	terrain := generator.NewTerrainGenerator(cfg).Generate()
+	placements, err := generator.NewPopulationGenerator(cfg, terrain).Generate()
+	if err != nil {
+		t.Fatalf("Population generation failed: %v", err)
+	}
+
+	if len(placements) != 7 {
+		t.Fatalf("Expected 7 placements, got %d", len(placements))
+	}
+	for _, placement := range placements {
+		if placement.Type != gazelle {
+			t.Errorf("Expected gazelle placement, got %s", placement.Type)
+		}
+	}
+
+	// Незарегистрированный вид - ошибка конфигурации, а не молча пропущенная популяция
+	cfg.Population.Species = append(cfg.Population.Species, config.SpeciesPopulation{Name: "unknown_species", Count: 5})
+	placements, err = generator.NewPopulationGenerator(cfg, terrain).Generate()
+	if !errors.Is(err, generator.ErrUnknownSpecies) || len(placements) != 0 {
+		t.Errorf("Unknown species should fail generation, got %d placements and error %v", len(placements), err)
+	}
+}
+
 // TestAnimalPlacementValidation проверяет что животные не размещаются на воде/кустах
 func TestAnimalPlacementValidation(t *testing.T) {
 	t.Parallel()
@@ -153,7 +199,10 @@ func TestAnimalPlacementValidation(t *testing.T) {
 
 	// Генерируем популяцию
 	popGen := generator.NewPopulationGenerator(cfg, terrain)
-	placements := popGen.Generate()
+	placements, err := popGen.Generate()
+	if err != nil {
+		t.Fatalf("Population generation failed: %v", err)
+	}
 
 	// Проверяем что все животные на проходимых тайлах
 	for _, placement := range placements {
@@ -182,7 +231,10 @@ func TestWolfMinimumDistance(t *testing.T) {
 
 	// Генерируем популяцию
 	popGen := generator.NewPopulationGenerator(cfg, terrain)
-	placements := popGen.Generate()
+	placements, err := popGen.Generate()
+	if err != nil {
+		t.Fatalf("Population generation failed: %v", err)
+	}
 
 	// Находим всех волков
 	var wolfPlacements []generator.AnimalPlacement
@@ -239,6 +291,13 @@ func TestConfigValidation(t *testing.T) {
 	if err := invalidConfig3.Validate(); err == nil {
 		t.Error("Negative population should fail validation")
 	}
+
+	// Тест отрицательной популяции вида
+	invalidConfig4 := config.LoadDefaultConfig()
+	invalidConfig4.Population.Species = []config.SpeciesPopulation{{Name: "zebra", Count: -1}}
+	if err := invalidConfig4.Validate(); err == nil {
+		t.Error("Negative species population should fail validation")
+	}
 }
 
 // terrainsEqual проверяет идентичность двух карт
@@ -0,0 +1,92 @@
+package unit
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/aiseeq/savanna/internal/core"
+	"github.com/aiseeq/savanna/internal/gamestate"
+)
+
+// TestParallelSectorsMatchSerial проверяет что параллельная обработка секторов даёт
+// побитово тот же мир, что и последовательная, на каждом тике для многих seed
+func TestParallelSectorsMatchSerial(t *testing.T) {
+	t.Parallel()
+
+	const ticks = 600
+	for seed := int64(1); seed <= 16; seed++ {
+		config := newSnapshotTestConfig()
+		config.RandomSeed = seed
+
+		serial := gamestate.NewGameState(config)
+		parallel := gamestate.NewGameState(config)
+		parallel.GetWorld().SetParallelism(4)
+
+		for tick := 1; tick <= ticks; tick++ {
+			serial.Update()
+			parallel.Update()
+
+			expected, actual := serial.ComputeStateHash(false), parallel.ComputeStateHash(false)
+			if expected.Hash != actual.Hash {
+				t.Fatalf("Seed %d diverged at tick %d: serial %+v, parallel %+v", seed, tick, expected, actual)
+			}
+		}
+	}
+}
+
+// TestForEachSectorPartitionsEntities проверяет раскладку сущностей по секторам
+func TestForEachSectorPartitionsEntities(t *testing.T) {
+	t.Parallel()
+
+	world := core.NewWorld(320, 320, 1)
+	world.SetParallelism(4)
+
+	var entities []core.EntityID
+	for i := 0; i < 200; i++ {
+		entity := world.CreateEntity()
+		world.AddSatiation(entity, core.Satiation{Value: 50})
+		if i%10 != 0 {
+			world.AddPosition(entity, core.NewPosition(float32(i), float32(i*7%400)-40)) // Есть точки за границами
+		}
+		entities = append(entities, entity)
+	}
+
+	var visited [core.SectorCount][]core.EntityID
+	var total atomic.Int64
+	world.ForEachSector(core.MaskSatiation, func(sector int, sectorEntities []core.EntityID) {
+		visited[sector] = append([]core.EntityID(nil), sectorEntities...)
+		total.Add(int64(len(sectorEntities)))
+	})
+
+	if total.Load() != int64(len(entities)) {
+		t.Fatalf("Every entity should be visited once, got %d of %d", total.Load(), len(entities))
+	}
+	// Секторы - полосы по Y: каждая следующая непустая полоса ниже предыдущей
+	lastY, nonEmpty := float32(-1000), 0
+	for sector, sectorEntities := range visited {
+		if len(sectorEntities) > 0 {
+			nonEmpty++
+		}
+		sectorMaxY := lastY
+		for i, entity := range sectorEntities {
+			if i > 0 && entity.Index() <= sectorEntities[i-1].Index() {
+				t.Errorf("Sector %d should keep slot order", sector)
+			}
+			pos, ok := world.GetPosition(entity)
+			if !ok {
+				if sector != 0 {
+					t.Errorf("Entity %d without position should be in sector 0, got %d", entity, sector)
+				}
+				continue
+			}
+			if pos.Y < lastY {
+				t.Errorf("Entity %d at Y=%.1f overlaps previous sector (max Y %.1f)", entity, pos.Y, lastY)
+			}
+			sectorMaxY = max(sectorMaxY, pos.Y)
+		}
+		lastY = sectorMaxY
+	}
+	if nonEmpty < core.SectorCount/2 {
+		t.Errorf("Entities should spread over sectors, only %d non-empty", nonEmpty)
+	}
+}
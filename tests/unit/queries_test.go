@@ -1,6 +1,7 @@
 package unit
 
 import (
+	"math/rand"
 	"testing"
 
 	"github.com/aiseeq/savanna/internal/core"
@@ -144,3 +145,103 @@ func TestQueryGetStats(t *testing.T) {
 		t.Errorf("Expected %d grass in stats, got %d", expectedGrassCount, stats[core.TypeGrass])
 	}
 }
+
+// scanEntitiesWith полный перебор сущностей - эталон для зарегистрированных запросов
+func scanEntitiesWith(world *core.World, mask core.ComponentMask) []core.EntityID {
+	var result []core.EntityID
+	world.ForEach(func(entity core.EntityID) {
+		if world.HasComponents(entity, mask) {
+			result = append(result, entity)
+		}
+	})
+	return result
+}
+
+// TestCachedQueryMatchesFullScan проверяет что инкрементальные запросы совпадают с полным перебором
+// (состав и порядок) при создании, удалении сущностей и смене компонентов
+func TestCachedQueryMatchesFullScan(t *testing.T) {
+	t.Parallel()
+
+	world := core.NewWorld(1000, 1000, 1)
+	masks := []core.ComponentMask{
+		0,
+		core.MaskPosition,
+		core.MaskPosition | core.MaskVelocity,
+		core.MaskHealth | core.MaskCorpse,
+	}
+	for _, mask := range masks {
+		world.Query(mask) // Регистрируем до изменений - дальше запросы обновляются только инкрементально
+	}
+
+	rng := rand.New(rand.NewSource(7))
+	var live []core.EntityID
+	for step := 0; step < 5000; step++ {
+		switch op := rng.Intn(6); {
+		case op == 0 || len(live) == 0:
+			live = append(live, world.CreateEntity())
+		case op == 1:
+			i := rng.Intn(len(live))
+			world.DestroyEntity(live[i])
+			live[i] = live[len(live)-1]
+			live = live[:len(live)-1]
+		case op == 2:
+			world.AddPosition(live[rng.Intn(len(live))], core.NewPosition(1, 1))
+		case op == 3:
+			world.AddVelocity(live[rng.Intn(len(live))], core.NewVelocity(1, 1))
+			world.AddHealth(live[rng.Intn(len(live))], core.Health{Current: 1, Max: 1})
+		case op == 4:
+			world.AddCorpse(live[rng.Intn(len(live))], core.Corpse{})
+		default:
+			entity := live[rng.Intn(len(live))]
+			world.RemovePosition(entity)
+			world.RemoveCorpse(entity)
+		}
+
+		if step%250 != 0 {
+			continue
+		}
+		for _, mask := range masks {
+			expected := scanEntitiesWith(world, mask)
+			got := world.GetEntitiesWith(mask)
+			if len(got) != len(expected) || world.CountEntitiesWith(mask) != len(expected) {
+				t.Fatalf("Step %d mask %b: expected %d entities, got %d (count %d)",
+					step, mask, len(expected), len(got), world.CountEntitiesWith(mask))
+			}
+			for i := range expected {
+				if got[i] != expected[i] {
+					t.Fatalf("Step %d mask %b: order differs at %d: %d != %d", step, mask, i, got[i], expected[i])
+				}
+			}
+		}
+	}
+}
+
+// TestCachedQueryMutationDuringIteration проверяет обход при изменении компонентов внутри колбэка
+func TestCachedQueryMutationDuringIteration(t *testing.T) {
+	t.Parallel()
+
+	world := core.NewWorld(100, 100, 1)
+	entities := make([]core.EntityID, 4)
+	for i := range entities {
+		entities[i] = world.CreateEntity()
+		world.AddHealth(entities[i], core.Health{Current: 1, Max: 1})
+	}
+
+	var visited []core.EntityID
+	world.ForEachWith(core.MaskHealth, func(entity core.EntityID) {
+		visited = append(visited, entity)
+		if entity == entities[0] {
+			created := world.CreateEntity() // Новая сущность в конце - посещается, как при полном переборе
+			world.AddHealth(created, core.Health{Current: 1, Max: 1})
+			world.DestroyEntity(entities[1]) // Удалённая дальше по порядку сущность не посещается
+			world.RemoveHealth(entities[2])
+		}
+	})
+
+	if len(visited) != 3 || visited[0] != entities[0] || visited[1] != entities[3] {
+		t.Errorf("Expected entities 0, 3 and the created one, got %v", visited)
+	}
+	if world.CountEntitiesWith(core.MaskHealth) != 3 {
+		t.Errorf("Expected 3 entities with Health, got %d", world.CountEntitiesWith(core.MaskHealth))
+	}
+}
@@ -0,0 +1,123 @@
+package unit
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/aiseeq/savanna/internal/gamestate"
+)
+
+// Параметры тестов реплеев
+const (
+	replayTicks         = 600 // Тиков записи (10 секунд)
+	replayScrollTick    = 120 // Тик начала прокрутки камеры
+	replayDivergentTick = 450 // Тик, на котором подменяется записанный хэш
+)
+
+// recordTestReplay записывает реплей с прокруткой камеры правой кнопкой мыши
+func recordTestReplay(entityDetailed bool) *gamestate.Replay {
+	recorder := gamestate.NewReplayRecorder(newSnapshotTestConfig(), entityDetailed)
+
+	for tick := 0; tick < replayTicks; tick++ {
+		var events []gamestate.InputEvent
+		switch {
+		case tick == replayScrollTick:
+			events = []gamestate.InputEvent{{Type: gamestate.InputMouseDown, Button: gamestate.MouseButtonRight, X: 100, Y: 100}}
+		case tick > replayScrollTick && tick < replayScrollTick+30:
+			events = []gamestate.InputEvent{{Type: gamestate.InputMouseMove, X: float64(100 + tick - replayScrollTick), Y: 100}}
+		case tick == replayScrollTick+30:
+			events = []gamestate.InputEvent{{Type: gamestate.InputMouseUp, Button: gamestate.MouseButtonRight}}
+		}
+		recorder.Step(events)
+	}
+
+	return recorder.Replay()
+}
+
+// TestReplayVerifiesDeterministicRun проверяет что повторный прогон с тем же seed и вводом
+// даёт те же хэши на каждом тике (включая сохранённый в файл реплей)
+func TestReplayVerifiesDeterministicRun(t *testing.T) {
+	t.Parallel()
+
+	replay := recordTestReplay(false)
+	if len(replay.Hashes) != replayTicks {
+		t.Fatalf("Ожидалось %d хэшей, записано %d", replayTicks, len(replay.Hashes))
+	}
+	if len(replay.Events) != 31 {
+		t.Fatalf("Ожидалось 31 событие ввода, записано %d", len(replay.Events))
+	}
+
+	filename := filepath.Join(t.TempDir(), "run.replay.json")
+	if err := replay.SaveToFile(filename); err != nil {
+		t.Fatalf("SaveToFile: %v", err)
+	}
+	loaded, err := gamestate.LoadReplayFromFile(filename)
+	if err != nil {
+		t.Fatalf("LoadReplayFromFile: %v", err)
+	}
+
+	divergence, err := gamestate.VerifyReplay(loaded)
+	if err != nil {
+		t.Fatalf("VerifyReplay: %v", err)
+	}
+	if divergence != nil {
+		t.Fatalf("Детерминированный прогон разошёлся: %s", divergence)
+	}
+}
+
+// TestReplayReportsFirstDivergence проверяет что плеер указывает тик, сущность и компонент
+func TestReplayReportsFirstDivergence(t *testing.T) {
+	t.Parallel()
+
+	replay := recordTestReplay(true)
+
+	// Подменяем записанную позицию одной сущности - как если бы симуляция разошлась
+	recorded := &replay.Hashes[replayDivergentTick]
+	if len(recorded.Entities) < 2 {
+		t.Fatalf("Ожидались покомпонентные хэши сущностей, получено %d", len(recorded.Entities))
+	}
+	target := recorded.Entities[1]
+	recorded.Entities[1].Position ^= 1
+	recorded.Positions ^= 1
+	recorded.Hash ^= 1
+
+	divergence, err := gamestate.VerifyReplay(replay)
+	if err != nil {
+		t.Fatalf("VerifyReplay: %v", err)
+	}
+	if divergence == nil {
+		t.Fatal("Расхождение не обнаружено")
+	}
+
+	if divergence.Tick != recorded.Tick {
+		t.Errorf("Ожидался тик %d, получен %d", recorded.Tick, divergence.Tick)
+	}
+	if divergence.Entity != target.ID {
+		t.Errorf("Ожидалась сущность %d, получена %d", target.ID, divergence.Entity)
+	}
+	if divergence.Component != gamestate.DivergencePosition {
+		t.Errorf("Ожидался компонент %q, получен %q", gamestate.DivergencePosition, divergence.Component)
+	}
+}
+
+// TestReplayReportsCameraDivergence проверяет что пропущенный ввод обнаруживается
+func TestReplayReportsCameraDivergence(t *testing.T) {
+	t.Parallel()
+
+	replay := recordTestReplay(false)
+	replay.Events = replay.Events[:len(replay.Events)-2] // Теряем последний сдвиг мыши
+
+	divergence, err := gamestate.VerifyReplay(replay)
+	if err != nil {
+		t.Fatalf("VerifyReplay: %v", err)
+	}
+	if divergence == nil || divergence.Component != gamestate.DivergenceCamera {
+		t.Fatalf("Ожидалось расхождение камеры, получено: %v", divergence)
+	}
+
+	replay.Version = gamestate.ReplayFormatVersion + 1
+	if _, err := gamestate.VerifyReplay(replay); !errors.Is(err, gamestate.ErrReplayVersion) {
+		t.Errorf("Ожидалась ErrReplayVersion, получено: %v", err)
+	}
+}
@@ -0,0 +1,274 @@
+package unit
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/aiseeq/savanna/internal/core"
+	"github.com/aiseeq/savanna/internal/gamestate"
+	"github.com/aiseeq/savanna/internal/simulation"
+	"github.com/aiseeq/savanna/internal/snapshot"
+)
+
+// Параметры тестов снапшотов
+const (
+	snapshotWarmupTicks   = 900  // Тиков до снятия снапшота (15 секунд)
+	snapshotContinueTicks = 1200 // Тиков после загрузки (20 секунд)
+)
+
+func newSnapshotTestConfig() *gamestate.GameConfig {
+	return &gamestate.GameConfig{
+		WorldWidth:    640,
+		WorldHeight:   480,
+		FixedTimeStep: 1.0 / 60.0,
+		RandomSeed:    12345,
+	}
+}
+
+// runGameState выполняет заданное количество фиксированных шагов
+func runGameState(gs *gamestate.GameState, ticks int) {
+	for i := 0; i < ticks; i++ {
+		gs.Update()
+	}
+}
+
+// captureWorld снимает снапшот мира
+func captureWorld(t *testing.T, world *core.World) *core.WorldSnapshot {
+	t.Helper()
+
+	snapshot, err := world.CreateSnapshot()
+	if err != nil {
+		t.Fatalf("CreateSnapshot: %v", err)
+	}
+	return snapshot
+}
+
+// captureGameState снимает полный снапшот состояния игры
+func captureGameState(t *testing.T, gs *gamestate.GameState) *snapshot.Snapshot {
+	t.Helper()
+
+	snap, err := gs.CreateSnapshot()
+	if err != nil {
+		t.Fatalf("CreateSnapshot: %v", err)
+	}
+	return snap
+}
+
+// assertGameStatesIdentical сравнивает мир и ландшафт двух состояний игры
+func assertGameStatesIdentical(t *testing.T, expected, actual *gamestate.GameState) {
+	t.Helper()
+
+	expectedSnapshot := captureWorld(t, expected.GetWorld())
+	actualSnapshot := captureWorld(t, actual.GetWorld())
+
+	if len(expectedSnapshot.Entities) != len(actualSnapshot.Entities) {
+		t.Fatalf("Количество сущностей различается: %d vs %d",
+			len(expectedSnapshot.Entities), len(actualSnapshot.Entities))
+	}
+
+	for i := range expectedSnapshot.Entities {
+		if !reflect.DeepEqual(expectedSnapshot.Entities[i], actualSnapshot.Entities[i]) {
+			t.Fatalf("Сущность %d различается после продолжения симуляции", expectedSnapshot.Entities[i].ID)
+		}
+	}
+
+	if !reflect.DeepEqual(expectedSnapshot, actualSnapshot) {
+		t.Fatal("Состояние мира (время, RNG, free-list или spatial grid) различается")
+	}
+
+	if !reflect.DeepEqual(expected.GetTerrain().Grass, actual.GetTerrain().Grass) {
+		t.Fatal("Количество травы различается после продолжения симуляции")
+	}
+}
+
+// TestSnapshotBinaryContinuationIsBitIdentical проверяет что загруженный снапшот
+// продолжает симуляцию бит-в-бит так же, как оригинальный мир
+func TestSnapshotBinaryContinuationIsBitIdentical(t *testing.T) {
+	t.Parallel()
+
+	original := gamestate.NewGameState(newSnapshotTestConfig())
+	runGameState(original, snapshotWarmupTicks)
+
+	var buffer bytes.Buffer
+	if err := snapshot.Encode(&buffer, captureGameState(t, original)); err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+
+	loaded, err := snapshot.Decode(&buffer)
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+
+	restored, err := gamestate.NewGameStateFromSnapshot(newSnapshotTestConfig(), loaded)
+	if err != nil {
+		t.Fatalf("NewGameStateFromSnapshot: %v", err)
+	}
+
+	// Сразу после загрузки состояние должно совпадать
+	assertGameStatesIdentical(t, original, restored)
+
+	runGameState(original, snapshotContinueTicks)
+	runGameState(restored, snapshotContinueTicks)
+
+	assertGameStatesIdentical(t, original, restored)
+}
+
+// TestSnapshotJSONContinuationIsBitIdentical то же самое для JSON формата
+func TestSnapshotJSONContinuationIsBitIdentical(t *testing.T) {
+	t.Parallel()
+
+	original := gamestate.NewGameState(newSnapshotTestConfig())
+	runGameState(original, snapshotWarmupTicks)
+
+	var buffer bytes.Buffer
+	if err := snapshot.EncodeJSON(&buffer, captureGameState(t, original)); err != nil {
+		t.Fatalf("EncodeJSON: %v", err)
+	}
+
+	loaded, err := snapshot.DecodeJSON(&buffer)
+	if err != nil {
+		t.Fatalf("DecodeJSON: %v", err)
+	}
+
+	restored, err := gamestate.NewGameStateFromSnapshot(newSnapshotTestConfig(), loaded)
+	if err != nil {
+		t.Fatalf("NewGameStateFromSnapshot: %v", err)
+	}
+
+	runGameState(original, snapshotContinueTicks)
+	runGameState(restored, snapshotContinueTicks)
+
+	assertGameStatesIdentical(t, original, restored)
+}
+
+// TestSnapshotKeepsBalanceProfile проверяет что профиль баланса сохраняется в снапшоте
+// и загруженное состояние продолжает прогон с ним, даже если конфигурация загрузки его не задаёт
+func TestSnapshotKeepsBalanceProfile(t *testing.T) {
+	t.Parallel()
+
+	profile := simulation.DefaultBalanceProfile()
+	profile.Name = "snapshot-test"
+	profile.Satiation.DecreaseRate *= 4
+	wolf := profile.Animals["wolf"]
+	wolf.HitChance = simulation.BalanceValue(float32(0)) // Явный ноль должен пережить бинарный формат
+	profile.Animals["wolf"] = wolf
+
+	config := newSnapshotTestConfig()
+	config.Balance = profile
+	original := gamestate.NewGameState(config)
+	runGameState(original, snapshotWarmupTicks)
+
+	var buffer bytes.Buffer
+	if err := snapshot.Encode(&buffer, captureGameState(t, original)); err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	loaded, err := snapshot.Decode(&buffer)
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if !reflect.DeepEqual(loaded.Balance, profile) {
+		t.Fatalf("Профиль баланса изменился при сохранении: %+v", loaded.Balance)
+	}
+
+	restored, err := gamestate.NewGameStateFromSnapshot(newSnapshotTestConfig(), loaded)
+	if err != nil {
+		t.Fatalf("NewGameStateFromSnapshot: %v", err)
+	}
+
+	runGameState(original, snapshotContinueTicks)
+	runGameState(restored, snapshotContinueTicks)
+
+	assertGameStatesIdentical(t, original, restored)
+}
+
+// TestSnapshotPreservesFreeListAndRNG проверяет free-list менеджера сущностей и состояние RNG
+func TestSnapshotPreservesFreeListAndRNG(t *testing.T) {
+	t.Parallel()
+
+	world := core.NewWorld(320, 320, 7)
+	entities := make([]core.EntityID, 0, 5)
+	for i := 0; i < 5; i++ {
+		entity := world.CreateEntity()
+		world.AddPosition(entity, core.NewPosition(float32(i*10), float32(i*10)))
+		entities = append(entities, entity)
+	}
+	world.DestroyEntity(entities[1])
+	world.DestroyEntity(entities[3])
+
+	// Продвигаем RNG
+	for i := 0; i < 17; i++ {
+		world.GetRNG().Float32()
+	}
+
+	restored, err := core.NewWorldFromSnapshot(captureWorld(t, world))
+	if err != nil {
+		t.Fatalf("NewWorldFromSnapshot: %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		expected := world.CreateEntity()
+		actual := restored.CreateEntity()
+		if expected != actual {
+			t.Errorf("Переиспользование ID различается: %d vs %d", expected, actual)
+		}
+	}
+
+	for i := 0; i < 10; i++ {
+		if expected, actual := world.GetRNG().Int63(), restored.GetRNG().Int63(); expected != actual {
+			t.Fatalf("RNG разошёлся на шаге %d: %d vs %d", i, expected, actual)
+		}
+	}
+}
+
+// TestSnapshotRejectsInvalidData проверяет обработку повреждённых файлов
+func TestSnapshotRejectsInvalidData(t *testing.T) {
+	t.Parallel()
+
+	if _, err := snapshot.Decode(bytes.NewReader([]byte("NOTASNAPSHOT"))); !errors.Is(err, snapshot.ErrBadMagic) {
+		t.Errorf("Ожидалась ErrBadMagic, получено: %v", err)
+	}
+
+	var buffer bytes.Buffer
+	snap := captureGameState(t, gamestate.NewGameState(newSnapshotTestConfig()))
+	snap.Version = snapshot.FormatVersion + 1
+	if err := snapshot.Encode(&buffer, snap); err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	if _, err := snapshot.Decode(&buffer); !errors.Is(err, snapshot.ErrUnsupportedVersion) {
+		t.Errorf("Ожидалась ErrUnsupportedVersion, получено: %v", err)
+	}
+
+	broken := captureGameState(t, gamestate.NewGameState(newSnapshotTestConfig()))
+	broken.World.NextID = 1 // Живые сущности с ID >= NextID - противоречие
+	if _, _, err := broken.Restore(); !errors.Is(err, core.ErrInvalidSnapshot) {
+		t.Errorf("Ожидалась ErrInvalidSnapshot, получено: %v", err)
+	}
+}
+
+// TestSnapshotRestoresAnimalTypesByName проверяет сопоставление видов по имени, а не по номеру
+func TestSnapshotRestoresAnimalTypesByName(t *testing.T) {
+	t.Parallel()
+
+	world := core.NewWorld(320, 320, 7)
+	entity := world.CreateEntity()
+	world.AddAnimalType(entity, core.TypeRabbit)
+
+	// Тот же номер в другом наборе видов означает другой вид - решает имя из таблицы снапшота
+	renumbered := captureWorld(t, world)
+	renumbered.AnimalTypes[core.TypeRabbit] = core.TypeWolf.String()
+	restored, err := core.NewWorldFromSnapshot(renumbered)
+	if err != nil {
+		t.Fatalf("NewWorldFromSnapshot: %v", err)
+	}
+	if animalType, _ := restored.GetAnimalType(entity); animalType != core.TypeWolf {
+		t.Errorf("Тип должен восстановиться по имени %q, получено %v", core.TypeWolf, animalType)
+	}
+
+	unknown := captureWorld(t, world)
+	unknown.AnimalTypes[core.TypeRabbit] = "unregistered-species"
+	if _, err := core.NewWorldFromSnapshot(unknown); !errors.Is(err, core.ErrUnknownAnimalType) {
+		t.Errorf("Ожидалась ErrUnknownAnimalType, получено: %v", err)
+	}
+}
@@ -0,0 +1,166 @@
+package unit
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/aiseeq/savanna/config"
+	"github.com/aiseeq/savanna/internal/constants"
+	"github.com/aiseeq/savanna/internal/core"
+	"github.com/aiseeq/savanna/internal/gamestate"
+	"github.com/aiseeq/savanna/internal/generator"
+	"github.com/aiseeq/savanna/internal/pipeline"
+	"github.com/aiseeq/savanna/internal/simulation"
+)
+
+// recordingSystem записывает своё имя в общий журнал вызовов
+type recordingSystem struct {
+	name string
+	log  *[]string
+}
+
+func (s *recordingSystem) Update(_ *core.World, _ float32) {
+	*s.log = append(*s.log, s.name)
+}
+
+// TestSystemManagerOrdersByPhaseAndDependencies проверяет порядок независимо от порядка регистрации
+func TestSystemManagerOrdersByPhaseAndDependencies(t *testing.T) {
+	t.Parallel()
+
+	var log []string
+	manager := core.NewSystemManager()
+	register := func(spec core.SystemSpec) {
+		t.Helper()
+		if err := manager.Register(&recordingSystem{name: spec.Name, log: &log}, spec); err != nil {
+			t.Fatalf("Register %s failed: %v", spec.Name, err)
+		}
+	}
+
+	register(core.SystemSpec{Name: "cleanup", Phase: core.PhaseCleanup})
+	register(core.SystemSpec{Name: "move", Phase: core.PhasePhysics})
+	register(core.SystemSpec{Name: "decide", Phase: core.PhaseAI, After: []string{"sense"}})
+	register(core.SystemSpec{Name: "sense", Phase: core.PhaseAI, After: []string{"optional"}}) // Нет такой системы - игнорируется
+	register(core.SystemSpec{Name: "hunt", Phase: core.PhaseAI, Before: []string{"sense"}})
+	manager.AddSystem(&recordingSystem{name: "legacy", log: &log}) // Сразу после последней зарегистрированной
+
+	// Имя AddSystem берётся из типа системы
+	expected := []string{"hunt", "sense", "decide", "*unit.recordingSystem", "move", "cleanup"}
+	if names := manager.SystemNames(); !reflect.DeepEqual(names, expected) {
+		t.Fatalf("Unexpected order %v", names)
+	}
+
+	manager.Update(core.NewWorld(100, 100, 1), 1.0/60.0)
+	if !reflect.DeepEqual(log, []string{"hunt", "sense", "decide", "legacy", "move", "cleanup"}) {
+		t.Errorf("Unexpected execution %v", log)
+	}
+
+	// Выключенная система пропускается, но сохраняет место в порядке
+	if !manager.SetEnabled("sense", false) || manager.IsEnabled("sense") || manager.SetEnabled("missing", false) {
+		t.Fatal("SetEnabled should toggle only registered systems")
+	}
+	log = nil
+	manager.Update(core.NewWorld(100, 100, 1), 1.0/60.0)
+	if len(log) != 5 || log[1] != "decide" {
+		t.Errorf("Disabled system should be skipped, got %v", log)
+	}
+
+	for _, stats := range manager.Stats() {
+		expectedCalls := 2
+		if stats.Name == "sense" {
+			expectedCalls = 1
+		}
+		if stats.Calls != expectedCalls || stats.Enabled != (stats.Name != "sense") {
+			t.Errorf("Unexpected stats for %s: %+v", stats.Name, stats)
+		}
+	}
+}
+
+// TestSystemManagerRejectsInvalidOrder проверяет обнаружение циклов, повторов имён и противоречий фазам
+func TestSystemManagerRejectsInvalidOrder(t *testing.T) {
+	t.Parallel()
+
+	var log []string
+	manager := core.NewSystemManager()
+	system := &recordingSystem{log: &log}
+
+	_ = manager.Register(system, core.SystemSpec{Name: "a", Phase: core.PhaseAI, After: []string{"c"}})
+	_ = manager.Register(system, core.SystemSpec{Name: "b", Phase: core.PhaseAI, After: []string{"a"}})
+
+	invalid := []core.SystemSpec{
+		{Name: "c", Phase: core.PhaseAI, After: []string{"b"}},          // Цикл a -> b -> c -> a
+		{Name: "a", Phase: core.PhaseCombat},                            // Повтор имени
+		{Name: "early", Phase: core.PhaseInput, After: []string{"b"}},   // Фаза ввода после фазы ИИ
+		{Name: "late", Phase: core.PhaseCleanup, Before: []string{"a"}}, // Фаза очистки до фазы ИИ
+		{Name: "", Phase: core.PhaseAI},                                 // Без имени
+	}
+	for _, spec := range invalid {
+		if err := manager.Register(system, spec); !errors.Is(err, core.ErrSystemOrder) {
+			t.Errorf("Spec %+v should be rejected, got %v", spec, err)
+		}
+	}
+
+	if names := manager.SystemNames(); !reflect.DeepEqual(names, []string{"a", "b"}) {
+		t.Errorf("Rejected systems should not be added, got %v", names)
+	}
+}
+
+// TestSystemManagerMustRegisterPanicsOnInvalidOrder проверяет что ошибка статичного объявления не теряется
+func TestSystemManagerMustRegisterPanicsOnInvalidOrder(t *testing.T) {
+	t.Parallel()
+
+	manager := core.NewSystemManager()
+	system := &recordingSystem{log: new([]string)}
+	manager.MustRegister(system, core.SystemSpec{Name: "a", Phase: core.PhaseAI})
+
+	defer func() {
+		if err, _ := recover().(error); !errors.Is(err, core.ErrSystemOrder) {
+			t.Errorf("MustRegister should panic with ErrSystemOrder, got %v", err)
+		}
+	}()
+	manager.MustRegister(system, core.SystemSpec{Name: "a", Phase: core.PhaseAI})
+}
+
+// TestPipelineSystemOrder фиксирует порядок систем конвейера: он критичен для питания и боя
+func TestPipelineSystemOrder(t *testing.T) {
+	t.Parallel()
+
+	terrain := generator.NewTerrainGenerator(config.LoadDefaultConfig()).Generate()
+	size := constants.TilesToPixels(float32(terrain.Size))
+	simPipeline := pipeline.New(terrain, size, size)
+
+	expected := []string{
+		simulation.SystemVegetation, simulation.SystemSatiation, simulation.SystemThirst,
+		simulation.SystemGrassSearch, simulation.SystemGrassEating, simulation.SystemCover, simulation.SystemPack,
+		simulation.SystemBehavior,
+		simulation.SystemSatiationSpeed, simulation.SystemMovement,
+		simulation.SystemAging, simulation.SystemCombat, simulation.SystemStarvation,
+		simulation.SystemReproduction, simulation.SystemNavigation,
+	}
+	if names := simPipeline.SystemManager().SystemNames(); !reflect.DeepEqual(names, expected) {
+		t.Errorf("Pipeline order changed:\n got %v\nwant %v", names, expected)
+	}
+}
+
+// TestGameStateSystemOrder проверяет что GameState выполняет те же системы, что и конвейер симуляции
+// Реплеи и A/B сравнение баланса идут на GameState и должны описывать ту же симуляцию, что игра
+func TestGameStateSystemOrder(t *testing.T) {
+	t.Parallel()
+
+	gs := gamestate.NewGameState(&gamestate.GameConfig{
+		WorldWidth:    640,
+		WorldHeight:   480,
+		FixedTimeStep: 1.0 / 60.0,
+		RandomSeed:    12345,
+	})
+
+	var names []string
+	for _, stats := range gs.GetSystemStats() {
+		names = append(names, stats.Name)
+	}
+
+	expected := pipeline.New(gs.GetTerrain(), 640, 480).SystemManager().SystemNames()
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("GameState systems differ from the pipeline:\n got %v\nwant %v", names, expected)
+	}
+}